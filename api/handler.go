@@ -1,10 +1,16 @@
 package api
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jonesrussell/mp-emailer/campaign"
 	"github.com/jonesrussell/mp-emailer/logger"
@@ -22,14 +28,98 @@ type Handler struct {
 	jwtExpiry       int
 }
 
+// CampaignList is one page of campaigns with links to its neighbours
+type CampaignList struct {
+	Data     []campaign.Campaign `json:"data"`
+	Total    int64               `json:"total"`
+	Page     int                 `json:"page"`
+	PageSize int                 `json:"page_size"`
+	Links    PageLinks           `json:"links"`
+	// Snippets maps campaign IDs to highlighted HTML excerpts; searches only
+	Snippets map[uuid.UUID]string `json:"snippets,omitempty"`
+}
+
+// PageLinks holds the URLs of the current, next and previous pages
+type PageLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// GetCampaigns lists campaigns a page at a time. It accepts the q, page,
+// page_size, sort (created, updated, name, relevance), order (asc, desc),
+// status, tag, owner (a user ID or "me") and organization query parameters.
+// A q parameter searches the campaigns' text, best match first. Only
+// owner=me lists campaigns that aren't active.
 func (h *Handler) GetCampaigns(c echo.Context) error {
-	campaigns, err := h.campaignService.GetCampaigns(c.Request().Context())
+	query := c.QueryParams()
+
+	params, err := campaign.ParseListQuery(query)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Invalid page, sort or filter", http.StatusBadRequest)
+	}
+
+	switch owner := query.Get("owner"); owner {
+	case "":
+	case "me":
+		userID, err := h.currentUserID(c)
+		if err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+		}
+		params.OwnerID = &userID
+	default:
+		ownerID, err := uuid.Parse(owner)
+		if err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Invalid owner ID", http.StatusBadRequest)
+		}
+		params.OwnerID = &ownerID
+	}
+
+	if org := query.Get("organization"); org != "" {
+		orgID, err := uuid.Parse(org)
+		if err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Invalid organization ID", http.StatusBadRequest)
+		}
+		params.OrganizationID = &orgID
+	}
+
+	// Other people's drafts and closed campaigns are never listed
+	if query.Get("owner") != "me" {
+		if params.Status != "" && params.Status != campaign.StatusActive {
+			return h.errorHandler.HandleHTTPError(c, campaign.ErrUnauthorizedAccess,
+				"Only your own campaigns can be listed by that status", http.StatusForbidden)
+		}
+		params.Status = campaign.StatusActive
+	}
+
+	page, err := h.campaignService.ListCampaigns(c.Request().Context(), params)
+	if errors.Is(err, campaign.ErrInvalidListParams) {
+		return h.errorHandler.HandleHTTPError(c, err, "Invalid page, sort or filter", http.StatusBadRequest)
+	}
 	if err != nil {
 		return h.errorHandler.HandleHTTPError(c, err, "Error fetching campaigns", http.StatusInternalServerError)
 	}
-	return c.JSON(http.StatusOK, campaigns)
+
+	path := c.Request().URL.Path
+	list := CampaignList{
+		Data:     page.Campaigns,
+		Total:    page.Total,
+		Page:     page.Page,
+		PageSize: page.PageSize,
+		Links:    PageLinks{Self: campaign.PageURL(path, query, page.Page)},
+		Snippets: page.Snippets,
+	}
+	if page.HasNext() {
+		list.Links.Next = campaign.PageURL(path, query, page.Page+1)
+	}
+	if page.HasPrev() {
+		list.Links.Prev = campaign.PageURL(path, query, page.Page-1)
+	}
+	return c.JSON(http.StatusOK, list)
 }
 
+// GetCampaign returns a campaign. Campaigns that aren't active are only
+// shown to users who may view them.
 func (h *Handler) GetCampaign(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -40,28 +130,252 @@ func (h *Handler) GetCampaign(c echo.Context) error {
 	if err != nil {
 		return h.errorHandler.HandleHTTPError(c, err, "Error fetching campaign", http.StatusInternalServerError)
 	}
+	if cmpn.Status != campaign.StatusActive {
+		if err := h.checkPermission(c, cmpn, campaign.PermissionView); err != nil {
+			return err
+		}
+	}
 	return c.JSON(http.StatusOK, cmpn)
 }
 
+// CreateCampaign creates a campaign owned by the caller, whatever owner the
+// body names
 func (h *Handler) CreateCampaign(c echo.Context) error {
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
 	dto := new(campaign.CreateCampaignDTO)
 	if err := c.Bind(dto); err != nil {
 		return h.errorHandler.HandleHTTPError(c, err, "Invalid input", http.StatusBadRequest)
 	}
+	dto.OwnerID = userID
 
 	createdCampaign, err := h.campaignService.CreateCampaign(c.Request().Context(), dto)
 	if err != nil {
-		return h.errorHandler.HandleHTTPError(c, err, "Error creating campaign", http.StatusInternalServerError)
+		return h.campaignError(c, err, "Error creating campaign")
 	}
 	return c.JSON(http.StatusCreated, createdCampaign)
 }
 
+// DuplicateCampaign copies a campaign into a new draft owned by the caller.
+// An optional JSON body of {"name": "..."} names the copy.
+func (h *Handler) DuplicateCampaign(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Invalid campaign ID", http.StatusBadRequest)
+	}
+
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	var body struct {
+		Name string `json:"name"`
+	}
+	if c.Request().ContentLength > 0 {
+		if err := c.Bind(&body); err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Invalid input", http.StatusBadRequest)
+		}
+	}
+
+	duplicate, err := h.campaignService.DuplicateCampaign(c.Request().Context(), &campaign.DuplicateCampaignDTO{
+		ID:      id,
+		OwnerID: userID,
+		Name:    body.Name,
+	})
+	if err != nil {
+		return h.campaignError(c, err, "Error duplicating campaign")
+	}
+	return c.JSON(http.StatusCreated, duplicate)
+}
+
+// GetStarterTemplates lists the starter template library
+func (h *Handler) GetStarterTemplates(c echo.Context) error {
+	starters, err := h.campaignService.ListStarterTemplates(c.Request().Context())
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Error fetching starter templates", http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, starters)
+}
+
+// CreateFromStarter creates a draft campaign owned by the caller from a
+// starter template. The optional JSON body may set "name" and
+// "organization_id".
+func (h *Handler) CreateFromStarter(c echo.Context) error {
+	starterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Invalid starter template ID", http.StatusBadRequest)
+	}
+
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	var body struct {
+		Name           string     `json:"name"`
+		OrganizationID *uuid.UUID `json:"organization_id"`
+	}
+	if c.Request().ContentLength > 0 {
+		if err := c.Bind(&body); err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Invalid input", http.StatusBadRequest)
+		}
+	}
+
+	created, err := h.campaignService.CreateFromStarter(c.Request().Context(), &campaign.CreateFromStarterDTO{
+		StarterID:      starterID,
+		OwnerID:        userID,
+		OrganizationID: body.OrganizationID,
+		Name:           body.Name,
+	})
+	if err != nil {
+		return h.campaignError(c, err, "Error creating campaign")
+	}
+	return c.JSON(http.StatusCreated, created)
+}
+
+// ExportCampaigns downloads campaigns as a bundle that ImportCampaigns, on
+// this or another instance, can read. Repeat the id query parameter to pick
+// campaigns the caller can view; without it every campaign the caller owns
+// is exported. format is json (the default) or yaml.
+func (h *Handler) ExportCampaigns(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	format, err := bundleFormat(c.QueryParam("format"), "")
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, err.Error(), http.StatusBadRequest)
+	}
+
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	var campaigns []campaign.Campaign
+	if ids := c.QueryParams()["id"]; len(ids) > 0 {
+		for _, raw := range ids {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return h.errorHandler.HandleHTTPError(c, err, "Invalid campaign ID", http.StatusBadRequest)
+			}
+			if err := h.authorize(c, id, campaign.PermissionView); err != nil {
+				return err
+			}
+			cmpn, err := h.campaignService.GetCampaignByID(ctx, campaign.GetCampaignParams{ID: id})
+			if err != nil {
+				return h.errorHandler.HandleHTTPError(c, err, "Error fetching campaign", http.StatusInternalServerError)
+			}
+			campaigns = append(campaigns, *cmpn)
+		}
+	} else {
+		campaigns, err = h.campaignService.GetCampaignsByOwner(ctx, userID)
+		if err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Error fetching campaigns", http.StatusInternalServerError)
+		}
+	}
+
+	now := time.Now()
+	var buf bytes.Buffer
+	if err := campaign.EncodeBundle(&buf, campaign.NewBundle(campaigns, now), format); err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Error exporting campaigns", http.StatusInternalServerError)
+	}
+
+	contentType := echo.MIMEApplicationJSONCharsetUTF8
+	if format == campaign.BundleFormatYAML {
+		contentType = "application/yaml"
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="campaigns-%s.%s"`, now.Format("20060102"), format))
+	return c.Blob(http.StatusOK, contentType, buf.Bytes())
+}
+
+// ImportCampaigns creates draft campaigns owned by the caller from a bundle
+// in the request body. The format is taken from the format query parameter
+// or the Content-Type. An organization query parameter puts the drafts in
+// one of the caller's organizations.
+func (h *Handler) ImportCampaigns(c echo.Context) error {
+	format, err := bundleFormat(c.QueryParam("format"), c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, err.Error(), http.StatusBadRequest)
+	}
+
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	dto := &campaign.ImportCampaignsDTO{OwnerID: userID}
+	if org := c.QueryParam("organization"); org != "" {
+		orgID, err := uuid.Parse(org)
+		if err != nil {
+			return h.errorHandler.HandleHTTPError(c, err, "Invalid organization ID", http.StatusBadRequest)
+		}
+		dto.OrganizationID = &orgID
+	}
+
+	dto.Bundle, err = campaign.DecodeBundle(c.Request().Body, format)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, err.Error(), http.StatusBadRequest)
+	}
+
+	result, err := h.campaignService.ImportCampaigns(c.Request().Context(), dto)
+	if errors.Is(err, campaign.ErrInvalidBundle) || errors.Is(err, campaign.ErrUnsupportedBundleVersion) {
+		return h.errorHandler.HandleHTTPError(c, err, err.Error(), http.StatusBadRequest)
+	}
+	if err != nil {
+		return h.campaignError(c, err, "Error importing campaigns")
+	}
+	return c.JSON(http.StatusCreated, result)
+}
+
+// bundleFormat picks a bundle encoding from an explicit format parameter,
+// falling back to the content type and then to JSON
+func bundleFormat(param, contentType string) (string, error) {
+	switch strings.ToLower(param) {
+	case campaign.BundleFormatJSON, campaign.BundleFormatYAML:
+		return strings.ToLower(param), nil
+	case "":
+	default:
+		return "", fmt.Errorf("%w: unknown format %q", campaign.ErrInvalidBundle, param)
+	}
+	if strings.Contains(strings.ToLower(contentType), "yaml") {
+		return campaign.BundleFormatYAML, nil
+	}
+	return campaign.BundleFormatJSON, nil
+}
+
+// campaignError renders a campaign service error, using message for
+// unexpected failures
+func (h *Handler) campaignError(c echo.Context, err error, message string) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, campaign.ErrCampaignNotFound):
+		return h.errorHandler.HandleHTTPError(c, err, "Campaign not found", http.StatusNotFound)
+	case errors.Is(err, campaign.ErrStarterTemplateNotFound):
+		return h.errorHandler.HandleHTTPError(c, err, "Starter template not found", http.StatusNotFound)
+	case errors.Is(err, campaign.ErrUnauthorizedAccess):
+		return h.errorHandler.HandleHTTPError(c, err, "Forbidden", http.StatusForbidden)
+	case errors.Is(err, campaign.ErrInvalidTemplate), errors.Is(err, campaign.ErrInvalidCustomFields),
+		errors.As(err, &validationErrs):
+		return h.errorHandler.HandleHTTPError(c, err, err.Error(), http.StatusBadRequest)
+	default:
+		return h.errorHandler.HandleHTTPError(c, err, message, http.StatusInternalServerError)
+	}
+}
+
 func (h *Handler) UpdateCampaign(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return h.errorHandler.HandleHTTPError(c, err, "Invalid campaign ID", http.StatusBadRequest)
 	}
 
+	if err := h.authorize(c, id, campaign.PermissionEdit); err != nil {
+		return err
+	}
+
 	dto := new(campaign.UpdateCampaignDTO)
 	if err := c.Bind(dto); err != nil {
 		return h.errorHandler.HandleHTTPError(c, err, "Invalid input", http.StatusBadRequest)
@@ -69,6 +383,9 @@ func (h *Handler) UpdateCampaign(c echo.Context) error {
 	dto.ID = id
 
 	if err := h.campaignService.UpdateCampaign(c.Request().Context(), dto); err != nil {
+		if errors.Is(err, campaign.ErrInvalidTemplate) || errors.Is(err, campaign.ErrInvalidCustomFields) {
+			return h.errorHandler.HandleHTTPError(c, err, err.Error(), http.StatusBadRequest)
+		}
 		return h.errorHandler.HandleHTTPError(c, err, "Error updating campaign", http.StatusInternalServerError)
 	}
 	return c.JSON(http.StatusOK, dto)
@@ -80,12 +397,61 @@ func (h *Handler) DeleteCampaign(c echo.Context) error {
 		return h.errorHandler.HandleHTTPError(c, err, "Invalid campaign ID", http.StatusBadRequest)
 	}
 
+	if err := h.authorize(c, id, campaign.PermissionManage); err != nil {
+		return err
+	}
+
 	if err := h.campaignService.DeleteCampaign(c.Request().Context(), campaign.DeleteCampaignDTO{ID: id}); err != nil {
 		return h.errorHandler.HandleHTTPError(c, err, "Error deleting campaign", http.StatusInternalServerError)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+// authorize checks that the token's user holds a permission on the campaign.
+// On failure the returned error is the rendered HTTP error.
+func (h *Handler) authorize(c echo.Context, campaignID uuid.UUID, permission campaign.Permission) error {
+	cmpn, err := h.campaignService.GetCampaignByID(c.Request().Context(), campaign.GetCampaignParams{ID: campaignID})
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Error fetching campaign", http.StatusInternalServerError)
+	}
+	return h.checkPermission(c, cmpn, permission)
+}
+
+// checkPermission is authorize for a campaign that has already been loaded
+func (h *Handler) checkPermission(c echo.Context, cmpn *campaign.Campaign, permission campaign.Permission) error {
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.errorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	if err := h.campaignService.CheckPermission(c.Request().Context(), cmpn, userID, permission); err != nil {
+		if errors.Is(err, campaign.ErrUnauthorizedAccess) {
+			return h.errorHandler.HandleHTTPError(c, err, "Forbidden", http.StatusForbidden)
+		}
+		return h.errorHandler.HandleHTTPError(c, err, "Error checking permissions", http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// currentUserID resolves the user named in the request's JWT
+func (h *Handler) currentUserID(c echo.Context) (uuid.UUID, error) {
+	username, _ := c.Get("username").(string)
+	if token, ok := c.Get("user").(*jwt.Token); ok && username == "" {
+		if claims, ok := token.Claims.(jwt.MapClaims); ok {
+			username, _ = claims["username"].(string)
+		}
+	}
+	if username == "" {
+		return uuid.Nil, errors.New("token does not identify a user")
+	}
+
+	u, err := h.userService.GetUser(c.Request().Context(), &user.GetDTO{Username: username})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return u.ID, nil
+}
+
 // RegisterUser User-related handlers
 func (h *Handler) RegisterUser(c echo.Context) error {
 	dto := new(user.RegisterDTO)
@@ -2,10 +2,13 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jonesrussell/mp-emailer/api"
 	"github.com/jonesrussell/mp-emailer/campaign"
@@ -15,6 +18,7 @@ import (
 	mocksShared "github.com/jonesrussell/mp-emailer/mocks/shared"
 	mocksUser "github.com/jonesrussell/mp-emailer/mocks/user"
 	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/jonesrussell/mp-emailer/user"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -60,27 +64,114 @@ func (s *APITestSuite) tearDown() {
 func TestGetCampaigns(t *testing.T) {
 	tests := []struct {
 		name           string
+		url            string
 		setupMocks     func(*APITestSuite)
 		expectedStatus int
 		expectedBody   string
 	}{
 		{
 			name: "successful fetch",
+			url:  "/api/campaign?page=2&page_size=1&tag=housing",
 			setupMocks: func(s *APITestSuite) {
 				s.mockCampaign.EXPECT().
-					GetCampaigns(mock.Anything).
-					Return([]campaign.Campaign{{
-						BaseModel: shared.BaseModel{ID: uuid.New()},
-						Name:      "Test Campaign",
-					}}, nil)
+					ListCampaigns(mock.Anything, mock.MatchedBy(func(p campaign.ListCampaignsParams) bool {
+						return p.Page == 2 && p.PageSize == 1 && p.Tag == "housing" &&
+							p.Status == campaign.StatusActive
+					})).
+					Return(&campaign.CampaignPage{
+						Campaigns: []campaign.Campaign{{
+							BaseModel: shared.BaseModel{ID: uuid.MustParse("6f1b7f9e-4d3b-4b8e-9f0a-2c1d3e4f5a6b")},
+							Name:      "Test Campaign",
+						}},
+						Total:    3,
+						Page:     2,
+						PageSize: 1,
+					}, nil)
 			},
 			expectedStatus: http.StatusOK,
+			expectedBody: `{
+				"total": 3, "page": 2, "page_size": 1,
+				"links": {
+					"self": "/api/campaign?page=2&page_size=1&tag=housing",
+					"next": "/api/campaign?page=3&page_size=1&tag=housing",
+					"prev": "/api/campaign?page=1&page_size=1&tag=housing"
+				}
+			}`,
+		},
+		{
+			name: "search",
+			url:  "/api/campaign?q=bike",
+			setupMocks: func(s *APITestSuite) {
+				id := uuid.MustParse("6f1b7f9e-4d3b-4b8e-9f0a-2c1d3e4f5a6b")
+				s.mockCampaign.EXPECT().
+					ListCampaigns(mock.Anything, campaign.ListCampaignsParams{
+						Query:  "bike",
+						Status: campaign.StatusActive,
+					}).
+					Return(&campaign.CampaignPage{
+						Campaigns: []campaign.Campaign{{BaseModel: shared.BaseModel{ID: id}, Name: "Bike Lanes"}},
+						Total:     1,
+						Page:      1,
+						PageSize:  campaign.DefaultPageSize,
+						Snippets:  map[uuid.UUID]string{id: "<mark>Bike</mark> Lanes"},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{
+				"total": 1, "page": 1, "page_size": 20,
+				"links": {"self": "/api/campaign?page=1&q=bike"},
+				"snippets": {"6f1b7f9e-4d3b-4b8e-9f0a-2c1d3e4f5a6b": "<mark>Bike</mark> Lanes"}
+			}`,
+		},
+		{
+			name: "own drafts",
+			url:  "/api/campaign?owner=me&status=draft",
+			setupMocks: func(s *APITestSuite) {
+				userID := uuid.MustParse("0c8f2a4e-6b1d-4f3a-9e5c-7d2b1a0f9e8d")
+				s.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+					Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+				s.mockCampaign.EXPECT().
+					ListCampaigns(mock.Anything, campaign.ListCampaignsParams{
+						OwnerID: &userID,
+						Status:  campaign.StatusDraft,
+					}).
+					Return(&campaign.CampaignPage{
+						Campaigns: []campaign.Campaign{{Name: "Draft", Status: campaign.StatusDraft}},
+						Total:     1,
+						Page:      1,
+						PageSize:  campaign.DefaultPageSize,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "other people's drafts",
+			url:  "/api/campaign?status=draft",
+			setupMocks: func(s *APITestSuite) {
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrUnauthorizedAccess,
+						"Only your own campaigns can be listed by that status", http.StatusForbidden).
+					Return(echo.NewHTTPError(http.StatusForbidden, "Forbidden"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "invalid page",
+			url:  "/api/campaign?page=zero",
+			setupMocks: func(s *APITestSuite) {
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrInvalidListParams,
+						"Invalid page, sort or filter", http.StatusBadRequest).
+					Return(echo.NewHTTPError(http.StatusBadRequest, "Invalid page, sort or filter"))
+			},
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name: "service error",
+			url:  "/api/campaign",
 			setupMocks: func(s *APITestSuite) {
 				s.mockCampaign.EXPECT().
-					GetCampaigns(mock.Anything).
+					ListCampaigns(mock.Anything, mock.Anything).
 					Return(nil, assert.AnError)
 				s.mockErrorHandler.EXPECT().
 					HandleHTTPError(
@@ -101,9 +192,10 @@ func TestGetCampaigns(t *testing.T) {
 
 			tt.setupMocks(suite)
 
-			req := httptest.NewRequest(http.MethodGet, "/api/campaigns", nil)
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
 			rec := httptest.NewRecorder()
 			c := suite.echo.NewContext(req, rec)
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
 
 			ctx := context.Background()
 			req = req.WithContext(ctx)
@@ -120,9 +212,443 @@ func TestGetCampaigns(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedStatus, rec.Code)
 				if tt.expectedBody != "" {
-					assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+					var got map[string]interface{}
+					assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+					assert.Len(t, got["data"], 1)
+					delete(got, "data")
+					body, _ := json.Marshal(got)
+					assert.JSONEq(t, tt.expectedBody, string(body))
 				}
 			}
 		})
 	}
 }
+
+func TestGetCampaign(t *testing.T) {
+	campaignID := uuid.New()
+	userID := uuid.New()
+	active := &campaign.Campaign{BaseModel: shared.BaseModel{ID: campaignID}, Status: campaign.StatusActive}
+	draft := &campaign.Campaign{BaseModel: shared.BaseModel{ID: campaignID}, Status: campaign.StatusDraft}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*APITestSuite)
+		expectedStatus int
+	}{
+		{
+			name: "anyone sees an active campaign",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().GetCampaignByID(mock.Anything, campaign.GetCampaignParams{ID: campaignID}).
+					Return(active, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "a member sees a draft",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().GetCampaignByID(mock.Anything, campaign.GetCampaignParams{ID: campaignID}).
+					Return(draft, nil)
+				s.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+					Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+				s.mockCampaign.EXPECT().CheckPermission(mock.Anything, draft, userID, campaign.PermissionView).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "anyone else is forbidden a draft",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().GetCampaignByID(mock.Anything, campaign.GetCampaignParams{ID: campaignID}).
+					Return(draft, nil)
+				s.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+					Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+				s.mockCampaign.EXPECT().CheckPermission(mock.Anything, draft, userID, campaign.PermissionView).
+					Return(campaign.ErrUnauthorizedAccess)
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrUnauthorizedAccess, "Forbidden", http.StatusForbidden).
+					Return(echo.NewHTTPError(http.StatusForbidden, "Forbidden"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupAPITest(t)
+			defer suite.tearDown()
+
+			tt.setupMocks(suite)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/campaign/"+campaignID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(campaignID.String())
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
+
+			err := suite.handler.GetCampaign(c)
+
+			if tt.expectedStatus != http.StatusOK {
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, he.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestDeleteCampaign(t *testing.T) {
+	campaignID := uuid.New()
+	userID := uuid.New()
+	cmpn := &campaign.Campaign{BaseModel: shared.BaseModel{ID: campaignID}, OwnerID: uuid.New()}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*APITestSuite)
+		expectedStatus int
+	}{
+		{
+			name: "owner deletes",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().CheckPermission(mock.Anything, cmpn, userID, campaign.PermissionManage).
+					Return(nil)
+				s.mockCampaign.EXPECT().DeleteCampaign(mock.Anything, campaign.DeleteCampaignDTO{ID: campaignID}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "editor is forbidden",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().CheckPermission(mock.Anything, cmpn, userID, campaign.PermissionManage).
+					Return(campaign.ErrUnauthorizedAccess)
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrUnauthorizedAccess, "Forbidden", http.StatusForbidden).
+					Return(echo.NewHTTPError(http.StatusForbidden, "Forbidden"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupAPITest(t)
+			defer suite.tearDown()
+
+			suite.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+				Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+			suite.mockCampaign.EXPECT().GetCampaignByID(mock.Anything, campaign.GetCampaignParams{ID: campaignID}).
+				Return(cmpn, nil)
+			tt.setupMocks(suite)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/campaign/"+campaignID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(campaignID.String())
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
+
+			err := suite.handler.DeleteCampaign(c)
+
+			if tt.expectedStatus != http.StatusNoContent {
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, he.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestCreateCampaign(t *testing.T) {
+	userID := uuid.New()
+	otherID := uuid.New()
+	orgID := uuid.New()
+	ownedBy := func(id uuid.UUID) interface{} {
+		return mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool { return dto.OwnerID == id })
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*APITestSuite)
+		expectedStatus int
+	}{
+		{
+			name: "the caller owns the campaign",
+			body: `{"Name": "Library Drive", "OwnerID": "` + otherID.String() + `"}`,
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().CreateCampaign(mock.Anything, ownedBy(userID)).
+					Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}, OwnerID: userID}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "a non-member can't borrow a member's ID to add to an organization",
+			body: `{"Name": "Library Drive", "OwnerID": "` + otherID.String() +
+				`", "OrganizationID": "` + orgID.String() + `"}`,
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().CreateCampaign(mock.Anything, ownedBy(userID)).
+					Return(nil, campaign.ErrUnauthorizedAccess)
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrUnauthorizedAccess, "Forbidden", http.StatusForbidden).
+					Return(echo.NewHTTPError(http.StatusForbidden, "Forbidden"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupAPITest(t)
+			defer suite.tearDown()
+
+			suite.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+				Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+			tt.setupMocks(suite)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/campaign", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
+
+			err := suite.handler.CreateCampaign(c)
+
+			if tt.expectedStatus != http.StatusCreated {
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, he.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestDuplicateCampaign(t *testing.T) {
+	campaignID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*APITestSuite)
+		expectedStatus int
+	}{
+		{
+			name: "copies into a draft",
+			body: `{"name": "Library Drive 2025"}`,
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().DuplicateCampaign(mock.Anything, &campaign.DuplicateCampaignDTO{
+					ID: campaignID, OwnerID: userID, Name: "Library Drive 2025",
+				}).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}, Status: campaign.StatusDraft}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "hidden campaign",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().DuplicateCampaign(mock.Anything, mock.Anything).
+					Return(nil, campaign.ErrCampaignNotFound)
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrCampaignNotFound, "Campaign not found", http.StatusNotFound).
+					Return(echo.NewHTTPError(http.StatusNotFound, "Campaign not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupAPITest(t)
+			defer suite.tearDown()
+
+			suite.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+				Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+			tt.setupMocks(suite)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/campaign/"+campaignID.String()+"/duplicate",
+				strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(campaignID.String())
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
+
+			err := suite.handler.DuplicateCampaign(c)
+
+			if tt.expectedStatus != http.StatusCreated {
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, he.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestExportCampaigns(t *testing.T) {
+	userID := uuid.New()
+	owned := campaign.Campaign{
+		BaseModel: shared.BaseModel{ID: uuid.New()},
+		Name:      "Save the Library",
+		Template:  "Dear {{.representative.name}},",
+		Targets:   []campaign.Target{campaign.TargetMayor},
+		OwnerID:   userID,
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*APITestSuite)
+		expectedStatus int
+		expectedType   string
+	}{
+		{
+			name:  "caller's campaigns as JSON",
+			query: "",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().GetCampaignsByOwner(mock.Anything, userID).
+					Return([]campaign.Campaign{owned}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   echo.MIMEApplicationJSONCharsetUTF8,
+		},
+		{
+			name:  "selected campaign as YAML",
+			query: "?format=yaml&id=" + owned.ID.String(),
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().GetCampaignByID(mock.Anything, campaign.GetCampaignParams{ID: owned.ID}).
+					Return(&owned, nil)
+				s.mockCampaign.EXPECT().CheckPermission(mock.Anything, &owned, userID, campaign.PermissionView).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/yaml",
+		},
+		{
+			name:  "campaign the caller can't see",
+			query: "?id=" + owned.ID.String(),
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().GetCampaignByID(mock.Anything, campaign.GetCampaignParams{ID: owned.ID}).
+					Return(&owned, nil)
+				s.mockCampaign.EXPECT().CheckPermission(mock.Anything, &owned, userID, campaign.PermissionView).
+					Return(campaign.ErrUnauthorizedAccess)
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, campaign.ErrUnauthorizedAccess, "Forbidden", http.StatusForbidden).
+					Return(echo.NewHTTPError(http.StatusForbidden, "Forbidden"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupAPITest(t)
+			defer suite.tearDown()
+
+			suite.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+				Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+			tt.setupMocks(suite)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/campaign/export"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
+
+			err := suite.handler.ExportCampaigns(c)
+
+			if tt.expectedStatus != http.StatusOK {
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, he.Code)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedType, rec.Header().Get(echo.HeaderContentType))
+			assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+
+			format := campaign.BundleFormatForPath("." + strings.TrimPrefix(tt.expectedType, "application/"))
+			bundle, err := campaign.DecodeBundle(rec.Body, format)
+			assert.NoError(t, err)
+			assert.Len(t, bundle.Campaigns, 1)
+			assert.Equal(t, owned.ID, bundle.Campaigns[0].ID)
+		})
+	}
+}
+
+func TestImportCampaigns(t *testing.T) {
+	userID := uuid.New()
+	sourceID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           string
+		contentType    string
+		setupMocks     func(*APITestSuite)
+		expectedStatus int
+	}{
+		{
+			name:        "YAML bundle",
+			contentType: "application/yaml",
+			body: "version: 1\ncampaigns:\n  - id: " + sourceID.String() +
+				"\n    name: Save the Library\n    description: Keep it open\n    template: Dear {{.representative.name}}\n",
+			setupMocks: func(s *APITestSuite) {
+				s.mockCampaign.EXPECT().ImportCampaigns(mock.Anything, mock.MatchedBy(func(dto *campaign.ImportCampaignsDTO) bool {
+					return dto.OwnerID == userID && dto.OrganizationID == nil &&
+						len(dto.Bundle.Campaigns) == 1 && dto.Bundle.Campaigns[0].ID == sourceID
+				})).Return(&campaign.ImportResult{IDMap: map[uuid.UUID]uuid.UUID{sourceID: uuid.New()}}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "newer bundle version",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"version": 99, "campaigns": []}`,
+			setupMocks: func(s *APITestSuite) {
+				s.mockErrorHandler.EXPECT().
+					HandleHTTPError(mock.Anything, mock.Anything, mock.Anything, http.StatusBadRequest).
+					Return(echo.NewHTTPError(http.StatusBadRequest))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupAPITest(t)
+			defer suite.tearDown()
+
+			suite.mockUser.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).
+				Return(&user.DTO{ID: userID, Username: "alice"}, nil)
+			tt.setupMocks(suite)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/campaign/import", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"username": "alice"}})
+
+			err := suite.handler.ImportCampaigns(c)
+
+			if tt.expectedStatus != http.StatusCreated {
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, he.Code)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
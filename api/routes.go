@@ -19,10 +19,18 @@ func RegisterRoutes(h *Handler, e *echo.Echo, middlewareManager *middleware.Mana
 	// Campaign routes
 	campaigns := protected.Group("/campaign")
 	campaigns.GET("", h.GetCampaigns)
+	campaigns.GET("/export", h.ExportCampaigns)
+	campaigns.POST("/import", h.ImportCampaigns)
 	campaigns.GET("/:id", h.GetCampaign)
 	campaigns.POST("", h.CreateCampaign)
 	campaigns.PUT("/:id", h.UpdateCampaign)
 	campaigns.DELETE("/:id", h.DeleteCampaign)
+	campaigns.POST("/:id/duplicate", h.DuplicateCampaign)
+
+	// Starter template routes
+	starters := protected.Group("/starter-templates")
+	starters.GET("", h.GetStarterTemplates)
+	starters.POST("/:id/campaign", h.CreateFromStarter)
 
 	// User routes
 	users := protected.Group("/user")
@@ -0,0 +1,176 @@
+package campaign
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// BundleVersion is the version of the bundle format this build reads and
+// writes. Bump it whenever a field is added or its meaning changes.
+//
+// Version 2 added custom fields, version 3 languages and translations,
+// version 4 confirmation of letters, and version 5 copies of letters to
+// constituents.
+const BundleVersion = 5
+
+// Bundle encodings
+const (
+	BundleFormatJSON = "json"
+	BundleFormatYAML = "yaml"
+)
+
+// Bundle is a portable set of campaigns for moving them between instances
+type Bundle struct {
+	Version    int              `json:"version" yaml:"version"`
+	ExportedAt time.Time        `json:"exported_at" yaml:"exported_at"`
+	Campaigns  []BundleCampaign `json:"campaigns" yaml:"campaigns"`
+}
+
+// BundleCampaign is one campaign in a bundle. ID is the campaign's ID on
+// the exporting instance; imported campaigns are given new IDs.
+type BundleCampaign struct {
+	ID          uuid.UUID `json:"id" yaml:"id"`
+	Name        string    `json:"name" yaml:"name"`
+	Description string    `json:"description" yaml:"description"`
+	Subject     string    `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Template    string    `json:"template" yaml:"template"`
+	Targets     []Target  `json:"targets,omitempty" yaml:"targets,omitempty"`
+	Tags        []string  `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// CustomFields are absent from version 1 bundles
+	CustomFields []CustomField `json:"custom_fields,omitempty" yaml:"custom_fields,omitempty"`
+	// Language and Translations are absent from bundles before version 3,
+	// whose campaigns are in English
+	Language     string        `json:"language,omitempty" yaml:"language,omitempty"`
+	Translations []Translation `json:"translations,omitempty" yaml:"translations,omitempty"`
+	// RequireConfirmation is absent from bundles before version 4
+	RequireConfirmation bool `json:"require_confirmation,omitempty" yaml:"require_confirmation,omitempty"`
+	// CopyConstituent is absent from bundles before version 5
+	CopyConstituent bool `json:"copy_constituent,omitempty" yaml:"copy_constituent,omitempty"`
+}
+
+// NewBundle packs campaigns into a bundle of the current version
+func NewBundle(campaigns []Campaign, exportedAt time.Time) *Bundle {
+	bundle := &Bundle{
+		Version:    BundleVersion,
+		ExportedAt: exportedAt.UTC(),
+		Campaigns:  make([]BundleCampaign, 0, len(campaigns)),
+	}
+	for _, c := range campaigns {
+		bundle.Campaigns = append(bundle.Campaigns, BundleCampaign{
+			ID:                  c.ID,
+			Name:                c.Name,
+			Description:         c.Description,
+			Subject:             c.Subject,
+			Template:            c.Template,
+			Targets:             NormalizeTargets(c.Targets),
+			Tags:                c.Tags,
+			CustomFields:        c.CustomFields,
+			Language:            c.CampaignLanguage(),
+			Translations:        c.Translations,
+			RequireConfirmation: c.RequireConfirmation,
+			CopyConstituent:     c.CopyConstituent,
+		})
+	}
+	return bundle
+}
+
+// BundleFormatForPath picks the encoding for a file from its extension,
+// defaulting to JSON
+func BundleFormatForPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return BundleFormatYAML
+	default:
+		return BundleFormatJSON
+	}
+}
+
+// EncodeBundle writes the bundle in the given format
+func EncodeBundle(w io.Writer, bundle *Bundle, format string) error {
+	switch format {
+	case BundleFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(bundle)
+	case BundleFormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(bundle); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidBundle, format)
+	}
+}
+
+// DecodeBundle reads a bundle in the given format. Bundles from a newer
+// version of the format, or with fields this version doesn't know, are
+// rejected rather than imported with data missing.
+func DecodeBundle(r io.Reader, format string) (*Bundle, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle: %w", err)
+	}
+
+	var header struct {
+		Version int `json:"version" yaml:"version"`
+	}
+	if err := unmarshalBundle(data, format, &header, false); err != nil {
+		return nil, err
+	}
+	if header.Version < 1 || header.Version > BundleVersion {
+		return nil, fmt.Errorf("%w: version %d, this instance reads up to %d",
+			ErrUnsupportedBundleVersion, header.Version, BundleVersion)
+	}
+
+	var bundle Bundle
+	if err := unmarshalBundle(data, format, &bundle, true); err != nil {
+		return nil, err
+	}
+	for _, c := range bundle.Campaigns {
+		if bundle.Version < 2 && len(c.CustomFields) > 0 {
+			return nil, fmt.Errorf("%w: custom fields need bundle version 2", ErrInvalidBundle)
+		}
+		if bundle.Version < 3 && (c.Language != "" || len(c.Translations) > 0) {
+			return nil, fmt.Errorf("%w: languages and translations need bundle version 3", ErrInvalidBundle)
+		}
+		if bundle.Version < 4 && c.RequireConfirmation {
+			return nil, fmt.Errorf("%w: letter confirmation needs bundle version 4", ErrInvalidBundle)
+		}
+		if bundle.Version < 5 && c.CopyConstituent {
+			return nil, fmt.Errorf("%w: constituent copies need bundle version 5", ErrInvalidBundle)
+		}
+	}
+	return &bundle, nil
+}
+
+func unmarshalBundle(data []byte, format string, dest interface{}, strict bool) error {
+	var err error
+	switch format {
+	case BundleFormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		if strict {
+			dec.DisallowUnknownFields()
+		}
+		err = dec.Decode(dest)
+	case BundleFormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(strict)
+		err = dec.Decode(dest)
+	default:
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidBundle, format)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+	return nil
+}
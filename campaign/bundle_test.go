@@ -0,0 +1,157 @@
+package campaign_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	campaigns := []campaign.Campaign{
+		{
+			BaseModel:   shared.BaseModel{ID: uuid.New()},
+			Name:        "Save the Library",
+			Description: "Keep our branch open",
+			Subject:     "Please keep the library open",
+			Template:    "Dear {{.representative.name}},",
+			Targets:     []campaign.Target{campaign.TargetMayor, campaign.TargetCouncillor},
+			Tags:        []string{"libraries"},
+			Translations: []campaign.Translation{
+				{Language: campaign.LanguageFrench, Subject: "Gardez la bibliothèque", Template: "Cher {{.representative.name}},"},
+			},
+			CustomFields: []campaign.CustomField{
+				{Key: "branch", Label: "Your branch", Type: campaign.FieldTypeSelect, Options: []string{"Main", "East"}},
+			},
+			RequireConfirmation: true,
+			CopyConstituent:     true,
+			OwnerID:             uuid.New(),
+		},
+		{
+			BaseModel:   shared.BaseModel{ID: uuid.New()},
+			Name:        "Fund Transit",
+			Description: "More buses",
+			Template:    "Dear {{.representative.name}}, I ride transit.",
+		},
+	}
+	exportedAt := time.Date(2024, 12, 15, 9, 0, 0, 0, time.UTC)
+
+	for _, format := range []string{campaign.BundleFormatJSON, campaign.BundleFormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, campaign.EncodeBundle(&buf, campaign.NewBundle(campaigns, exportedAt), format))
+			assert.NotContains(t, buf.String(), campaigns[0].OwnerID.String())
+
+			bundle, err := campaign.DecodeBundle(&buf, format)
+
+			require.NoError(t, err)
+			assert.Equal(t, campaign.BundleVersion, bundle.Version)
+			assert.True(t, exportedAt.Equal(bundle.ExportedAt))
+			require.Len(t, bundle.Campaigns, 2)
+			first := bundle.Campaigns[0]
+			assert.Equal(t, campaigns[0].ID, first.ID)
+			assert.Equal(t, campaigns[0].Subject, first.Subject)
+			assert.Equal(t, campaigns[0].Template, first.Template)
+			assert.Equal(t, []campaign.Target{campaign.TargetMayor, campaign.TargetCouncillor}, first.Targets)
+			assert.Equal(t, []string{"libraries"}, first.Tags)
+			assert.Equal(t, campaigns[0].CustomFields, first.CustomFields)
+			assert.Equal(t, campaign.LanguageEnglish, first.Language)
+			assert.Equal(t, campaigns[0].Translations, first.Translations)
+			assert.True(t, first.RequireConfirmation)
+			assert.False(t, bundle.Campaigns[1].RequireConfirmation)
+			assert.True(t, first.CopyConstituent)
+			assert.False(t, bundle.Campaigns[1].CopyConstituent)
+			assert.Equal(t, []campaign.Target{campaign.TargetMP}, bundle.Campaigns[1].Targets)
+		})
+	}
+}
+
+func TestDecodeBundle_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		input   string
+		wantErr error
+	}{
+		{
+			name:    "newer version",
+			format:  campaign.BundleFormatJSON,
+			input:   `{"version": 99, "campaigns": []}`,
+			wantErr: campaign.ErrUnsupportedBundleVersion,
+		},
+		{
+			name:    "missing version",
+			format:  campaign.BundleFormatYAML,
+			input:   "campaigns: []\n",
+			wantErr: campaign.ErrUnsupportedBundleVersion,
+		},
+		{
+			name:    "unknown field",
+			format:  campaign.BundleFormatJSON,
+			input:   `{"version": 1, "campaigns": [{"name": "x", "budget": 10}]}`,
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "unknown YAML field",
+			format:  campaign.BundleFormatYAML,
+			input:   "version: 1\ncampaigns:\n  - name: x\n    budget: 10\n",
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "custom fields in a version 1 bundle",
+			format:  campaign.BundleFormatJSON,
+			input:   `{"version": 1, "campaigns": [{"name": "x", "custom_fields": [{"key": "a", "label": "A", "type": "text"}]}]}`,
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "translations in a version 2 bundle",
+			format:  campaign.BundleFormatYAML,
+			input:   "version: 2\ncampaigns:\n  - name: x\n    translations:\n      - language: fr\n        template: Bonjour\n",
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "confirmation in a version 3 bundle",
+			format:  campaign.BundleFormatJSON,
+			input:   `{"version": 3, "campaigns": [{"name": "x", "require_confirmation": true}]}`,
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "constituent copies in a version 4 bundle",
+			format:  campaign.BundleFormatYAML,
+			input:   "version: 4\ncampaigns:\n  - name: x\n    copy_constituent: true\n",
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "malformed",
+			format:  campaign.BundleFormatJSON,
+			input:   `{"version": 1,`,
+			wantErr: campaign.ErrInvalidBundle,
+		},
+		{
+			name:    "unknown format",
+			format:  "xml",
+			input:   `<bundle/>`,
+			wantErr: campaign.ErrInvalidBundle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := campaign.DecodeBundle(strings.NewReader(tt.input), tt.format)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBundleFormatForPath(t *testing.T) {
+	assert.Equal(t, campaign.BundleFormatYAML, campaign.BundleFormatForPath("campaigns.YML"))
+	assert.Equal(t, campaign.BundleFormatYAML, campaign.BundleFormatForPath("out/campaigns.yaml"))
+	assert.Equal(t, campaign.BundleFormatJSON, campaign.BundleFormatForPath("campaigns.json"))
+	assert.Equal(t, campaign.BundleFormatJSON, campaign.BundleFormatForPath("-"))
+}
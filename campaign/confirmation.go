@@ -0,0 +1,217 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/email"
+)
+
+// defaultConfirmationTTL is how long a letter waits for confirmation when the
+// configuration doesn't say
+const defaultConfirmationTTL = 48 * time.Hour
+
+// confirmationRequest gathers the letters of one submission that wait for
+// the constituent's confirmation, so that a single link releases them all
+type confirmationRequest struct {
+	campaign  *Campaign
+	email     string
+	token     string
+	expiresAt time.Time
+	sends     []*Send
+}
+
+// holdForConfirmation records a letter as unconfirmed under the submission's
+// confirmation link. The link is emailed by requestConfirmation once every
+// draft of the submission has been held.
+func (s *Service) holdForConfirmation(
+	ctx context.Context,
+	req *confirmationRequest,
+	campaign *Campaign,
+	send *Send,
+	subject, body, constituentEmail string,
+) (*Send, error) {
+	if req.token == "" {
+		token, err := newLinkToken()
+		if err != nil {
+			return nil, err
+		}
+
+		ttl := s.confirmTTL
+		if ttl <= 0 {
+			ttl = defaultConfirmationTTL
+		}
+		req.campaign = campaign
+		req.email = constituentEmail
+		req.token = token
+		req.expiresAt = time.Now().Add(ttl)
+	}
+
+	send.Status = SendStatusUnconfirmed
+	send.Subject = subject
+	send.Body = body
+	send.ReplyTo = constituentEmail
+	send.ConfirmationToken = &req.token
+	send.ConfirmationExpiresAt = &req.expiresAt
+
+	if err := s.recordSend(ctx, send); err != nil {
+		return nil, err
+	}
+	req.sends = append(req.sends, send)
+	return send, nil
+}
+
+// requestConfirmation queues the one email that asks the constituent to
+// confirm the letters held for a submission
+func (s *Service) requestConfirmation(ctx context.Context, req *confirmationRequest) error {
+	if len(req.sends) == 0 {
+		return nil
+	}
+
+	subject, body := s.confirmationEmail(req)
+	msg := &email.QueuedMessage{To: req.email, Subject: subject, Body: body}
+	if err := s.emailQueue.Enqueue(ctx, msg); err != nil {
+		// Letters nobody can confirm would only wait to expire
+		for _, send := range req.sends {
+			send.Status = SendStatusFailed
+			send.Error = "confirmation email: " + err.Error()
+			send.Subject, send.Body, send.ReplyTo = "", "", ""
+			if updateErr := s.sendRepo.Update(ctx, send); updateErr != nil {
+				s.Logger.Error("Failed to update campaign send", updateErr, "sendID", send.ID)
+			}
+		}
+		return fmt.Errorf("failed to queue confirmation: %w", err)
+	}
+
+	s.Logger.Info("Campaign letters awaiting confirmation", "campaignID", req.campaign.ID, "letters", len(req.sends))
+	return nil
+}
+
+// confirmationEmail builds the subject and plain-text body of the email that
+// asks a constituent to confirm their letters
+func (s *Service) confirmationEmail(req *confirmationRequest) (string, string) {
+	names := make([]string, len(req.sends))
+	for i, send := range req.sends {
+		names[i] = send.RepresentativeName
+	}
+
+	letters, them := "letter", "it"
+	if len(req.sends) > 1 {
+		letters, them = "letters", "them"
+	}
+	subject := fmt.Sprintf("Please confirm your %s to %s", letters, joinNames(names))
+	body := fmt.Sprintf(`Hello,
+
+You wrote to %s through the campaign "%s". Before we forward your %s,
+please confirm your email address by opening the following link:
+%s/campaign/confirm/%s
+
+If you don't confirm by %s, we won't send %s. If you didn't write
+to them, you can ignore this email.
+
+Best regards,
+Your Application Team`,
+		joinNames(names),
+		req.campaign.Name,
+		letters,
+		strings.TrimRight(s.baseURL, "/"),
+		req.token,
+		req.expiresAt.Format("January 2, 2006 at 3:04 PM MST"),
+		them)
+	return subject, body
+}
+
+// joinNames lists names as "A", "A and B" or "A, B and C"
+func joinNames(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// GetConfirmation returns the letters a confirmation link refers to, as
+// long as the link hasn't expired
+func (s *Service) GetConfirmation(ctx context.Context, token string) ([]Send, error) {
+	if token == "" {
+		return nil, ErrConfirmationNotFound
+	}
+
+	sends, err := s.sendRepo.ListByConfirmationToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// The letters of a submission share the link, so they expire together
+	now := time.Now()
+	for _, send := range sends {
+		if send.Status == SendStatusExpired ||
+			send.IsUnconfirmed() && send.ConfirmationExpiresAt != nil && now.After(*send.ConfirmationExpiresAt) {
+			return nil, ErrConfirmationExpired
+		}
+	}
+	return sends, nil
+}
+
+// ConfirmSend queues the letters a confirmation link refers to and returns
+// them. Confirming again after the letters were queued is harmless.
+func (s *Service) ConfirmSend(ctx context.Context, token string) ([]Send, error) {
+	sends, err := s.GetConfirmation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var campaign *Campaign
+	var queueErr error
+	for i := range sends {
+		send := &sends[i]
+		if !send.IsUnconfirmed() {
+			continue
+		}
+
+		if campaign == nil {
+			campaign, err = s.FetchCampaign(ctx, GetCampaignParams{ID: send.CampaignID})
+			if err != nil {
+				return nil, err
+			}
+			if !campaign.IsOpen(now) {
+				return nil, ErrCampaignNotOpen
+			}
+		}
+
+		// Only one of two quick clicks on the link gets to queue the letter
+		confirmed, err := s.sendRepo.Confirm(ctx, send.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to confirm send: %w", err)
+		}
+		subject, body, replyTo := send.Subject, send.Body, send.ReplyTo
+		send.Status = SendStatusPending
+		send.ConfirmedAt = &now
+		send.Subject, send.Body, send.ReplyTo = "", "", ""
+		if !confirmed {
+			continue
+		}
+
+		if err := s.queueSend(ctx, campaign, send, subject, body, replyTo); err != nil {
+			queueErr = err
+			continue
+		}
+		s.Logger.Info("Campaign letter confirmed", "campaignID", send.CampaignID, "sendID", send.ID)
+	}
+	return sends, queueErr
+}
+
+// ExpireUnconfirmedSends expires the letters whose confirmation window
+// closed before now
+func (s *Service) ExpireUnconfirmedSends(ctx context.Context, now time.Time) error {
+	expired, err := s.sendRepo.ExpireUnconfirmed(ctx, now)
+	if err != nil {
+		return err
+	}
+	if expired > 0 {
+		s.Logger.Info("Unconfirmed campaign letters expired", "count", expired)
+	}
+	return nil
+}
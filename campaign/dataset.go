@@ -0,0 +1,271 @@
+package campaign
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Formats of a representatives export
+const (
+	DatasetFormatJSON = "json"
+	DatasetFormatCSV  = "csv"
+)
+
+// PostalCodeRiding places a postal code in a riding of the offline dataset.
+// A postal code usually lies in several ridings, one per level of
+// government.
+type PostalCodeRiding struct {
+	PostalCode   string `gorm:"type:varchar(6);primarykey" json:"postal_code"`
+	DistrictName string `gorm:"type:varchar(255);primarykey" json:"district_name"`
+	// RepresentativeSet narrows the riding to one legislature, such as
+	// "House of Commons"; when empty the riding matches representatives of
+	// any legislature with a district of that name
+	RepresentativeSet string `gorm:"column:representative_set_name;type:varchar(255);primarykey" json:"representative_set_name"`
+}
+
+// TableName overrides the default table name
+func (PostalCodeRiding) TableName() string {
+	return "dataset_postal_codes"
+}
+
+// DatasetRepresentative is a representative of the offline dataset
+type DatasetRepresentative struct {
+	ID                uint           `gorm:"primarykey" json:"-"`
+	DistrictName      string         `gorm:"type:varchar(255);not null;index" json:"district_name"`
+	RepresentativeSet string         `gorm:"column:representative_set_name;type:varchar(255);not null" json:"representative_set_name"`
+	Representative    Representative `gorm:"type:json;serializer:json;not null" json:"representative"`
+}
+
+// TableName overrides the default table name
+func (DatasetRepresentative) TableName() string {
+	return "dataset_representatives"
+}
+
+// DatasetFormatForPath picks the format of a representatives export from
+// its file name, defaulting to JSON
+func DatasetFormatForPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return DatasetFormatCSV
+	}
+	return DatasetFormatJSON
+}
+
+// ParsePostalCodeRidings reads a postal-code-to-riding CSV. Its header
+// names a postal_code and a district_name column, and optionally a
+// representative_set_name column; the other columns are ignored.
+func ParsePostalCodeRidings(r io.Reader) ([]PostalCodeRiding, error) {
+	rows, columns, err := readDatasetCSV(r)
+	if err != nil {
+		return nil, err
+	}
+	postalCol, ok := columns["postal_code"]
+	if !ok {
+		return nil, fmt.Errorf("%w: postal codes need a postal_code column", ErrInvalidDataset)
+	}
+	districtCol, ok := columns["district_name"]
+	if !ok {
+		return nil, fmt.Errorf("%w: postal codes need a district_name column", ErrInvalidDataset)
+	}
+
+	seen := make(map[PostalCodeRiding]bool, len(rows))
+	ridings := make([]PostalCodeRiding, 0, len(rows))
+	for i, row := range rows {
+		line := i + 2
+		postalCode, err := validatePostalCode(row[postalCol])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidDataset, line, err)
+		}
+		riding := PostalCodeRiding{
+			PostalCode:        postalCode,
+			DistrictName:      strings.TrimSpace(row[districtCol]),
+			RepresentativeSet: field(row, columns, "representative_set_name"),
+		}
+		if riding.DistrictName == "" {
+			return nil, fmt.Errorf("%w: line %d: district name is required", ErrInvalidDataset, line)
+		}
+		if !seen[riding] {
+			seen[riding] = true
+			ridings = append(ridings, riding)
+		}
+	}
+	return ridings, nil
+}
+
+// ParseDatasetRepresentatives reads representatives exported from
+// Represent, either as JSON (an API response with an "objects" list, or a
+// bare list) or as CSV. Representatives without a legislature are given
+// defaultSet.
+func ParseDatasetRepresentatives(r io.Reader, format, defaultSet string) ([]DatasetRepresentative, error) {
+	var representatives []Representative
+	var err error
+	switch format {
+	case DatasetFormatJSON:
+		representatives, err = parseRepresentativesJSON(r)
+	case DatasetFormatCSV:
+		representatives, err = parseRepresentativesCSV(r)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidDataset, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dataset := make([]DatasetRepresentative, 0, len(representatives))
+	for i, rep := range representatives {
+		if rep.RepresentativeSet == "" {
+			rep.RepresentativeSet = defaultSet
+		}
+		if strings.TrimSpace(rep.Name) == "" || strings.TrimSpace(rep.DistrictName) == "" {
+			return nil, fmt.Errorf("%w: representative %d needs a name and a district name", ErrInvalidDataset, i+1)
+		}
+		dataset = append(dataset, DatasetRepresentative{
+			DistrictName:      strings.TrimSpace(rep.DistrictName),
+			RepresentativeSet: rep.RepresentativeSet,
+			Representative:    rep,
+		})
+	}
+	return dataset, nil
+}
+
+func parseRepresentativesJSON(r io.Reader) ([]Representative, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var representatives []Representative
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(data, &representatives)
+	} else {
+		var page struct {
+			Objects []Representative `json:"objects"`
+		}
+		err = json.Unmarshal(data, &page)
+		representatives = page.Objects
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+	}
+	return representatives, nil
+}
+
+// officeColumn matches the office columns of a Represent CSV export, which
+// are numbered from the second office on, as in "phone_2"
+var officeColumn = regexp.MustCompile(`^(office_type|address|phone|fax)(?:_([0-9]+))?$`)
+
+func parseRepresentativesCSV(r io.Reader) ([]Representative, error) {
+	rows, columns, err := readDatasetCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	representatives := make([]Representative, 0, len(rows))
+	for _, row := range rows {
+		rep := Representative{
+			Name:              field(row, columns, "name"),
+			DistrictName:      field(row, columns, "district_name"),
+			ElectedOffice:     field(row, columns, "elected_office", "primary_role"),
+			FirstName:         field(row, columns, "first_name"),
+			LastName:          field(row, columns, "last_name"),
+			Party:             field(row, columns, "party_name"),
+			Email:             field(row, columns, "email"),
+			URL:               field(row, columns, "url", "source_url"),
+			PersonalURL:       field(row, columns, "personal_url", "website"),
+			PhotoURL:          field(row, columns, "photo_url"),
+			Gender:            field(row, columns, "gender"),
+			RepresentativeSet: field(row, columns, "representative_set_name"),
+		}
+		rep.Offices = csvOffices(row, columns)
+		representatives = append(representatives, rep)
+	}
+	return representatives, nil
+}
+
+// csvOffices collects a CSV row's numbered office columns into offices
+func csvOffices(row []string, columns map[string]int) []Office {
+	offices := map[int]*Office{}
+	for name, col := range columns {
+		m := officeColumn.FindStringSubmatch(name)
+		if m == nil || strings.TrimSpace(row[col]) == "" {
+			continue
+		}
+		n := 1
+		if m[2] != "" {
+			n, _ = strconv.Atoi(m[2])
+		}
+		if offices[n] == nil {
+			offices[n] = &Office{}
+		}
+		value := strings.TrimSpace(row[col])
+		switch m[1] {
+		case "office_type":
+			offices[n].Type = value
+		case "address":
+			offices[n].Postal = value
+		case "phone":
+			offices[n].Tel = value
+		case "fax":
+			offices[n].Fax = value
+		}
+	}
+
+	var result []Office
+	for _, n := range slices.Sorted(maps.Keys(offices)) {
+		result = append(result, *offices[n])
+	}
+	return result
+}
+
+// readDatasetCSV reads a CSV file with a header row. Header names are
+// matched case-insensitively with spaces read as underscores, so that
+// "District name" is the district_name column.
+func readDatasetCSV(r io.Reader) ([][]string, map[string]int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%w: the CSV file is empty", ErrInvalidDataset)
+	}
+
+	header := records[0]
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		name = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+
+	rows := records[1:]
+	for i, row := range rows {
+		if len(row) != len(header) {
+			return nil, nil, fmt.Errorf("%w: line %d has %d fields, the header %d",
+				ErrInvalidDataset, i+2, len(row), len(header))
+		}
+	}
+	return rows, columns, nil
+}
+
+// field returns the trimmed value of the first of the named columns the
+// CSV file has
+func field(row []string, columns map[string]int, names ...string) string {
+	for _, name := range names {
+		if col, ok := columns[name]; ok {
+			return strings.TrimSpace(row[col])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+)
+
+// DatasetProviderParams for dependency injection
+type DatasetProviderParams struct {
+	fx.In
+
+	Dataset DatasetRepositoryInterface
+	Logger  logger.Interface
+}
+
+// DatasetProvider answers representative lookups from the offline dataset,
+// without network access
+type DatasetProvider struct {
+	dataset DatasetRepositoryInterface
+	Logger  logger.Interface
+}
+
+// NewDatasetProvider creates a new instance of DatasetProvider
+func NewDatasetProvider(params DatasetProviderParams) *DatasetProvider {
+	return &DatasetProvider{
+		dataset: params.Dataset,
+		Logger:  params.Logger,
+	}
+}
+
+// Name implements RepresentativeProvider
+func (p *DatasetProvider) Name() string {
+	return string(config.RepresentativeProviderDataset)
+}
+
+// FetchRepresentatives implements RepresentativeProvider
+func (p *DatasetProvider) FetchRepresentatives(ctx context.Context, postalCode string) ([]Representative, error) {
+	postalCode = normalizePostalCode(postalCode)
+	representatives, err := p.dataset.FindByPostalCode(ctx, postalCode)
+	if err != nil {
+		p.Logger.Error("Error looking up representatives in dataset", err, "postalCode", postalCode)
+		return nil, err
+	}
+	return representatives, nil
+}
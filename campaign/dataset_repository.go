@@ -0,0 +1,83 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonesrussell/mp-emailer/database"
+)
+
+// datasetBatchSize is how many rows an import inserts per statement
+const datasetBatchSize = 1000
+
+// DatasetRepositoryInterface stores the offline representative dataset
+type DatasetRepositoryInterface interface {
+	// FindByPostalCode returns the representatives of the ridings a postal
+	// code lies in
+	FindByPostalCode(ctx context.Context, postalCode string) ([]Representative, error)
+	// Replace swaps the whole dataset for a new one
+	Replace(ctx context.Context, ridings []PostalCodeRiding, representatives []DatasetRepresentative) error
+}
+
+// DatasetRepository implements DatasetRepositoryInterface
+type DatasetRepository struct {
+	db database.Database
+}
+
+// NewDatasetRepository creates a new instance of DatasetRepository
+func NewDatasetRepository(params RepositoryParams) DatasetRepositoryInterface {
+	return &DatasetRepository{db: params.DB}
+}
+
+// FindByPostalCode implements DatasetRepositoryInterface
+func (r *DatasetRepository) FindByPostalCode(ctx context.Context, postalCode string) ([]Representative, error) {
+	var rows []DatasetRepresentative
+	err := r.db.DB().WithContext(ctx).
+		Joins("JOIN dataset_postal_codes p ON p.district_name = dataset_representatives.district_name"+
+			" AND (p.representative_set_name = '' OR"+
+			" p.representative_set_name = dataset_representatives.representative_set_name)").
+		Where("p.postal_code = ?", postalCode).
+		Order("dataset_representatives.representative_set_name, dataset_representatives.id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("error looking up postal code in dataset: %w", err)
+	}
+
+	representatives := make([]Representative, len(rows))
+	for i, row := range rows {
+		representatives[i] = row.Representative
+	}
+	return representatives, nil
+}
+
+// Replace implements DatasetRepositoryInterface. Lookups keep seeing the old
+// dataset until the new one is complete.
+func (r *DatasetRepository) Replace(
+	ctx context.Context,
+	ridings []PostalCodeRiding,
+	representatives []DatasetRepresentative,
+) error {
+	err := r.db.Transaction(ctx, func(tx database.Database) error {
+		if err := tx.DB().Where("1 = 1").Delete(&PostalCodeRiding{}).Error; err != nil {
+			return err
+		}
+		if err := tx.DB().Where("1 = 1").Delete(&DatasetRepresentative{}).Error; err != nil {
+			return err
+		}
+		if len(ridings) > 0 {
+			if err := tx.DB().CreateInBatches(ridings, datasetBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(representatives) > 0 {
+			if err := tx.DB().CreateInBatches(representatives, datasetBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error replacing representative dataset: %w", err)
+	}
+	return nil
+}
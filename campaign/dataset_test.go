@@ -0,0 +1,150 @@
+package campaign_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	mocksCampaign "github.com/jonesrussell/mp-emailer/mocks/campaign"
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePostalCodeRidings(t *testing.T) {
+	input := "\ufeffPostal Code,District Name,Representative Set Name,Province\n" +
+		"k1a 0a6,Ottawa Centre,House of Commons,ON\n" +
+		"K1A0A6,Ottawa Centre,House of Commons,ON\n" +
+		"K1A0A6,Ottawa Centre,,ON\n"
+
+	ridings, err := campaign.ParsePostalCodeRidings(strings.NewReader(input))
+
+	require.NoError(t, err)
+	assert.Equal(t, []campaign.PostalCodeRiding{
+		{PostalCode: "K1A0A6", DistrictName: "Ottawa Centre", RepresentativeSet: "House of Commons"},
+		{PostalCode: "K1A0A6", DistrictName: "Ottawa Centre"},
+	}, ridings)
+}
+
+func TestParsePostalCodeRidings_Rejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "no district column", input: "postal_code,riding\nK1A0A6,Ottawa Centre\n"},
+		{name: "invalid postal code", input: "postal_code,district_name\n12345,Ottawa Centre\n"},
+		{name: "missing district", input: "postal_code,district_name\nK1A0A6,\n"},
+		{name: "short row", input: "postal_code,district_name\nK1A0A6\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := campaign.ParsePostalCodeRidings(strings.NewReader(tt.input))
+			assert.ErrorIs(t, err, campaign.ErrInvalidDataset)
+		})
+	}
+}
+
+func TestParseDatasetRepresentatives(t *testing.T) {
+	jane := campaign.Representative{
+		Name:              "Jane Doe",
+		DistrictName:      "Ottawa Centre",
+		ElectedOffice:     "MP",
+		Email:             "jane.doe@parl.gc.ca",
+		RepresentativeSet: "House of Commons",
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		want   campaign.Representative
+	}{
+		{
+			name:   "API response",
+			format: campaign.DatasetFormatJSON,
+			input: `{"objects": [{"name": "Jane Doe", "district_name": "Ottawa Centre", "elected_office": "MP",
+				"email": "jane.doe@parl.gc.ca", "representative_set_name": "House of Commons"}], "meta": {}}`,
+			want: jane,
+		},
+		{
+			name:   "bare list without a legislature",
+			format: campaign.DatasetFormatJSON,
+			input: `[{"name": "Jane Doe", "district_name": "Ottawa Centre", "elected_office": "MP",
+				"email": "jane.doe@parl.gc.ca"}]`,
+			want: jane,
+		},
+		{
+			name:   "CSV export with offices",
+			format: campaign.DatasetFormatCSV,
+			input: "District name,Primary role,Name,Email,Office type,Phone,Office type 2,Address 2,Phone 2\n" +
+				"Ottawa Centre,MP,Jane Doe,jane.doe@parl.gc.ca,legislature,613-555-0100,constituency,\"1 Main St\",613-555-0199\n",
+			want: func() campaign.Representative {
+				rep := jane
+				rep.Offices = []campaign.Office{
+					{Type: "legislature", Tel: "613-555-0100"},
+					{Type: "constituency", Postal: "1 Main St", Tel: "613-555-0199"},
+				}
+				return rep
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reps, err := campaign.ParseDatasetRepresentatives(strings.NewReader(tt.input), tt.format, "House of Commons")
+
+			require.NoError(t, err)
+			require.Len(t, reps, 1)
+			assert.Equal(t, "Ottawa Centre", reps[0].DistrictName)
+			assert.Equal(t, "House of Commons", reps[0].RepresentativeSet)
+			assert.Equal(t, tt.want, reps[0].Representative)
+		})
+	}
+}
+
+func TestParseDatasetRepresentatives_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+	}{
+		{name: "unknown format", format: "xml", input: "<reps/>"},
+		{name: "malformed JSON", format: campaign.DatasetFormatJSON, input: `{"objects": [`},
+		{name: "no district", format: campaign.DatasetFormatJSON, input: `[{"name": "Jane Doe"}]`},
+		{name: "no name", format: campaign.DatasetFormatCSV, input: "district_name,name\nOttawa Centre,\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := campaign.ParseDatasetRepresentatives(strings.NewReader(tt.input), tt.format, "")
+			assert.ErrorIs(t, err, campaign.ErrInvalidDataset)
+		})
+	}
+}
+
+func TestDatasetFormatForPath(t *testing.T) {
+	assert.Equal(t, campaign.DatasetFormatCSV, campaign.DatasetFormatForPath("representatives.CSV"))
+	assert.Equal(t, campaign.DatasetFormatJSON, campaign.DatasetFormatForPath("representatives.json"))
+	assert.Equal(t, campaign.DatasetFormatJSON, campaign.DatasetFormatForPath("-"))
+}
+
+func TestDatasetProvider(t *testing.T) {
+	dataset := mocksCampaign.NewMockDatasetRepositoryInterface(t)
+	provider := campaign.NewDatasetProvider(campaign.DatasetProviderParams{
+		Dataset: dataset,
+		Logger:  mocksLogger.NewMockInterface(t),
+	})
+
+	jane := campaign.Representative{Name: "Jane Doe", DistrictName: "Ottawa Centre"}
+	dataset.EXPECT().FindByPostalCode(mock.Anything, "K1A0A6").Return([]campaign.Representative{jane}, nil).Once()
+
+	reps, err := provider.FetchRepresentatives(context.Background(), "k1a 0a6")
+
+	require.NoError(t, err)
+	assert.Equal(t, []campaign.Representative{jane}, reps)
+	assert.Equal(t, "dataset", provider.Name())
+}
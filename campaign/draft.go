@@ -0,0 +1,147 @@
+package campaign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	// draftAudience keeps draft tokens from being accepted anywhere else
+	draftAudience = "campaign-draft"
+	// defaultDraftTTL is how long a composed letter may be sent for when the
+	// configuration doesn't say
+	defaultDraftTTL = time.Hour
+)
+
+// draftClaims bind a composed letter to the campaign it was composed for
+// (the subject), the representative address found for the constituent's
+// postal code, and a digest of everything else the send form carries back.
+// Each token has its own ID, which the send records so it is only used once.
+type draftClaims struct {
+	Recipient  string `json:"rcpt"`
+	PostalCode string `json:"postal_code"`
+	Digest     string `json:"digest"`
+	jwt.RegisteredClaims
+}
+
+// draftSigner issues and verifies the tokens that let a composed letter be
+// sent without trusting the preview form
+type draftSigner struct {
+	key []byte
+	ttl time.Duration
+}
+
+// newDraftSigner derives the signing key from the application secret, so
+// that draft tokens and session tokens can never be swapped for each other
+func newDraftSigner(secret string, ttl time.Duration) *draftSigner {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(draftAudience))
+	if ttl <= 0 {
+		ttl = defaultDraftTTL
+	}
+	return &draftSigner{key: mac.Sum(nil), ttl: ttl}
+}
+
+// draft is what a draft token vouches for: a letter composed for a
+// representative found by looking up the constituent's postal code
+type draft struct {
+	CampaignID             string
+	RepresentativeName     string
+	RepresentativeEmail    string
+	RepresentativeOffice   string
+	RepresentativeDistrict string
+	PostalCode             string
+	Language               string
+	Content                string
+	// The constituent's contact details are carried to the send form so they
+	// can choose to share them; whether they do is theirs to change
+	ConstituentFirstName string
+	ConstituentLastName  string
+	ConstituentEmail     string
+}
+
+// digest hashes the parts of a draft the token doesn't hold itself
+func (d draft) digest() string {
+	h := sha256.New()
+	for _, part := range []string{
+		d.RepresentativeName,
+		d.RepresentativeOffice,
+		d.RepresentativeDistrict,
+		d.Language,
+		normalizeNewlines(d.Content),
+		d.ConstituentFirstName,
+		d.ConstituentLastName,
+		d.ConstituentEmail,
+	} {
+		// Length prefixes keep "ab"+"c" and "a"+"bc" apart
+		fmt.Fprintf(h, "%d:%s", len(part), part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// normalizeNewlines undoes the CRLF line endings browsers submit textareas with
+func normalizeNewlines(s string) string {
+	return strings.ReplaceAll(s, "\r\n", "\n")
+}
+
+// Issue signs a draft
+func (s *draftSigner) Issue(d draft, now time.Time) (string, error) {
+	claims := draftClaims{
+		Recipient:  strings.ToLower(d.RepresentativeEmail),
+		PostalCode: d.PostalCode,
+		Digest:     d.digest(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   d.CampaignID,
+			Audience:  jwt.ClaimStrings{draftAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.key)
+}
+
+// Verify checks that a token was issued for exactly this draft and hasn't
+// expired, and returns the token's ID
+func (s *draftSigner) Verify(token string, d draft) (string, error) {
+	if token == "" {
+		return "", fmt.Errorf("%w: missing draft token", ErrInvalidDraft)
+	}
+
+	claims := &draftClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(_ *jwt.Token) (interface{}, error) {
+		return s.key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+		jwt.WithAudience(draftAudience),
+		jwt.WithExpirationRequired(),
+	)
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return "", ErrDraftExpired
+	case err != nil:
+		return "", fmt.Errorf("%w: %w", ErrInvalidDraft, err)
+	}
+
+	switch {
+	case claims.ID == "":
+		return "", fmt.Errorf("%w: token has no ID", ErrInvalidDraft)
+	case claims.Subject != d.CampaignID:
+		return "", fmt.Errorf("%w: composed for another campaign", ErrInvalidDraft)
+	case claims.Recipient != strings.ToLower(d.RepresentativeEmail):
+		return "", fmt.Errorf("%w: recipient was not found for the postal code", ErrInvalidDraft)
+	case claims.PostalCode != d.PostalCode:
+		return "", fmt.Errorf("%w: postal code changed", ErrInvalidDraft)
+	case !hmac.Equal([]byte(claims.Digest), []byte(d.digest())):
+		return "", fmt.Errorf("%w: letter changed after it was composed", ErrInvalidDraft)
+	}
+	return claims.ID, nil
+}
@@ -0,0 +1,68 @@
+package campaign
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftSigner(t *testing.T) {
+	signer := newDraftSigner("secret", time.Hour)
+	d := draft{
+		CampaignID:          "123e4567-e89b-12d3-a456-426614174000",
+		RepresentativeName:  "Jane Doe",
+		RepresentativeEmail: "Jane.Doe@parl.gc.ca",
+		PostalCode:          "K1A0A6",
+		Content:             "<p>Hello</p>\n<p>Bye</p>",
+	}
+	now := time.Now()
+
+	token, err := signer.Issue(d, now)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		id, err := signer.Verify(token, d)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, id)
+	})
+
+	t.Run("every token has its own ID", func(t *testing.T) {
+		again, err := signer.Issue(d, now)
+		require.NoError(t, err)
+		first, err := signer.Verify(token, d)
+		require.NoError(t, err)
+		second, err := signer.Verify(again, d)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("recipient case and CRLF line endings", func(t *testing.T) {
+		submitted := d
+		submitted.RepresentativeEmail = "jane.doe@parl.gc.ca"
+		submitted.Content = "<p>Hello</p>\r\n<p>Bye</p>"
+		_, err := signer.Verify(token, submitted)
+		assert.NoError(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		old, err := signer.Issue(d, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+		_, err = signer.Verify(old, d)
+		assert.ErrorIs(t, err, ErrDraftExpired)
+	})
+
+	t.Run("signed with another secret", func(t *testing.T) {
+		_, err := newDraftSigner("other", time.Hour).Verify(token, d)
+		assert.ErrorIs(t, err, ErrInvalidDraft)
+	})
+
+	t.Run("session token with the same secret", func(t *testing.T) {
+		session, err := shared.GenerateToken("admin", "secret", 60)
+		require.NoError(t, err)
+		_, err = signer.Verify(session, d)
+		assert.ErrorIs(t, err, ErrInvalidDraft)
+	})
+}
@@ -1,15 +1,36 @@
 package campaign
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 // CreateCampaignDTO represents the data structure for creating a new campaign
 type CreateCampaignDTO struct {
-	Name        string    `validate:"required,min=3"`
-	Description string    `validate:"required"`
-	Template    string    `validate:"required"`
-	OwnerID     uuid.UUID `validate:"required"`
+	Name        string `validate:"required,min=3"`
+	Description string `validate:"required"`
+	Subject     string `validate:"omitempty,max=255"`
+	Template    string `validate:"required"`
+	// Language is the language of Subject and Template, English by default
+	Language string `validate:"omitempty,oneof=en fr"`
+	// Translations are checked by the service
+	Translations []Translation
+	Targets      []Target `validate:"omitempty,dive,oneof=MP provincial Mayor Councillor"`
+	Tags         []string `validate:"omitempty,max=10,dive,max=50"`
+	// CustomFields are checked by ValidateCustomFields
+	CustomFields        []CustomField
+	RequireConfirmation bool
+	CopyConstituent     bool
+	OwnerID             uuid.UUID `validate:"required"`
+	// OrganizationID optionally assigns the campaign to one of the owner's organizations
+	OrganizationID *uuid.UUID
+	// Status defaults to draft; only draft, scheduled and active are valid at creation
+	Status   Status `validate:"omitempty,oneof=draft scheduled active"`
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	// Tokens is filled in by the service from the parsed template
+	Tokens []string `json:"-"`
 }
 
 // UpdateCampaignDTO represents the data structure for updating an existing campaign
@@ -17,7 +38,22 @@ type UpdateCampaignDTO struct {
 	ID          uuid.UUID `validate:"required"`
 	Name        string    `validate:"required,min=3"`
 	Description string    `validate:"required"`
+	Subject     string    `validate:"omitempty,max=255"`
 	Template    string    `validate:"required"`
+	// Language is the language of Subject and Template, English by default
+	Language string `validate:"omitempty,oneof=en fr"`
+	// Translations are checked by the service
+	Translations []Translation
+	Targets      []Target `validate:"omitempty,dive,oneof=MP provincial Mayor Councillor"`
+	Tags         []string `validate:"omitempty,max=10,dive,max=50"`
+	// CustomFields are checked by ValidateCustomFields
+	CustomFields        []CustomField
+	RequireConfirmation bool
+	CopyConstituent     bool
+	StartsAt            *time.Time
+	EndsAt              *time.Time
+	// Tokens is filled in by the service from the parsed template
+	Tokens []string `json:"-"`
 }
 
 // GetCampaignDTO represents the data structure for getting a campaign
@@ -32,7 +68,80 @@ type ComposeEmailDTO struct {
 	UserData map[string]string `validate:"required"`
 }
 
+// ChangeStatusDTO represents the data structure for moving a campaign to a new state
+type ChangeStatusDTO struct {
+	ID     uuid.UUID `validate:"required"`
+	Status Status    `validate:"required,oneof=draft scheduled active paused closed archived"`
+}
+
+// InviteMemberDTO represents the data structure for inviting a collaborator
+type InviteMemberDTO struct {
+	CampaignID  uuid.UUID  `validate:"required"`
+	Email       string     `validate:"required,email"`
+	Role        MemberRole `validate:"required,oneof=editor viewer"`
+	InvitedByID uuid.UUID  `validate:"required"`
+}
+
+// AcceptInviteDTO represents the data structure for accepting an invitation
+type AcceptInviteDTO struct {
+	Token  string    `validate:"required"`
+	UserID uuid.UUID `validate:"required"`
+}
+
+// RemoveMemberDTO represents the data structure for removing a collaborator
+type RemoveMemberDTO struct {
+	CampaignID uuid.UUID `validate:"required"`
+	MemberID   uuid.UUID `validate:"required"`
+}
+
 // DeleteCampaignDTO represents the data structure for deleting a campaign
 type DeleteCampaignDTO struct {
 	ID uuid.UUID `validate:"required"`
 }
+
+// SendCampaignEmailDTO represents the data structure for sending a composed campaign email
+type SendCampaignEmailDTO struct {
+	CampaignID             uuid.UUID `validate:"required"`
+	RepresentativeName     string    `validate:"required"`
+	RepresentativeEmail    string    `validate:"required,email"`
+	RepresentativeOffice   string
+	RepresentativeDistrict string
+	PostalCode             string `validate:"required"`
+	Content                string `validate:"required"`
+	// Language is the language the content was composed in; it picks the subject
+	Language string `validate:"omitempty,oneof=en fr"`
+	// DraftToken is the token ComposeEmail issued with the content
+	DraftToken string `validate:"required"`
+	// The constituent's contact details are kept only with ContactConsent
+	ContactConsent       bool
+	ConstituentFirstName string `validate:"max=100"`
+	ConstituentLastName  string `validate:"max=100"`
+	ConstituentEmail     string `validate:"omitempty,email,max=255"`
+}
+
+// DuplicateCampaignDTO represents the data structure for copying a campaign
+// into a new draft
+type DuplicateCampaignDTO struct {
+	ID      uuid.UUID `validate:"required"`
+	OwnerID uuid.UUID `validate:"required"`
+	// Name overrides the default "Copy of ..." name
+	Name string `validate:"omitempty,min=3,max=255"`
+}
+
+// CreateFromStarterDTO represents the data structure for starting a campaign
+// from a starter template
+type CreateFromStarterDTO struct {
+	StarterID      uuid.UUID `validate:"required"`
+	OwnerID        uuid.UUID `validate:"required"`
+	OrganizationID *uuid.UUID
+	// Name overrides the starter template's name
+	Name string `validate:"omitempty,min=3,max=255"`
+}
+
+// ImportCampaignsDTO represents the data structure for importing a bundle.
+// Every imported campaign is owned by OwnerID, whoever owned it before.
+type ImportCampaignsDTO struct {
+	Bundle         *Bundle   `validate:"required"`
+	OwnerID        uuid.UUID `validate:"required"`
+	OrganizationID *uuid.UUID
+}
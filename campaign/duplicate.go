@@ -0,0 +1,79 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// maxNameLength matches the campaigns.name column
+const maxNameLength = 255
+
+// DuplicateCampaign copies a campaign's name, description, subject, template,
+// targets and custom fields into a new draft owned by dto.OwnerID. Anyone who can see the
+// campaign may copy it. The copy stays in the source's organization only
+// when the new owner may add campaigns to it.
+func (s *Service) DuplicateCampaign(ctx context.Context, dto *DuplicateCampaignDTO) (*Campaign, error) {
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	source, err := s.repo.GetByID(ctx, GetCampaignDTO{ID: dto.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	// Closed and draft campaigns are only visible to their team
+	if !source.IsOpen(time.Now()) {
+		if err := s.CheckPermission(ctx, source, dto.OwnerID, PermissionView); err != nil {
+			if errors.Is(err, ErrUnauthorizedAccess) {
+				return nil, ErrCampaignNotFound
+			}
+			return nil, err
+		}
+	}
+
+	name := dto.Name
+	if name == "" {
+		name = copyName(source.Name)
+	}
+
+	copyDTO := &CreateCampaignDTO{
+		Name:                name,
+		Description:         source.Description,
+		Subject:             source.Subject,
+		Language:            source.Language,
+		Template:            source.Template,
+		Translations:        append([]Translation(nil), source.Translations...),
+		Targets:             append([]Target(nil), source.Targets...),
+		CustomFields:        append([]CustomField(nil), source.CustomFields...),
+		OwnerID:             dto.OwnerID,
+		Status:              StatusDraft,
+		RequireConfirmation: source.RequireConfirmation,
+		CopyConstituent:     source.CopyConstituent,
+	}
+	if source.OrganizationID != nil &&
+		s.checkOrganizationPermission(ctx, *source.OrganizationID, dto.OwnerID, PermissionEdit) == nil {
+		copyDTO.OrganizationID = source.OrganizationID
+	}
+
+	campaign, err := s.CreateCampaign(ctx, copyDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("Campaign duplicated", "sourceID", source.ID, "id", campaign.ID)
+	return campaign, nil
+}
+
+// copyName names a duplicate after its source, keeping within the column size
+func copyName(name string) string {
+	name = "Copy of " + name
+	for len(name) > maxNameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
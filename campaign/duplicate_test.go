@@ -0,0 +1,115 @@
+package campaign_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *CampaignServiceTestSuite) TestDuplicateCampaign() {
+	userID := uuid.New()
+	orgID := uuid.New()
+
+	source := func(status campaign.Status) *campaign.Campaign {
+		return &campaign.Campaign{
+			BaseModel:      shared.BaseModel{ID: uuid.New()},
+			Name:           "Save the Library",
+			Description:    "Keep our branch open",
+			Template:       "Dear {{.representative.name}}, from {{.first_name}}",
+			Targets:        []campaign.Target{campaign.TargetMayor, campaign.TargetCouncillor},
+			Tags:           []string{"libraries"},
+			OwnerID:        uuid.New(),
+			OrganizationID: &orgID,
+			Status:         status,
+		}
+	}
+
+	expectCreated := func() {
+		s.mockLogger.EXPECT().Info("Campaign created successfully", "id", mock.AnythingOfType("uuid.UUID")).Once()
+		s.mockLogger.EXPECT().Info("Campaign duplicated",
+			"sourceID", mock.AnythingOfType("uuid.UUID"), "id", mock.AnythingOfType("uuid.UUID")).Once()
+	}
+
+	s.Run("copies an open campaign into a personal draft", func() {
+		src := source(campaign.StatusActive)
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: src.ID}).Return(src, nil).Once()
+		s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, userID).
+			Return("", organization.ErrMemberNotFound).Once()
+		s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+			return dto.Name == "Copy of Save the Library" &&
+				dto.Description == src.Description &&
+				dto.Template == src.Template &&
+				s.Equal(src.Targets, dto.Targets) &&
+				dto.Tags == nil &&
+				dto.OwnerID == userID &&
+				dto.OrganizationID == nil &&
+				dto.Status == campaign.StatusDraft
+		})).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}}, nil).Once()
+		expectCreated()
+
+		_, err := s.service.DuplicateCampaign(context.Background(), &campaign.DuplicateCampaignDTO{
+			ID:      src.ID,
+			OwnerID: userID,
+		})
+		s.NoError(err)
+	})
+
+	s.Run("keeps the organization for its editors", func() {
+		src := source(campaign.StatusClosed)
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: src.ID}).Return(src, nil).Once()
+		s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, userID).Return(organization.RoleEditor, nil).Times(3)
+		s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+			return dto.Name == "Spring Library Drive" && dto.OrganizationID != nil && *dto.OrganizationID == orgID
+		})).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}}, nil).Once()
+		expectCreated()
+
+		_, err := s.service.DuplicateCampaign(context.Background(), &campaign.DuplicateCampaignDTO{
+			ID:      src.ID,
+			OwnerID: userID,
+			Name:    "Spring Library Drive",
+		})
+		s.NoError(err)
+	})
+
+	s.Run("hides closed campaigns from outsiders", func() {
+		src := source(campaign.StatusDraft)
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: src.ID}).Return(src, nil).Once()
+		s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, userID).
+			Return("", organization.ErrMemberNotFound).Once()
+		s.mockMembers.EXPECT().GetByCampaignAndUser(mock.Anything, src.ID, userID).
+			Return(nil, campaign.ErrMemberNotFound).Once()
+
+		_, err := s.service.DuplicateCampaign(context.Background(), &campaign.DuplicateCampaignDTO{
+			ID:      src.ID,
+			OwnerID: userID,
+		})
+		s.ErrorIs(err, campaign.ErrCampaignNotFound)
+	})
+}
+
+func (s *CampaignServiceTestSuite) TestDuplicateCampaign_LongName() {
+	userID := uuid.New()
+	src := &campaign.Campaign{
+		BaseModel:   shared.BaseModel{ID: uuid.New()},
+		Name:        strings.Repeat("é", 127),
+		Description: "d",
+		Template:    "t",
+		OwnerID:     userID,
+		Status:      campaign.StatusDraft,
+	}
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: src.ID}).Return(src, nil).Once()
+	s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+		return len(dto.Name) <= 255 && strings.HasPrefix(dto.Name, "Copy of é") && strings.HasSuffix(dto.Name, "é")
+	})).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}}, nil).Once()
+	s.mockLogger.EXPECT().Info("Campaign created successfully", "id", mock.AnythingOfType("uuid.UUID")).Once()
+	s.mockLogger.EXPECT().Info("Campaign duplicated",
+		"sourceID", mock.AnythingOfType("uuid.UUID"), "id", mock.AnythingOfType("uuid.UUID")).Once()
+
+	_, err := s.service.DuplicateCampaign(context.Background(), &campaign.DuplicateCampaignDTO{ID: src.ID, OwnerID: userID})
+	s.NoError(err)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/jonesrussell/mp-emailer/httpclient"
 	"github.com/jonesrussell/mp-emailer/session"
 )
 
@@ -13,6 +14,35 @@ var (
 	ErrCampaignNotFound    = errors.New("campaign not found")
 	ErrInvalidCampaignID   = errors.New("invalid campaign ID")
 	ErrInvalidCampaignData = errors.New("invalid campaign data")
+	ErrInvalidTemplate     = errors.New("invalid campaign template")
+
+	ErrCampaignNotOpen         = errors.New("campaign is not accepting letters")
+	ErrInvalidStatusTransition = errors.New("invalid campaign status transition")
+	ErrInvalidSchedule         = errors.New("invalid campaign schedule")
+	ErrInvalidListParams       = errors.New("invalid campaign listing parameters")
+	ErrInvalidCustomFields     = errors.New("invalid custom fields")
+	ErrInvalidFieldValue       = errors.New("invalid custom field value")
+	ErrInvalidDraft            = errors.New("invalid letter draft")
+	ErrDraftExpired            = errors.New("letter draft has expired")
+	ErrDraftUsed               = errors.New("letter draft has already been sent")
+
+	ErrConfirmationRequired = errors.New("an email address is required to confirm the letter")
+	ErrMixedSubmission      = errors.New("letters submitted together have different constituent emails")
+	ErrConfirmationNotFound = errors.New("letter confirmation not found")
+	ErrConfirmationExpired  = errors.New("letter confirmation has expired")
+
+	ErrStarterTemplateNotFound = errors.New("starter template not found")
+
+	ErrInvalidBundle            = errors.New("invalid campaign bundle")
+	ErrUnsupportedBundleVersion = errors.New("unsupported campaign bundle version")
+
+	ErrInvalidDataset = errors.New("invalid representative dataset")
+
+	ErrMemberNotFound  = errors.New("campaign member not found")
+	ErrMemberExists    = errors.New("already a member of this campaign")
+	ErrInviteNotFound  = errors.New("invitation not found")
+	ErrInviteExpired   = errors.New("invitation has expired")
+	ErrInviteWrongUser = errors.New("invitation was sent to another email address")
 
 	ErrUnauthorizedAccess = errors.New("unauthorized access")
 	ErrUserNotFound       = errors.New("user not found in session")
@@ -33,10 +63,62 @@ func mapErrorToHTTPStatus(err error) (int, string) {
 		return http.StatusUnauthorized, "Unauthorized access"
 	case errors.Is(err, ErrInvalidCampaignData):
 		return http.StatusBadRequest, "Invalid campaign data"
+	case errors.Is(err, ErrInvalidTemplate):
+		return http.StatusBadRequest, "Invalid campaign template"
+	case errors.Is(err, ErrCampaignNotOpen):
+		return http.StatusForbidden, "This campaign is not currently accepting letters"
+	case errors.Is(err, ErrInvalidStatusTransition):
+		return http.StatusConflict, "Campaign cannot move to that status"
+	case errors.Is(err, ErrInvalidSchedule):
+		return http.StatusBadRequest, "Invalid campaign schedule"
+	case errors.Is(err, ErrInvalidCustomFields):
+		return http.StatusBadRequest, "Invalid custom fields"
+	case errors.Is(err, ErrInvalidFieldValue):
+		return http.StatusBadRequest, "Please check your answers"
+	case errors.Is(err, ErrInvalidDraft):
+		return http.StatusBadRequest, "This letter can't be sent as submitted. Please compose it again"
+	case errors.Is(err, ErrDraftExpired):
+		return http.StatusGone, "This letter has expired. Please compose it again"
+	case errors.Is(err, ErrDraftUsed):
+		return http.StatusConflict, "This letter has already been sent"
+	case errors.Is(err, ErrMixedSubmission):
+		return http.StatusBadRequest, "All of your letters must be sent from the same email address"
+	case errors.Is(err, ErrConfirmationRequired):
+		return http.StatusBadRequest, "Please enter your email address so we can confirm your letter"
+	case errors.Is(err, ErrConfirmationNotFound):
+		return http.StatusNotFound, "Confirmation link not found"
+	case errors.Is(err, ErrConfirmationExpired):
+		return http.StatusGone, "This confirmation link has expired. Please write your letter again"
+	case errors.Is(err, ErrInvalidListParams):
+		return http.StatusBadRequest, "Invalid page, sort or filter"
+	case errors.Is(err, ErrStarterTemplateNotFound):
+		return http.StatusNotFound, "Starter template not found"
+	case errors.Is(err, ErrInvalidBundle):
+		return http.StatusBadRequest, "Invalid campaign bundle"
+	case errors.Is(err, ErrUnsupportedBundleVersion):
+		return http.StatusBadRequest, "Unsupported campaign bundle version"
+	case errors.Is(err, ErrMemberNotFound):
+		return http.StatusNotFound, "Member not found"
+	case errors.Is(err, ErrMemberExists):
+		return http.StatusConflict, "That person is already a member of this campaign"
+	case errors.Is(err, ErrInviteNotFound):
+		return http.StatusNotFound, "Invitation not found"
+	case errors.Is(err, ErrInviteExpired):
+		return http.StatusGone, "Invitation has expired"
+	case errors.Is(err, ErrInviteWrongUser):
+		return http.StatusForbidden, "This invitation was sent to another email address. Sign in with that address to accept it"
 	case errors.Is(err, ErrInvalidPostalCode):
 		return http.StatusBadRequest, "Invalid postal code"
 	case errors.Is(err, ErrNoRepresentatives):
 		return http.StatusNotFound, "No representatives found"
+	case errors.Is(err, httpclient.ErrCircuitOpen), errors.Is(err, httpclient.ErrUnavailable):
+		return http.StatusServiceUnavailable, "Representative lookup is unavailable right now. Please try again in a few minutes"
+	case errors.Is(err, httpclient.ErrRateLimited):
+		return http.StatusServiceUnavailable, "Representative lookup is busy right now. Please try again shortly"
+	case errors.Is(err, httpclient.ErrTimeout):
+		return http.StatusGatewayTimeout, "Representative lookup took too long. Please try again"
+	case errors.Is(err, httpclient.ErrInvalidResponse), errors.As(err, new(*httpclient.StatusError)):
+		return http.StatusBadGateway, "Representative lookup failed. Please try again later"
 	case errors.Is(err, ErrDatabaseOperation):
 		return http.StatusInternalServerError, "Internal server error"
 	case errors.Is(err, validator.ValidationErrors{}):
@@ -0,0 +1,234 @@
+package campaign
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jonesrussell/mp-emailer/templating"
+)
+
+// FieldType is the input a custom field is answered with
+type FieldType string
+
+const (
+	FieldTypeText     FieldType = "text"
+	FieldTypeTextarea FieldType = "textarea"
+	FieldTypeSelect   FieldType = "select"
+	FieldTypeCheckbox FieldType = "checkbox"
+)
+
+// FieldTypes lists the custom field types in the order the editor offers them
+//
+//nolint:gochecknoglobals
+var FieldTypes = []FieldType{FieldTypeText, FieldTypeTextarea, FieldTypeSelect, FieldTypeCheckbox}
+
+// Limits on custom field definitions and answers
+const (
+	MaxCustomFields     = 20
+	maxFieldLabelLength = 100
+	maxFieldOptions     = 50
+	maxTextAnswerLength = 255
+	maxLongAnswerLength = 5000
+)
+
+const (
+	// customFieldsVariable holds the answers in letter templates
+	customFieldsVariable = "fields"
+	// customFieldInputPrefix starts the send form input name of every custom field
+	customFieldInputPrefix = "field_"
+)
+
+// fieldKeyPattern restricts keys to names usable in template actions
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// CustomField is an extra question a campaign asks on its send form. The
+// answer is available to the letter template as {{.fields.<key>}}; checkbox
+// answers are booleans and everything else is a string.
+type CustomField struct {
+	Key      string    `json:"key" yaml:"key"`
+	Label    string    `json:"label" yaml:"label"`
+	Type     FieldType `json:"type" yaml:"type"`
+	Required bool      `json:"required,omitempty" yaml:"required,omitempty"`
+	// Options are the choices of a select field
+	Options []string `json:"options,omitempty" yaml:"options,omitempty"`
+	// MinLength, MaxLength and Pattern constrain text and textarea answers.
+	// Pattern is Go regexp syntax, so it is only checked on the server;
+	// browsers would read it as a JavaScript regular expression.
+	MinLength int    `json:"min_length,omitempty" yaml:"min_length,omitempty"`
+	MaxLength int    `json:"max_length,omitempty" yaml:"max_length,omitempty"`
+	Pattern   string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+}
+
+// Variable returns the template variable holding the field's answer
+func (f CustomField) Variable() string {
+	return customFieldsVariable + "." + f.Key
+}
+
+// InputName returns the name of the field's send form input. The prefix
+// keeps custom fields from colliding with the built-in constituent fields.
+func (f CustomField) InputName() string {
+	return customFieldInputPrefix + f.Key
+}
+
+// AnswerLimit returns the longest answer a text or textarea field accepts
+func (f CustomField) AnswerLimit() int {
+	if f.MaxLength > 0 {
+		return f.MaxLength
+	}
+	if f.Type == FieldTypeTextarea {
+		return maxLongAnswerLength
+	}
+	return maxTextAnswerLength
+}
+
+// ValidateCustomFields checks a campaign's custom field definitions
+func ValidateCustomFields(fields []CustomField) error {
+	if len(fields) > MaxCustomFields {
+		return fmt.Errorf("%w: at most %d fields are allowed", ErrInvalidCustomFields, MaxCustomFields)
+	}
+
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if err := f.validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidCustomFields, err)
+		}
+		if seen[f.Key] {
+			return fmt.Errorf("%w: the key %q is used more than once", ErrInvalidCustomFields, f.Key)
+		}
+		seen[f.Key] = true
+	}
+	return nil
+}
+
+func (f CustomField) validate() error {
+	if !fieldKeyPattern.MatchString(f.Key) {
+		return fmt.Errorf("key %q must start with a letter and use only lowercase letters, digits and underscores", f.Key)
+	}
+	if label := strings.TrimSpace(f.Label); label == "" || utf8.RuneCountInString(label) > maxFieldLabelLength {
+		return fmt.Errorf("%s needs a label of at most %d characters", f.Key, maxFieldLabelLength)
+	}
+
+	switch f.Type {
+	case FieldTypeText, FieldTypeTextarea:
+		if len(f.Options) > 0 {
+			return fmt.Errorf("%s: only select fields have options", f.Key)
+		}
+		if f.MinLength < 0 || f.MaxLength < 0 || f.MinLength > f.AnswerLimit() {
+			return fmt.Errorf("%s: invalid length limits", f.Key)
+		}
+		if f.Pattern != "" {
+			if _, err := regexp.Compile(f.Pattern); err != nil {
+				return fmt.Errorf("%s: invalid pattern: %w", f.Key, err)
+			}
+		}
+	case FieldTypeSelect:
+		if len(f.Options) == 0 || len(f.Options) > maxFieldOptions {
+			return fmt.Errorf("%s: select fields need between 1 and %d options", f.Key, maxFieldOptions)
+		}
+		for _, option := range f.Options {
+			if strings.TrimSpace(option) == "" {
+				return fmt.Errorf("%s: options can't be blank", f.Key)
+			}
+		}
+	case FieldTypeCheckbox:
+	default:
+		return fmt.Errorf("%s: unknown field type %q", f.Key, f.Type)
+	}
+
+	if f.Type != FieldTypeText && f.Type != FieldTypeTextarea &&
+		(f.MinLength != 0 || f.MaxLength != 0 || f.Pattern != "") {
+		return fmt.Errorf("%s: only text fields have length limits and patterns", f.Key)
+	}
+	return nil
+}
+
+// FieldValueError reports an answer that doesn't satisfy its field
+type FieldValueError struct {
+	Label   string
+	Message string
+}
+
+func (e *FieldValueError) Error() string {
+	return e.Label + " " + e.Message
+}
+
+func (e *FieldValueError) Unwrap() error {
+	return ErrInvalidFieldValue
+}
+
+// CustomFieldValues checks the submitted answers, keyed by input name, and
+// returns them keyed by field for the letter template. Every field gets a
+// value, so templates may reference optional fields that were left blank.
+func CustomFieldValues(fields []CustomField, input map[string]string) (templating.Data, error) {
+	values := make(templating.Data, len(fields))
+	for _, f := range fields {
+		raw := input[f.InputName()]
+
+		if f.Type == FieldTypeCheckbox {
+			checked := raw != ""
+			if f.Required && !checked {
+				return nil, &FieldValueError{Label: f.Label, Message: "must be checked"}
+			}
+			values[f.Key] = checked
+			continue
+		}
+
+		value := strings.TrimSpace(raw)
+		if value == "" {
+			if f.Required {
+				return nil, &FieldValueError{Label: f.Label, Message: "is required"}
+			}
+			values[f.Key] = ""
+			continue
+		}
+
+		switch f.Type {
+		case FieldTypeSelect:
+			if !f.HasOption(value) {
+				return nil, &FieldValueError{Label: f.Label, Message: "must be one of the listed options"}
+			}
+		default:
+			length := utf8.RuneCountInString(value)
+			if length < f.MinLength {
+				return nil, &FieldValueError{Label: f.Label,
+					Message: fmt.Sprintf("must be at least %d characters", f.MinLength)}
+			}
+			if length > f.AnswerLimit() {
+				return nil, &FieldValueError{Label: f.Label,
+					Message: fmt.Sprintf("must be at most %d characters", f.AnswerLimit())}
+			}
+			if f.Pattern != "" {
+				pattern, err := regexp.Compile(`^(?:` + f.Pattern + `)$`)
+				if err != nil {
+					return nil, fmt.Errorf("%w: %s: invalid pattern: %w", ErrInvalidCustomFields, f.Key, err)
+				}
+				if !pattern.MatchString(value) {
+					return nil, &FieldValueError{Label: f.Label, Message: "is not in the expected format"}
+				}
+			}
+		}
+		values[f.Key] = value
+	}
+	return values, nil
+}
+
+// HasOption reports whether value is one of a select field's options
+func (f CustomField) HasOption(value string) bool {
+	for _, option := range f.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// customFieldVariables returns the template variables of the fields
+func customFieldVariables(fields []CustomField) []string {
+	vars := make([]string, len(fields))
+	for i, f := range fields {
+		vars[i] = f.Variable()
+	}
+	return vars
+}
@@ -0,0 +1,152 @@
+package campaign_test
+
+import (
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/templating"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCustomFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []campaign.CustomField
+		wantErr bool
+	}{
+		{
+			name: "valid fields",
+			fields: []campaign.CustomField{
+				{Key: "nurse", Label: "Are you a nurse?", Type: campaign.FieldTypeCheckbox},
+				{Key: "phone", Label: "Phone", Type: campaign.FieldTypeText, Pattern: `[0-9 ()+-]+`, MaxLength: 20},
+				{Key: "story", Label: "Your story", Type: campaign.FieldTypeTextarea, Required: true},
+				{Key: "region", Label: "Region", Type: campaign.FieldTypeSelect, Options: []string{"North", "South"}},
+			},
+		},
+		{
+			name:    "invalid key",
+			fields:  []campaign.CustomField{{Key: "Phone Number", Label: "Phone", Type: campaign.FieldTypeText}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate key",
+			fields: []campaign.CustomField{
+				{Key: "phone", Label: "Phone", Type: campaign.FieldTypeText},
+				{Key: "phone", Label: "Cell", Type: campaign.FieldTypeText},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing label",
+			fields:  []campaign.CustomField{{Key: "phone", Label: " ", Type: campaign.FieldTypeText}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			fields:  []campaign.CustomField{{Key: "age", Label: "Age", Type: "number"}},
+			wantErr: true,
+		},
+		{
+			name:    "select without options",
+			fields:  []campaign.CustomField{{Key: "region", Label: "Region", Type: campaign.FieldTypeSelect}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid pattern",
+			fields:  []campaign.CustomField{{Key: "phone", Label: "Phone", Type: campaign.FieldTypeText, Pattern: "[0-9"}},
+			wantErr: true,
+		},
+		{
+			name:    "pattern on a checkbox",
+			fields:  []campaign.CustomField{{Key: "nurse", Label: "Nurse", Type: campaign.FieldTypeCheckbox, Pattern: "on"}},
+			wantErr: true,
+		},
+		{
+			name:    "minimum beyond maximum",
+			fields:  []campaign.CustomField{{Key: "story", Label: "Story", Type: campaign.FieldTypeText, MinLength: 30, MaxLength: 10}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := campaign.ValidateCustomFields(tt.fields)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, campaign.ErrInvalidCustomFields)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCustomFieldValues(t *testing.T) {
+	fields := []campaign.CustomField{
+		{Key: "nurse", Label: "Are you a nurse?", Type: campaign.FieldTypeCheckbox},
+		{Key: "phone", Label: "Phone", Type: campaign.FieldTypeText, Pattern: `[0-9 -]+`, MinLength: 7},
+		{Key: "story", Label: "Your story", Type: campaign.FieldTypeTextarea, Required: true, MaxLength: 20},
+		{Key: "region", Label: "Region", Type: campaign.FieldTypeSelect, Options: []string{"North", "South"}},
+	}
+
+	tests := []struct {
+		name    string
+		input   map[string]string
+		want    templating.Data
+		wantErr string
+	}{
+		{
+			name: "all answered",
+			input: map[string]string{
+				"field_nurse":  "on",
+				"field_phone":  "807 555-0100",
+				"field_story":  "  I work nights  ",
+				"field_region": "North",
+			},
+			want: templating.Data{"nurse": true, "phone": "807 555-0100", "story": "I work nights", "region": "North"},
+		},
+		{
+			name:  "optional fields left blank",
+			input: map[string]string{"field_story": "Short story"},
+			want:  templating.Data{"nurse": false, "phone": "", "story": "Short story", "region": ""},
+		},
+		{
+			name:    "required field missing",
+			input:   map[string]string{},
+			wantErr: "Your story is required",
+		},
+		{
+			name:    "answer too long",
+			input:   map[string]string{"field_story": "This story is far too long to fit"},
+			wantErr: "Your story must be at most 20 characters",
+		},
+		{
+			name:    "answer too short",
+			input:   map[string]string{"field_story": "ok", "field_phone": "555"},
+			wantErr: "Phone must be at least 7 characters",
+		},
+		{
+			name:    "pattern must match the whole answer",
+			input:   map[string]string{"field_story": "ok", "field_phone": "call 807 555-0100"},
+			wantErr: "Phone is not in the expected format",
+		},
+		{
+			name:    "unlisted option",
+			input:   map[string]string{"field_story": "ok", "field_region": "East"},
+			wantErr: "Region must be one of the listed options",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := campaign.CustomFieldValues(fields, tt.input)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, campaign.ErrInvalidFieldValue)
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,9 +6,10 @@ import (
 	"html/template"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/jonesrussell/mp-emailer/email"
+	"github.com/jonesrussell/mp-emailer/organization"
 	"github.com/jonesrussell/mp-emailer/session"
 	"github.com/jonesrussell/mp-emailer/shared"
 	"github.com/labstack/echo/v4"
@@ -19,8 +20,7 @@ type Handler struct {
 	shared.BaseHandler
 	service                     ServiceInterface
 	representativeLookupService RepresentativeLookupServiceInterface
-	emailService                email.Service
-	client                      ClientInterface
+	orgs                        organization.ServiceInterface
 }
 
 // HandlerParams for dependency injection
@@ -29,8 +29,7 @@ type HandlerParams struct {
 	fx.In
 	Service                     ServiceInterface
 	RepresentativeLookupService RepresentativeLookupServiceInterface
-	EmailService                email.Service
-	Client                      ClientInterface
+	Orgs                        organization.ServiceInterface
 }
 
 // HandlerResult is the output struct for NewHandler
@@ -48,8 +47,7 @@ func NewHandler(params HandlerParams) (HandlerResult, error) {
 		BaseHandler:                 base,
 		service:                     params.Service,
 		representativeLookupService: params.RepresentativeLookupService,
-		emailService:                params.EmailService,
-		client:                      params.Client,
+		orgs:                        params.Orgs,
 	}
 	return HandlerResult{Handler: handler}, nil
 }
@@ -76,9 +74,18 @@ func (h *Handler) CampaignGET(c echo.Context) error {
 	userID, err := h.GetUserIDFromSession(c)
 	isAuthenticated := err == nil && userID != ""
 
-	// Optional: Add ownership check
-	if isAuthenticated && campaign.OwnerID.String() != userID {
-		return h.ErrorHandler.HandleHTTPError(c, ErrUnauthorizedAccess, "Unauthorized", http.StatusUnauthorized)
+	var canView, canEdit, canManage bool
+	if isAuthenticated {
+		canManage = h.authorize(c, campaign, userID, PermissionManage) == nil
+		canEdit = canManage || h.authorize(c, campaign, userID, PermissionEdit) == nil
+		canView = canEdit || h.authorize(c, campaign, userID, PermissionView) == nil
+	}
+
+	// Campaigns that are not accepting letters are only visible to their team
+	isOpen := campaign.IsOpen(time.Now())
+	if !canView && !isOpen {
+		status, msg := h.MapError(ErrCampaignNotFound)
+		return h.ErrorHandler.HandleHTTPError(c, ErrCampaignNotFound, msg, status)
 	}
 
 	data := shared.Data{
@@ -86,28 +93,87 @@ func (h *Handler) CampaignGET(c echo.Context) error {
 		PageName:        "campaign",
 		IsAuthenticated: isAuthenticated,
 		Content: map[string]interface{}{
-			"Campaign": campaign,
+			"Campaign":    campaign,
+			"IsOpen":      isOpen,
+			"CanEdit":     canEdit,
+			"CanManage":   canManage,
+			"Transitions": campaign.Status.Transitions(),
 		},
 	}
 
 	return c.Render(http.StatusOK, "campaign", data)
 }
 
-// GetCampaigns handles GET requests for all campaigns
+// GetCampaigns handles GET requests for the campaign listing. Visitors see
+// open campaigns; signed-in users may pass owner=me to page through their own
+// campaigns in any state.
 func (h *Handler) GetCampaigns(c echo.Context) error {
 	h.Logger.Debug("Handling GetCampaigns request")
-	campaigns, err := h.service.GetCampaigns(c.Request().Context())
+
+	params, err := ParseListQuery(c.QueryParams())
 	if err != nil {
 		status, msg := h.MapError(err)
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
-	h.Logger.Debug("Rendering all campaigns", "count", len(campaigns))
-	data := shared.Data{
-		Title:    "Campaigns",
-		PageName: "campaigns",
-		Content: map[string]interface{}{
-			"Campaigns": campaigns,
+
+	userID, err := h.GetUserIDFromSession(c)
+	isAuthenticated := err == nil && userID != ""
+	ownerID, parseErr := uuid.Parse(userID)
+	isAuthenticated = isAuthenticated && parseErr == nil
+
+	onlyMine := isAuthenticated && c.QueryParam("owner") == "me"
+	if onlyMine {
+		params.OwnerID = &ownerID
+	} else {
+		// Other people's drafts and closed campaigns are never listed
+		now := time.Now()
+		params.Status = ""
+		params.OpenAt = &now
+	}
+
+	page, err := h.service.ListCampaigns(c.Request().Context(), params)
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	query := c.QueryParams()
+	content := map[string]interface{}{
+		"Campaigns": page.Campaigns,
+		"Page":      page,
+		"OnlyMine":  onlyMine,
+		"Snippets":  page.Snippets,
+		"Filters": map[string]string{
+			"Query":  params.Query,
+			"Tag":    params.Tag,
+			"Status": string(params.Status),
+			"Sort":   query.Get("sort"),
+			"Order":  query.Get("order"),
 		},
+		"Statuses": allStatuses,
+	}
+	if page.HasPrev() {
+		content["PrevURL"] = PageURL("/campaigns", query, page.Page-1)
+	}
+	if page.HasNext() {
+		content["NextURL"] = PageURL("/campaigns", query, page.Page+1)
+	}
+
+	if isAuthenticated && !onlyMine {
+		sharedWithMe, err := h.service.GetSharedCampaigns(c.Request().Context(), ownerID)
+		if err != nil {
+			status, msg := h.MapError(err)
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		content["SharedCampaigns"] = sharedWithMe
+	}
+
+	h.Logger.Debug("Rendering campaigns", "count", len(page.Campaigns), "total", page.Total)
+	data := shared.Data{
+		Title:           "Campaigns",
+		PageName:        "campaigns",
+		IsAuthenticated: isAuthenticated,
+		Content:         content,
 	}
 	return c.Render(http.StatusOK, "campaigns", data)
 }
@@ -115,10 +181,83 @@ func (h *Handler) GetCampaigns(c echo.Context) error {
 // CreateCampaignForm handles GET requests for the campaign creation form
 func (h *Handler) CreateCampaignForm(c echo.Context) error {
 	h.Logger.Debug("Handling CreateCampaignForm request")
-	return c.Render(http.StatusOK, "campaign_create", shared.Data{
+	userID, _ := h.GetUserIDFromSession(c)
+
+	// The starter template library links here to prefill the form
+	if id := c.QueryParam("starter"); id != "" {
+		starterID, err := uuid.Parse(id)
+		if err != nil {
+			status, msg := h.MapError(ErrStarterTemplateNotFound)
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		starter, err := h.service.GetStarterTemplate(c.Request().Context(), starterID)
+		if err != nil {
+			status, msg := h.MapError(err)
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		params := &CreateCampaignParams{
+			Name:        starter.Name,
+			Description: starter.Description,
+			Template:    starter.Template,
+			Targets:     starter.Targets,
+		}
+		if orgID, err := uuid.Parse(c.QueryParam("organization_id")); err == nil {
+			params.OrganizationID = &orgID
+		}
+		return h.renderCreateForm(c, http.StatusOK, userID, params, nil)
+	}
+
+	return h.renderCreateForm(c, http.StatusOK, userID, nil, nil)
+}
+
+// renderCreateForm renders the campaign creation form, offering the
+// organizations the user belongs to as owners
+func (h *Handler) renderCreateForm(
+	c echo.Context,
+	code int,
+	userID string,
+	params *CreateCampaignParams,
+	errs []string,
+) error {
+	content := map[string]interface{}{
+		"Targets":         AllTargets,
+		"Statuses":        creatableStatuses,
+		"Placeholders":    Placeholders(),
+		"FieldTypes":      FieldTypes,
+		"CustomFieldRows": customFieldRows(nil),
+		"Languages":       LanguageOptions(),
+		"TranslationRows": translationRows(DefaultLanguage, nil),
+		"Organizations":   []organization.Organization{},
+		// The organization dashboard links here with its organization preselected
+		"SelectedOrganization": c.QueryParam("organization_id"),
+	}
+	if params != nil {
+		content["FormValues"] = params
+		content["Placeholders"] = Placeholders(params.CustomFields...)
+		content["CustomFieldRows"] = customFieldRows(params.CustomFields)
+		content["TranslationRows"] = translationRows(params.Language, params.Translations)
+		content["SelectedOrganization"] = ""
+		if params.OrganizationID != nil {
+			content["SelectedOrganization"] = params.OrganizationID.String()
+		}
+	}
+	if len(errs) > 0 {
+		content["Errors"] = errs
+	}
+
+	if id, err := uuid.Parse(userID); err == nil {
+		orgs, err := h.orgs.ListForUser(c.Request().Context(), id)
+		if err != nil {
+			h.Logger.Error("Failed to list organizations for campaign form", err, "userID", userID)
+		} else {
+			content["Organizations"] = orgs
+		}
+	}
+
+	return c.Render(code, "campaign_create", shared.Data{
 		Title:    "Create Campaign",
 		PageName: "campaign_create",
-		Content:  nil,
+		Content:  content,
 	})
 }
 
@@ -137,14 +276,38 @@ func (h *Handler) CreateCampaign(c echo.Context) error {
 
 	// Parse and validate form data
 	params := &CreateCampaignParams{
-		Name:        strings.TrimSpace(c.FormValue("name")),
-		Description: strings.TrimSpace(c.FormValue("description")),
-		Template:    strings.TrimSpace(c.FormValue("template")),
-		OwnerID:     uuid.Must(uuid.Parse(userID)),
+		Name:                strings.TrimSpace(c.FormValue("name")),
+		Description:         strings.TrimSpace(c.FormValue("description")),
+		Subject:             strings.TrimSpace(c.FormValue("subject")),
+		Language:            c.FormValue("language"),
+		Template:            strings.TrimSpace(c.FormValue("template")),
+		Translations:        formTranslations(c),
+		Targets:             formTargets(c),
+		Tags:                formTags(c),
+		OwnerID:             uuid.Must(uuid.Parse(userID)),
+		Status:              Status(c.FormValue("status")),
+		RequireConfirmation: c.FormValue("require_confirmation") == "on",
+		CopyConstituent:     c.FormValue("copy_constituent") == "on",
 	}
 
 	// Enhanced validation with specific error messages
 	var validationErrors []string
+	if params.StartsAt, err = formTime(c, "starts_at"); err != nil {
+		validationErrors = append(validationErrors, "Start time is invalid")
+	}
+	if params.EndsAt, err = formTime(c, "ends_at"); err != nil {
+		validationErrors = append(validationErrors, "End time is invalid")
+	}
+	if params.CustomFields, err = formCustomFields(c); err != nil {
+		validationErrors = append(validationErrors, err.Error())
+	}
+	if orgID := c.FormValue("organization_id"); orgID != "" {
+		if id, parseErr := uuid.Parse(orgID); parseErr == nil {
+			params.OrganizationID = &id
+		} else {
+			validationErrors = append(validationErrors, "Organization is invalid")
+		}
+	}
 	if params.Name == "" {
 		validationErrors = append(validationErrors, "Name is required")
 	}
@@ -161,14 +324,7 @@ func (h *Handler) CreateCampaign(c echo.Context) error {
 			"name", params.Name,
 			"description", params.Description)
 
-		return c.Render(http.StatusBadRequest, "campaign_create", shared.Data{
-			Title:    "Create Campaign",
-			PageName: "campaign_create",
-			Content: map[string]interface{}{
-				"Errors":     validationErrors,
-				"FormValues": params,
-			},
-		})
+		return h.renderCreateForm(c, http.StatusBadRequest, userID, params, validationErrors)
 	}
 
 	h.Logger.Debug("CreateCampaign: Validation passed, creating campaign",
@@ -177,10 +333,22 @@ func (h *Handler) CreateCampaign(c echo.Context) error {
 
 	// Create campaign DTO
 	dto := &CreateCampaignDTO{
-		Name:        params.Name,
-		Description: params.Description,
-		Template:    params.Template,
-		OwnerID:     params.OwnerID,
+		Name:                params.Name,
+		Description:         params.Description,
+		Subject:             params.Subject,
+		Language:            params.Language,
+		Template:            params.Template,
+		Translations:        params.Translations,
+		Targets:             params.Targets,
+		Tags:                params.Tags,
+		CustomFields:        params.CustomFields,
+		OwnerID:             params.OwnerID,
+		OrganizationID:      params.OrganizationID,
+		Status:              params.Status,
+		RequireConfirmation: params.RequireConfirmation,
+		CopyConstituent:     params.CopyConstituent,
+		StartsAt:            params.StartsAt,
+		EndsAt:              params.EndsAt,
 	}
 
 	// Create campaign
@@ -189,6 +357,14 @@ func (h *Handler) CreateCampaign(c echo.Context) error {
 		h.Logger.Error("CreateCampaign: Failed to create campaign", err,
 			"ownerID", userID,
 			"name", params.Name)
+		if errors.Is(err, ErrInvalidTemplate) || errors.Is(err, ErrInvalidSchedule) ||
+			errors.Is(err, ErrInvalidCustomFields) {
+			return h.renderCreateForm(c, http.StatusBadRequest, userID, params, []string{err.Error()})
+		}
+		if errors.Is(err, ErrUnauthorizedAccess) {
+			return h.renderCreateForm(c, http.StatusForbidden, userID, params,
+				[]string{"Only organization admins and editors can add campaigns to it"})
+		}
 		status, msg := h.MapError(err)
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
@@ -239,8 +415,9 @@ func (h *Handler) DeleteCampaign(c echo.Context) error {
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
-	if campaign.OwnerID.String() != userID {
-		return h.ErrorHandler.HandleHTTPError(c, ErrUnauthorizedAccess, "Unauthorized", http.StatusUnauthorized)
+	if err := h.authorize(c, campaign, userID, PermissionManage); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
 	if err := h.service.DeleteCampaign(c.Request().Context(), DeleteCampaignDTO{ID: campaignID}); err != nil {
@@ -256,6 +433,43 @@ func (h *Handler) DeleteCampaign(c echo.Context) error {
 	return c.Redirect(http.StatusSeeOther, "/campaigns")
 }
 
+// DuplicateCampaign handles POST requests to copy a campaign into a new
+// draft, then opens the copy for editing
+func (h *Handler) DuplicateCampaign(c echo.Context) error {
+	h.Logger.Debug("Handling DuplicateCampaign request")
+
+	userID, err := h.GetUserIDFromSession(c)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+	ownerID, err := uuid.Parse(userID)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	campaignID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		status, msg := h.MapError(ErrInvalidCampaignID)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	duplicate, err := h.service.DuplicateCampaign(c.Request().Context(), &DuplicateCampaignDTO{
+		ID:      campaignID,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.AddFlashMessage(c, "Campaign duplicated. Your copy is a draft."); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	h.Logger.Info("Campaign duplicated", "sourceID", campaignID, "campaignID", duplicate.ID)
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+duplicate.ID.String()+"/edit")
+}
+
 // EditCampaignForm handles GET requests for the campaign edit form
 func (h *Handler) EditCampaignForm(c echo.Context) error {
 	h.Logger.Debug("Handling EditCampaignForm request")
@@ -307,15 +521,13 @@ func (h *Handler) EditCampaignForm(c echo.Context) error {
 		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
 	}
 
-	if campaign.OwnerID.String() != userID {
-		h.Logger.Error("Unauthorized access attempt", nil,
+	if err := h.authorize(c, campaign, userID, PermissionEdit); err != nil {
+		h.Logger.Error("Unauthorized access attempt", err,
 			"campaignID", campaignID,
 			"requestingUserID", userID,
 			"ownerID", campaign.OwnerID)
-		return h.ErrorHandler.HandleHTTPError(c,
-			errors.New("unauthorized"),
-			"Unauthorized",
-			http.StatusUnauthorized)
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
 	// Get CSRF token with error handling
@@ -339,8 +551,15 @@ func (h *Handler) EditCampaignForm(c echo.Context) error {
 		Title:    "Edit Campaign",
 		PageName: "campaign_edit",
 		Content: map[string]interface{}{
-			"Campaign":  campaign,
-			"CSRFToken": csrfToken,
+			"Campaign":        campaign,
+			"CSRFToken":       csrfToken,
+			"Targets":         AllTargets,
+			"Placeholders":    Placeholders(campaign.CustomFields...),
+			"RequiredFields":  requiredFieldLabels(campaign),
+			"FieldTypes":      FieldTypes,
+			"CustomFieldRows": customFieldRows(campaign.CustomFields),
+			"Languages":       LanguageOptions(),
+			"TranslationRows": translationRows(campaign.Language, campaign.Translations),
 		},
 	}
 
@@ -385,23 +604,86 @@ func (h *Handler) EditCampaign(c echo.Context) error {
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
-	if campaign.OwnerID.String() != userID {
-		return h.ErrorHandler.HandleHTTPError(c, ErrUnauthorizedAccess, "Unauthorized", http.StatusUnauthorized)
+	if err := h.authorize(c, campaign, userID, PermissionEdit); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
 	params := EditParams{
-		ID:          campaignID,
-		Name:        c.FormValue("name"),
-		Description: c.FormValue("description"),
-		Template:    c.FormValue("template"),
-	}
-
-	if err := h.service.UpdateCampaign(c.Request().Context(), &UpdateCampaignDTO{
-		ID:          params.ID,
-		Name:        params.Name,
-		Description: params.Description,
-		Template:    params.Template,
-	}); err != nil {
+		ID:                  campaignID,
+		Name:                c.FormValue("name"),
+		Description:         c.FormValue("description"),
+		Subject:             strings.TrimSpace(c.FormValue("subject")),
+		Language:            c.FormValue("language"),
+		Template:            c.FormValue("template"),
+		Translations:        formTranslations(c),
+		Targets:             formTargets(c),
+		Tags:                formTags(c),
+		RequireConfirmation: c.FormValue("require_confirmation") == "on",
+		CopyConstituent:     c.FormValue("copy_constituent") == "on",
+	}
+
+	var formErr error
+	if params.StartsAt, err = formTime(c, "starts_at"); err != nil {
+		formErr = fmt.Errorf("%w: start time is invalid", ErrInvalidSchedule)
+	}
+	if params.EndsAt, err = formTime(c, "ends_at"); err != nil {
+		formErr = fmt.Errorf("%w: end time is invalid", ErrInvalidSchedule)
+	}
+	if params.CustomFields, err = formCustomFields(c); err != nil {
+		formErr = err
+	}
+
+	err = formErr
+	if err == nil {
+		err = h.service.UpdateCampaign(c.Request().Context(), &UpdateCampaignDTO{
+			ID:                  params.ID,
+			Name:                params.Name,
+			Description:         params.Description,
+			Subject:             params.Subject,
+			Language:            params.Language,
+			Template:            params.Template,
+			Translations:        params.Translations,
+			Targets:             params.Targets,
+			Tags:                params.Tags,
+			CustomFields:        params.CustomFields,
+			StartsAt:            params.StartsAt,
+			EndsAt:              params.EndsAt,
+			RequireConfirmation: params.RequireConfirmation,
+			CopyConstituent:     params.CopyConstituent,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, ErrInvalidTemplate) || errors.Is(err, ErrInvalidSchedule) ||
+			errors.Is(err, ErrInvalidCustomFields) {
+			campaign.Name = params.Name
+			campaign.Description = params.Description
+			campaign.Subject = params.Subject
+			campaign.Language = params.Language
+			campaign.Template = params.Template
+			campaign.Translations = params.Translations
+			campaign.Targets = params.Targets
+			campaign.Tags = params.Tags
+			campaign.CustomFields = params.CustomFields
+			campaign.RequireConfirmation = params.RequireConfirmation
+			campaign.CopyConstituent = params.CopyConstituent
+			campaign.StartsAt = params.StartsAt
+			campaign.EndsAt = params.EndsAt
+			return c.Render(http.StatusBadRequest, "campaign_edit", shared.Data{
+				Title:    "Edit Campaign",
+				PageName: "campaign_edit",
+				Content: map[string]interface{}{
+					"Campaign":        campaign,
+					"Errors":          []string{err.Error()},
+					"Targets":         AllTargets,
+					"Placeholders":    Placeholders(params.CustomFields...),
+					"FieldTypes":      FieldTypes,
+					"CustomFieldRows": customFieldRows(params.CustomFields),
+					"Languages":       LanguageOptions(),
+					"TranslationRows": translationRows(params.Language, params.Translations),
+				},
+			})
+		}
 		status, msg := h.MapError(err)
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
@@ -414,6 +696,50 @@ func (h *Handler) EditCampaign(c echo.Context) error {
 	return c.Redirect(http.StatusSeeOther, "/campaign/"+params.ID.String())
 }
 
+// UpdateCampaignStatus handles POST requests for moving a campaign to a new state
+func (h *Handler) UpdateCampaignStatus(c echo.Context) error {
+	h.Logger.Debug("Handling UpdateCampaignStatus request")
+
+	userID, err := h.GetUserIDFromSession(c)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	campaignID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		status, msg := h.MapError(ErrInvalidCampaignID)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	// Verify ownership
+	campaign, err := h.service.FetchCampaign(c.Request().Context(), GetCampaignParams{ID: campaignID})
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.authorize(c, campaign, userID, PermissionManage); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	updated, err := h.service.ChangeStatus(c.Request().Context(), &ChangeStatusDTO{
+		ID:     campaignID,
+		Status: Status(c.FormValue("status")),
+	})
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.AddFlashMessage(c, "Campaign is now "+string(updated.Status)); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	h.Logger.Info("Campaign status updated", "campaignID", campaignID, "status", updated.Status)
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+campaignID.String())
+}
+
 // ComposeEmail handles the initial postal code submission and email composition
 func (h *Handler) ComposeEmail(c echo.Context) error {
 	h.Logger.Info("Handling email composition request")
@@ -430,11 +756,15 @@ func (h *Handler) ComposeEmail(c echo.Context) error {
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
-	mp, err := h.representativeLookupService.FetchRepresentatives(postalCode)
-	if err != nil || len(mp) == 0 {
-		status, msg := h.MapError(ErrNoRepresentatives)
+	mp, err := h.representativeLookupService.FetchRepresentatives(c.Request().Context(), postalCode)
+	if err != nil {
+		status, msg := h.MapError(err)
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
+	if len(mp) == 0 {
+		status, msg := h.MapError(ErrNoRepresentatives)
+		return h.ErrorHandler.HandleHTTPError(c, ErrNoRepresentatives, msg, status)
+	}
 
 	campaign, err := h.service.FetchCampaign(c.Request().Context(), GetCampaignParams{ID: params.ID})
 	if err != nil {
@@ -442,69 +772,223 @@ func (h *Handler) ComposeEmail(c echo.Context) error {
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
-	userData := extractUserData(c)
-	representative := mp[0]
-	emailContent, err := h.service.ComposeEmail(c.Request().Context(), ComposeEmailParams{
-		MP:       representative,
-		Campaign: campaign,
-		UserData: userData,
-	})
+	fieldValues, err := CustomFieldValues(campaign.CustomFields, extractFieldInput(c, campaign.CustomFields))
 	if err != nil {
+		var valueErr *FieldValueError
+		if errors.As(err, &valueErr) {
+			return h.ErrorHandler.HandleHTTPError(c, err, valueErr.Error(), http.StatusBadRequest)
+		}
 		status, msg := h.MapError(err)
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
+	recipients := SelectRecipients(campaign, mp)
+	if len(recipients) == 0 {
+		status, msg := h.MapError(ErrNoRepresentatives)
+		return h.ErrorHandler.HandleHTTPError(c, ErrNoRepresentatives, msg, status)
+	}
+
+	userData := extractUserData(c)
+	drafts := make([]EmailDraft, 0, len(recipients))
+	for _, representative := range recipients {
+		composed, err := h.service.ComposeEmail(c.Request().Context(), ComposeEmailParams{
+			MP:          representative,
+			Campaign:    campaign,
+			PostalCode:  postalCode,
+			UserData:    userData,
+			FieldValues: fieldValues,
+		})
+		if err != nil {
+			status, msg := h.MapError(err)
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		drafts = append(drafts, EmailDraft{
+			Representative: representative,
+			Language:       composed.Language,
+			Subject:        composed.Subject,
+			Content:        template.HTML(composed.Content),
+			DraftToken:     composed.DraftToken,
+		})
+	}
+
 	if err := h.AddFlashMessage(c, "Email composed successfully"); err != nil {
 		h.Logger.Error("Failed to add flash message", err)
 	}
 
 	h.Logger.Info("Email composed successfully",
 		"campaignID", params.ID,
-		"representative", representative.Email)
+		"drafts", len(drafts))
 
-	return h.RenderEmailTemplate(c, representative.Email, emailContent)
+	return h.RenderEmailTemplate(c, drafts, postalCode, userData)
 }
 
-// SendCampaign handles the actual email sending
+// SendCampaign queues every draft submitted from the preview page
 func (h *Handler) SendCampaign(c echo.Context) error {
 	h.Logger.Info("Handling email send request")
 
-	email := c.FormValue("email")
-	content := template.HTML(c.FormValue("content"))
+	campaignID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		status, msg := h.MapError(ErrInvalidCampaignID)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	form, err := c.FormParams()
+	if err != nil {
+		status, msg := h.MapError(ErrInvalidCampaignData)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	// Drafts are submitted as index-aligned repeated fields
+	emails := form["email"]
+	contents := form["content"]
 
-	if email == "" || content == "" {
+	if len(emails) == 0 || len(emails) != len(contents) {
 		h.Logger.Error("Missing required fields", nil,
-			"email", email != "",
-			"hasContent", content != "")
+			"emails", len(emails),
+			"contents", len(contents))
 		return h.ErrorHandler.HandleHTTPError(c,
 			ErrInvalidCampaignData,
 			"Email and content are required",
 			http.StatusBadRequest)
 	}
 
-	htmlContent := string(content)
-	err := h.emailService.SendEmail(email, "Campaign", htmlContent, true)
+	postalCode := c.FormValue("postal_code")
+	contactConsent := c.FormValue("contact_consent") == "on"
+	dtos := make([]*SendCampaignEmailDTO, len(emails))
+	for i, email := range emails {
+		dtos[i] = &SendCampaignEmailDTO{
+			CampaignID:             campaignID,
+			RepresentativeName:     valueAt(form["representative_name"], i),
+			RepresentativeEmail:    email,
+			RepresentativeOffice:   valueAt(form["representative_office"], i),
+			RepresentativeDistrict: valueAt(form["representative_district"], i),
+			PostalCode:             postalCode,
+			Content:                contents[i],
+			Language:               valueAt(form["language"], i),
+			DraftToken:             valueAt(form["draft_token"], i),
+			ContactConsent:         contactConsent,
+			ConstituentFirstName:   c.FormValue("constituent_first_name"),
+			ConstituentLastName:    c.FormValue("constituent_last_name"),
+			ConstituentEmail:       c.FormValue("constituent_email"),
+		}
+	}
+
+	var lastErr error
+	queued, unconfirmed := 0, 0
+	for i, result := range h.service.SendCampaignEmails(c.Request().Context(), dtos) {
+		email, send := emails[i], result.Send
+		if result.Err != nil {
+			h.Logger.Error("Failed to queue email", result.Err,
+				"recipient", email)
+			lastErr = result.Err
+			continue
+		}
+
+		if send.IsUnconfirmed() {
+			unconfirmed++
+			h.Logger.Info("Email awaiting confirmation",
+				"recipient", email,
+				"campaignID", campaignID,
+				"sendID", send.ID)
+			continue
+		}
+
+		queued++
+		h.Logger.Info("Email queued for delivery",
+			"recipient", email,
+			"campaignID", campaignID,
+			"sendID", send.ID)
+	}
+
+	if queued+unconfirmed == 0 {
+		status, msg := h.MapError(lastErr)
+		return h.ErrorHandler.HandleHTTPError(c, lastErr, msg, status)
+	}
+
+	message := "Your email has been queued for delivery!"
+	switch {
+	case unconfirmed == 1 && len(emails) == 1:
+		message = "Check your inbox: we've emailed you a link to confirm your letter before it is forwarded."
+	case unconfirmed > 0:
+		message = fmt.Sprintf("Check your inbox: we've emailed you a link to confirm %d of %d letters "+
+			"before they are forwarded.", unconfirmed, len(emails))
+	case len(emails) > 1:
+		message = fmt.Sprintf("%d of %d emails have been queued for delivery!", queued, len(emails))
+	}
+	if err := h.AddFlashMessage(c, message); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+campaignID.String())
+}
+
+// ConfirmSendGET shows the letters a confirmation link refers to, with the
+// form that confirms them. Mail scanners open links, so this must not
+// confirm anything itself.
+func (h *Handler) ConfirmSendGET(c echo.Context) error {
+	sends, err := h.service.GetConfirmation(c.Request().Context(), c.Param("token"))
 	if err != nil {
-		h.Logger.Error("Failed to send email", err,
-			"recipient", email)
 		status, msg := h.MapError(err)
 		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 
-	h.Logger.Info("Email sent successfully",
-		"recipient", email,
-		"campaignID", c.Param("id"))
+	names := make([]string, len(sends))
+	pending := false
+	for i, send := range sends {
+		names[i] = send.RepresentativeName
+		pending = pending || send.IsUnconfirmed()
+	}
+
+	return c.Render(http.StatusOK, "campaign_confirm", shared.Data{
+		Title:    "Confirm Your Letter",
+		PageName: "campaign_confirm",
+		Content: map[string]interface{}{
+			"Token":           c.Param("token"),
+			"CampaignID":      sends[0].CampaignID,
+			"Representatives": joinNames(names),
+			"Letters":         len(sends),
+			"Pending":         pending,
+		},
+	})
+}
 
-	if err := h.AddFlashMessage(c, "Email sent successfully!"); err != nil {
+// ConfirmSend handles the form a constituent submits to confirm their letters
+func (h *Handler) ConfirmSend(c echo.Context) error {
+	sends, err := h.service.ConfirmSend(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	names := make([]string, len(sends))
+	for i, send := range sends {
+		names[i] = send.RepresentativeName
+	}
+	message := fmt.Sprintf("Thank you! Your letter to %s has been confirmed and queued for delivery.",
+		joinNames(names))
+	if len(sends) > 1 {
+		message = fmt.Sprintf("Thank you! Your letters to %s have been confirmed and queued for delivery.",
+			joinNames(names))
+	}
+	if err := h.AddFlashMessage(c, message); err != nil {
 		h.Logger.Error("Failed to add flash message", err)
 	}
 
-	return c.Redirect(http.StatusSeeOther, "/campaign/"+c.Param("id"))
+	campaignID := sends[0].CampaignID
+	h.Logger.Info("Campaign letters confirmed", "campaignID", campaignID, "letters", len(sends))
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+campaignID.String())
 }
 
-// RenderEmailTemplate renders the email template
-func (h *Handler) RenderEmailTemplate(c echo.Context, email string, content string) error {
-	h.Logger.Debug("Rendering email template", "recipientEmail", email)
+// RenderEmailTemplate renders the preview of every composed draft. The
+// constituent's details are carried to the send form so they can choose to
+// share them with the campaign's organizers.
+func (h *Handler) RenderEmailTemplate(
+	c echo.Context,
+	drafts []EmailDraft,
+	postalCode string,
+	constituent map[string]string,
+) error {
+	h.Logger.Debug("Rendering email template", "drafts", len(drafts))
 
 	campaignID := c.Param("id")
 
@@ -512,9 +996,10 @@ func (h *Handler) RenderEmailTemplate(c echo.Context, email string, content stri
 		Title:    "Email Preview",
 		PageName: "email",
 		Content: map[string]interface{}{
-			"Email":      email,
-			"Content":    template.HTML(content),
-			"CampaignID": campaignID,
+			"Drafts":      drafts,
+			"PostalCode":  postalCode,
+			"CampaignID":  campaignID,
+			"Constituent": constituent,
 		},
 	}
 
@@ -526,10 +1011,11 @@ func (h *Handler) HandleRepresentativeLookup(c echo.Context) error {
 	h.Logger.Debug("Handling representative lookup request")
 	postalCode := c.FormValue("postal_code")
 	representativeType := c.FormValue("type")
-	representatives, err := h.representativeLookupService.FetchRepresentatives(postalCode)
+	representatives, err := h.representativeLookupService.FetchRepresentatives(c.Request().Context(), postalCode)
 	if err != nil {
 		h.Logger.Error("Error fetching representatives", err, "postalCode", postalCode)
-		return h.ErrorHandler.HandleHTTPError(c, err, "Error fetching representatives", http.StatusInternalServerError)
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
 	}
 	filters := map[string]string{"type": representativeType}
 	filteredRepresentatives := h.representativeLookupService.FilterRepresentatives(representatives, filters)
@@ -543,6 +1029,15 @@ func (h *Handler) HandleRepresentativeLookup(c echo.Context) error {
 	})
 }
 
+// authorize checks that the signed-in user holds a permission on the campaign
+func (h *Handler) authorize(c echo.Context, campaign *Campaign, userID string, permission Permission) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return ErrUnauthorizedAccess
+	}
+	return h.service.CheckPermission(c.Request().Context(), campaign, id, permission)
+}
+
 // GetSessionManager retrieves the session manager from context
 func (h *Handler) GetSessionManager(c echo.Context) (session.Manager, error) {
 	sessionManager, ok := c.Get("session_manager").(session.Manager)
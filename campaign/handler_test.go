@@ -1,6 +1,7 @@
 package campaign_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -46,8 +47,6 @@ func (s *HandlerTestSuite) SetupTest() {
 		},
 		Service:                     s.CampaignService,
 		RepresentativeLookupService: s.RepresentativeLookupService,
-		EmailService:                s.EmailService,
-		Client:                      s.CampaignClient,
 	}
 
 	result, err := campaign.NewHandler(params)
@@ -85,6 +84,7 @@ func (s *HandlerTestSuite) TestCampaignGET() {
 					Description: "Test Description",
 					Template:    "Test Template",
 					OwnerID:     uuid.New(),
+					Status:      campaign.StatusActive,
 				}
 
 				s.CampaignService.EXPECT().
@@ -170,11 +170,20 @@ func (s *HandlerTestSuite) TestGetCampaigns() {
 			name: "successful campaigns fetch",
 			setupMocks: func() {
 				s.Logger.EXPECT().Debug("Handling GetCampaigns request")
-				s.Logger.EXPECT().Debug("Rendering all campaigns", "count", len(campaigns))
+				s.Logger.EXPECT().Debug("Rendering campaigns", "count", len(campaigns), "total", int64(len(campaigns)))
 
-				s.CampaignService.EXPECT().GetCampaigns(
+				s.CampaignService.EXPECT().ListCampaigns(
 					mock.Anything,
-				).Return(campaigns, nil)
+					mock.MatchedBy(func(p campaign.ListCampaignsParams) bool {
+						// Visitors only ever see open campaigns
+						return p.OpenAt != nil && p.OwnerID == nil
+					}),
+				).Return(&campaign.CampaignPage{
+					Campaigns: campaigns,
+					Total:     int64(len(campaigns)),
+					Page:      1,
+					PageSize:  campaign.DefaultPageSize,
+				}, nil)
 
 				s.TemplateRenderer.EXPECT().Render(
 					mock.Anything,
@@ -196,7 +205,8 @@ func (s *HandlerTestSuite) TestGetCampaigns() {
 				s.Logger.EXPECT().Debug("Handling GetCampaigns request")
 
 				dbErr := errors.New("database error")
-				s.CampaignService.EXPECT().GetCampaigns(
+				s.CampaignService.EXPECT().ListCampaigns(
+					mock.Anything,
 					mock.Anything,
 				).Return(nil, dbErr)
 
@@ -247,9 +257,10 @@ func (s *HandlerTestSuite) TestCreateCampaignForm() {
 			mock.Anything,
 			"campaign_create",
 			mock.MatchedBy(func(data shared.Data) bool {
+				content, ok := data.Content.(map[string]interface{})
 				return data.Title == "Create Campaign" &&
 					data.PageName == "campaign_create" &&
-					data.Content == nil
+					ok && s.Equal(campaign.AllTargets, content["Targets"])
 			}),
 			mock.Anything,
 		).Return(nil)
@@ -260,6 +271,71 @@ func (s *HandlerTestSuite) TestCreateCampaignForm() {
 		s.NoError(err)
 		s.Equal(http.StatusOK, s.Recorder.Code)
 	})
+
+	s.Run("prefills a starter template", func() {
+		starter := &campaign.StarterTemplate{
+			BaseModel:   shared.BaseModel{ID: uuid.New()},
+			Name:        "Protect local parks",
+			Description: "Keep our green space",
+			Template:    "Dear {{.representative.name}}",
+			Targets:     []campaign.Target{campaign.TargetMayor},
+		}
+		s.Logger.EXPECT().Debug("Handling CreateCampaignForm request")
+		s.CampaignService.EXPECT().GetStarterTemplate(mock.Anything, starter.ID).Return(starter, nil)
+		s.TemplateRenderer.EXPECT().Render(
+			mock.Anything,
+			"campaign_create",
+			mock.MatchedBy(func(data shared.Data) bool {
+				content, ok := data.Content.(map[string]interface{})
+				if !ok {
+					return false
+				}
+				values, ok := content["FormValues"].(*campaign.CreateCampaignParams)
+				return ok && values.Name == starter.Name && values.Template == starter.Template &&
+					values.HasTarget(campaign.TargetMayor) && !values.HasTarget(campaign.TargetMP)
+			}),
+			mock.Anything,
+		).Return(nil)
+
+		c := s.NewContext(http.MethodGet, "/campaign/new?starter="+starter.ID.String(), nil)
+		s.NoError(s.handler.CreateCampaignForm(c))
+	})
+}
+
+func (s *HandlerTestSuite) TestHandleRepresentativeLookup() {
+	s.Run("looks up with the request context", func() {
+		reps := []campaign.Representative{{Name: "Jane Doe", ElectedOffice: "MP"}}
+		c := s.NewContext(http.MethodPost, "/campaign/lookup?postal_code=K1A0A6&type=MP", nil)
+		reqCtx := c.Request().Context()
+
+		s.Logger.EXPECT().Debug("Handling representative lookup request").Once()
+		s.RepresentativeLookupService.EXPECT().
+			FetchRepresentatives(mock.MatchedBy(func(ctx context.Context) bool { return ctx == reqCtx }), "K1A0A6").
+			Return(reps, nil).Once()
+		s.RepresentativeLookupService.EXPECT().
+			FilterRepresentatives(reps, map[string]string{"type": "MP"}).Return(reps).Once()
+		s.Logger.EXPECT().Info("Representatives lookup successful", "count", 1, "postalCode", "K1A0A6", "type", "MP").Once()
+		s.TemplateRenderer.EXPECT().Render(mock.Anything, "representatives", mock.Anything, mock.Anything).Return(nil).Once()
+
+		s.NoError(s.handler.HandleRepresentativeLookup(c))
+	})
+
+	s.Run("a disconnected client cancels the lookup", func() {
+		c := s.NewContext(http.MethodPost, "/campaign/lookup?postal_code=K1A0A6", nil)
+		ctx, cancel := context.WithCancel(c.Request().Context())
+		c.SetRequest(c.Request().WithContext(ctx))
+		cancel()
+
+		s.Logger.EXPECT().Debug("Handling representative lookup request").Once()
+		s.RepresentativeLookupService.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").
+			RunAndReturn(func(ctx context.Context, _ string) ([]campaign.Representative, error) {
+				return nil, ctx.Err()
+			}).Once()
+		s.Logger.EXPECT().Error("Error fetching representatives", context.Canceled, "postalCode", "K1A0A6").Once()
+		s.ErrorHandler.EXPECT().HandleHTTPError(c, context.Canceled, mock.Anything, mock.Anything).Return(nil).Once()
+
+		s.NoError(s.handler.HandleRepresentativeLookup(c))
+	})
 }
 
 func (s *HandlerTestSuite) TestGetSessionManager() {
@@ -0,0 +1,79 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ImportResult describes the campaigns created by an import
+type ImportResult struct {
+	Campaigns []Campaign `json:"campaigns"`
+	// IDMap maps each campaign's ID in the bundle to its new ID
+	IDMap map[uuid.UUID]uuid.UUID `json:"id_map"`
+}
+
+// ImportCampaigns creates a draft for every campaign in a bundle, owned by
+// dto.OwnerID and optionally run by dto.OrganizationID. Every campaign is
+// checked before any is created, so a bad bundle imports nothing.
+func (s *Service) ImportCampaigns(ctx context.Context, dto *ImportCampaignsDTO) (*ImportResult, error) {
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+	if v := dto.Bundle.Version; v < 1 || v > BundleVersion {
+		return nil, fmt.Errorf("%w: version %d, this instance reads up to %d",
+			ErrUnsupportedBundleVersion, v, BundleVersion)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(dto.Bundle.Campaigns))
+	creates := make([]*CreateCampaignDTO, 0, len(dto.Bundle.Campaigns))
+	for i, bc := range dto.Bundle.Campaigns {
+		if bc.ID != uuid.Nil {
+			if seen[bc.ID] {
+				return nil, fmt.Errorf("%w: campaign %s appears more than once", ErrInvalidBundle, bc.ID)
+			}
+			seen[bc.ID] = true
+		}
+
+		create := &CreateCampaignDTO{
+			Name:                bc.Name,
+			Description:         bc.Description,
+			Subject:             bc.Subject,
+			Language:            bc.Language,
+			Template:            bc.Template,
+			Translations:        append([]Translation(nil), bc.Translations...),
+			Targets:             append([]Target(nil), bc.Targets...),
+			Tags:                append([]string(nil), bc.Tags...),
+			CustomFields:        append([]CustomField(nil), bc.CustomFields...),
+			OwnerID:             dto.OwnerID,
+			OrganizationID:      dto.OrganizationID,
+			Status:              StatusDraft,
+			RequireConfirmation: bc.RequireConfirmation,
+			CopyConstituent:     bc.CopyConstituent,
+		}
+		if err := s.prepareCreate(ctx, create); err != nil {
+			return nil, fmt.Errorf("%w: campaign %d (%q): %w", ErrInvalidBundle, i+1, bc.Name, err)
+		}
+		creates = append(creates, create)
+	}
+
+	result := &ImportResult{
+		Campaigns: make([]Campaign, 0, len(creates)),
+		IDMap:     make(map[uuid.UUID]uuid.UUID, len(creates)),
+	}
+	for i, create := range creates {
+		campaign, err := s.repo.Create(ctx, create)
+		if err != nil {
+			s.Logger.Error("Failed to import campaign", err, "imported", len(result.Campaigns))
+			return result, fmt.Errorf("failed to import campaign %d (%q): %w", i+1, create.Name, err)
+		}
+		if sourceID := dto.Bundle.Campaigns[i].ID; sourceID != uuid.Nil {
+			result.IDMap[sourceID] = campaign.ID
+		}
+		result.Campaigns = append(result.Campaigns, *campaign)
+	}
+
+	s.Logger.Info("Campaigns imported", "count", len(result.Campaigns), "ownerID", dto.OwnerID)
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package campaign_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *CampaignServiceTestSuite) TestImportCampaigns() {
+	userID := uuid.New()
+	orgID := uuid.New()
+	sourceA, sourceB := uuid.New(), uuid.New()
+
+	bundle := func(template string) *campaign.Bundle {
+		return &campaign.Bundle{
+			Version: campaign.BundleVersion,
+			Campaigns: []campaign.BundleCampaign{
+				{
+					ID:          sourceA,
+					Name:        "Save the Library",
+					Description: "Keep our branch open",
+					Subject:     "Keep the library open",
+					Template:    "Dear {{.representative.name}},",
+					Targets:     []campaign.Target{campaign.TargetMayor},
+					Tags:        []string{"libraries"},
+				},
+				{
+					ID:          sourceB,
+					Name:        "Fund Transit",
+					Description: "More buses",
+					Template:    template,
+				},
+			},
+		}
+	}
+
+	s.Run("creates drafts and maps their IDs", func() {
+		newA, newB := uuid.New(), uuid.New()
+		s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, userID).Return(organization.RoleEditor, nil).Twice()
+		s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+			return dto.Name == "Save the Library" &&
+				dto.Subject == "Keep the library open" &&
+				s.Equal([]string{"representative.name"}, dto.Tokens) &&
+				s.Equal([]string{"libraries"}, dto.Tags) &&
+				dto.OwnerID == userID &&
+				*dto.OrganizationID == orgID &&
+				dto.Status == campaign.StatusDraft
+		})).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: newA}}, nil).Once()
+		s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+			return dto.Name == "Fund Transit"
+		})).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: newB}}, nil).Once()
+		s.mockLogger.EXPECT().Info("Campaigns imported", "count", 2, "ownerID", userID).Once()
+
+		result, err := s.service.ImportCampaigns(context.Background(), &campaign.ImportCampaignsDTO{
+			Bundle:         bundle("Hello {{.first_name}}"),
+			OwnerID:        userID,
+			OrganizationID: &orgID,
+		})
+
+		s.Require().NoError(err)
+		s.Len(result.Campaigns, 2)
+		s.Equal(map[uuid.UUID]uuid.UUID{sourceA: newA, sourceB: newB}, result.IDMap)
+	})
+
+	s.Run("a bad template imports nothing", func() {
+		s.mockLogger.EXPECT().Debug("Invalid campaign template", "error", mock.Anything).Once()
+
+		_, err := s.service.ImportCampaigns(context.Background(), &campaign.ImportCampaignsDTO{
+			Bundle:  bundle("Hello {{.first_name"),
+			OwnerID: userID,
+		})
+
+		s.ErrorIs(err, campaign.ErrInvalidBundle)
+		s.ErrorIs(err, campaign.ErrInvalidTemplate)
+	})
+
+	s.Run("duplicate source IDs", func() {
+		b := bundle("Hello")
+		b.Campaigns[1].ID = sourceA
+
+		_, err := s.service.ImportCampaigns(context.Background(), &campaign.ImportCampaignsDTO{Bundle: b, OwnerID: userID})
+
+		s.ErrorIs(err, campaign.ErrInvalidBundle)
+	})
+
+	s.Run("newer bundle version", func() {
+		b := bundle("Hello")
+		b.Version = campaign.BundleVersion + 1
+
+		_, err := s.service.ImportCampaigns(context.Background(), &campaign.ImportCampaignsDTO{Bundle: b, OwnerID: userID})
+
+		s.ErrorIs(err, campaign.ErrUnsupportedBundleVersion)
+	})
+}
@@ -0,0 +1,155 @@
+package campaign
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Languages a campaign's letters may be written in
+const (
+	LanguageEnglish = "en"
+	LanguageFrench  = "fr"
+	// DefaultLanguage is the language of campaigns that don't set one
+	DefaultLanguage = LanguageEnglish
+)
+
+// Languages lists the supported letter languages in the order forms offer them
+//
+//nolint:gochecknoglobals
+var Languages = []string{LanguageEnglish, LanguageFrench}
+
+// languageNames are the display names of Languages
+//
+//nolint:gochecknoglobals
+var languageNames = map[string]string{
+	LanguageEnglish: "English",
+	LanguageFrench:  "Français",
+}
+
+// LanguageName returns the display name of a language code
+func LanguageName(code string) string {
+	if name, ok := languageNames[code]; ok {
+		return name
+	}
+	return code
+}
+
+// LanguageOption is a language offered by campaign forms
+type LanguageOption struct {
+	Code string
+	Name string
+}
+
+// LanguageOptions returns Languages with their display names
+func LanguageOptions() []LanguageOption {
+	options := make([]LanguageOption, len(Languages))
+	for i, code := range Languages {
+		options[i] = LanguageOption{Code: code, Name: LanguageName(code)}
+	}
+	return options
+}
+
+// languageCode maps a language as the Represent API reports it, such as
+// "English" or "French", or a tag such as "fr-CA", to a supported code. It
+// returns "" for languages campaigns can't be written in.
+func languageCode(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	switch language {
+	case "english", "anglais":
+		return LanguageEnglish
+	case "french", "français", "francais":
+		return LanguageFrench
+	}
+	if i := strings.IndexAny(language, "-_"); i > 0 {
+		language = language[:i]
+	}
+	if _, ok := languageNames[language]; ok {
+		return language
+	}
+	return ""
+}
+
+// Translation is a campaign's letter in a language other than its own
+type Translation struct {
+	Language string `json:"language" yaml:"language"`
+	// Subject falls back to the campaign's subject when empty
+	Subject  string `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Template string `json:"template" yaml:"template"`
+}
+
+// LanguageName returns the display name of the translation's language
+func (t Translation) LanguageName() string {
+	return LanguageName(t.Language)
+}
+
+// Letter is the subject and template of one of a campaign's languages
+type Letter struct {
+	Language string
+	Subject  string
+	Template string
+}
+
+// CampaignLanguage returns the language of the campaign's own subject and
+// template
+func (c *Campaign) CampaignLanguage() string {
+	if c.Language == "" {
+		return DefaultLanguage
+	}
+	return c.Language
+}
+
+// LetterIn returns the campaign's letter in the given language, falling back
+// to the campaign's own language when it has no translation
+func (c *Campaign) LetterIn(language string) Letter {
+	for _, t := range c.Translations {
+		if t.Language == language && language != c.CampaignLanguage() {
+			subject := t.Subject
+			if subject == "" {
+				subject = c.EmailSubject()
+			}
+			return Letter{Language: t.Language, Subject: subject, Template: t.Template}
+		}
+	}
+	return Letter{Language: c.CampaignLanguage(), Subject: c.EmailSubject(), Template: c.Template}
+}
+
+// LetterFor picks the letter for a representative: the first of their
+// preferred languages the campaign is written in, else the campaign's own
+func (c *Campaign) LetterFor(representative Representative) Letter {
+	for _, preferred := range representative.Extra.PreferredLanguages {
+		code := languageCode(preferred)
+		if code == "" {
+			continue
+		}
+		if letter := c.LetterIn(code); letter.Language == code {
+			return letter
+		}
+	}
+	return c.LetterIn(c.CampaignLanguage())
+}
+
+// validateTranslations checks that each translation is in a supported
+// language other than the campaign's own, at most once
+func validateTranslations(language string, translations []Translation) error {
+	if language == "" {
+		language = DefaultLanguage
+	}
+	seen := make(map[string]bool, len(translations))
+	for _, t := range translations {
+		switch {
+		case languageCode(t.Language) != t.Language:
+			return fmt.Errorf("%w: unsupported language %q", ErrInvalidTemplate, t.Language)
+		case t.Language == language:
+			return fmt.Errorf("%w: %s is the campaign's own language, so it uses the main template",
+				ErrInvalidTemplate, LanguageName(t.Language))
+		case seen[t.Language]:
+			return fmt.Errorf("%w: %s has more than one translation", ErrInvalidTemplate, LanguageName(t.Language))
+		case strings.TrimSpace(t.Template) == "":
+			return fmt.Errorf("%w: the %s translation needs a template", ErrInvalidTemplate, LanguageName(t.Language))
+		case len(t.Subject) > 255:
+			return fmt.Errorf("%w: the %s subject is too long", ErrInvalidTemplate, LanguageName(t.Language))
+		}
+		seen[t.Language] = true
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package campaign_test
+
+import (
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLetterFor(t *testing.T) {
+	french := &campaign.Campaign{
+		Name:     "Sauvez la bibliothèque",
+		Language: campaign.LanguageFrench,
+		Template: "Bonjour",
+		Translations: []campaign.Translation{
+			{Language: campaign.LanguageEnglish, Template: "Hello"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		campaign  *campaign.Campaign
+		preferred []string
+		want      campaign.Letter
+	}{
+		{
+			name:      "translation preferred",
+			campaign:  french,
+			preferred: []string{"English"},
+			want:      campaign.Letter{Language: campaign.LanguageEnglish, Subject: "Sauvez la bibliothèque", Template: "Hello"},
+		},
+		{
+			name:      "campaign language preferred",
+			campaign:  french,
+			preferred: []string{"fr-CA", "English"},
+			want:      campaign.Letter{Language: campaign.LanguageFrench, Subject: "Sauvez la bibliothèque", Template: "Bonjour"},
+		},
+		{
+			name:      "unsupported languages are skipped",
+			campaign:  french,
+			preferred: []string{"Cree", "english"},
+			want:      campaign.Letter{Language: campaign.LanguageEnglish, Subject: "Sauvez la bibliothèque", Template: "Hello"},
+		},
+		{
+			name:      "no translation falls back to the campaign language",
+			campaign:  &campaign.Campaign{Name: "Fund Transit", Template: "Hello"},
+			preferred: []string{"French"},
+			want:      campaign.Letter{Language: campaign.LanguageEnglish, Subject: "Fund Transit", Template: "Hello"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.campaign.LetterFor(campaign.Representative{
+				Extra: campaign.Extra{PreferredLanguages: tt.preferred},
+			})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package campaign
+
+import (
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/templating"
+)
+
+// Constituent fields collected by the send form, keyed by template variable
+const (
+	FieldFirstName  = "first_name"
+	FieldLastName   = "last_name"
+	FieldAddress1   = "address_1"
+	FieldCity       = "city"
+	FieldProvince   = "province"
+	FieldPostalCode = "postal_code"
+	FieldEmail      = "email"
+)
+
+// ConstituentFields lists the form fields available to letter templates
+//
+//nolint:gochecknoglobals
+var ConstituentFields = []string{
+	FieldFirstName,
+	FieldLastName,
+	FieldAddress1,
+	FieldCity,
+	FieldProvince,
+	FieldPostalCode,
+	FieldEmail,
+}
+
+// constituentFieldLabels are the form labels for ConstituentFields
+//
+//nolint:gochecknoglobals
+var constituentFieldLabels = map[string]string{
+	FieldFirstName:  "First Name",
+	FieldLastName:   "Last Name",
+	FieldAddress1:   "Address 1",
+	FieldCity:       "City",
+	FieldProvince:   "Province",
+	FieldPostalCode: "Postal Code",
+	FieldEmail:      "Email",
+}
+
+// FieldLabel returns the form label for a constituent field
+func FieldLabel(field string) string {
+	if label, ok := constituentFieldLabels[field]; ok {
+		return label
+	}
+	return field
+}
+
+// representativeVariables are exposed to templates as representative.<name>
+//
+//nolint:gochecknoglobals
+var representativeVariables = []string{
+	"name", "first_name", "last_name", "email", "party", "office", "district",
+}
+
+// legacyPlaceholders maps the original {{Token}} syntax to template actions
+//
+//nolint:gochecknoglobals
+var legacyPlaceholders = map[string]string{
+	"First Name":    "." + FieldFirstName,
+	"Last Name":     "." + FieldLastName,
+	"Address 1":     "." + FieldAddress1,
+	"City":          "." + FieldCity,
+	"Province":      "." + FieldProvince,
+	"Postal Code":   "." + FieldPostalCode,
+	"Email Address": "." + FieldEmail,
+	"MP's Name":     ".representative.name",
+	"MPEmail":       ".representative.email",
+	"Date":          `.date | date "2006-01-02"`,
+}
+
+// LetterVariables returns every variable a campaign template may reference
+func LetterVariables() []string {
+	vars := append([]string(nil), ConstituentFields...)
+	for _, v := range representativeVariables {
+		vars = append(vars, "representative."+v)
+	}
+	return append(vars, "campaign.name", "date")
+}
+
+// Placeholders returns an example action for every letter variable,
+// including the given custom fields, for display next to the template editor
+func Placeholders(fields ...CustomField) []string {
+	vars := append(LetterVariables(), customFieldVariables(fields)...)
+	placeholders := make([]string, 0, len(vars))
+	for _, v := range vars {
+		if v == "date" {
+			placeholders = append(placeholders, `{{.date | date "January 2, 2006"}}`)
+			continue
+		}
+		placeholders = append(placeholders, "{{."+v+"}}")
+	}
+	return placeholders
+}
+
+// NewLetterEngine creates the template engine used for campaign letters
+func NewLetterEngine() *templating.Engine {
+	return templating.New(templating.Options{
+		Variables: LetterVariables(),
+		Aliases:   legacyPlaceholders,
+	})
+}
+
+// letterData builds the values a letter template is executed against
+func letterData(params ComposeEmailParams, now time.Time) templating.Data {
+	data := templating.Data{
+		"representative": templating.Data{
+			"name":       params.MP.Name,
+			"first_name": params.MP.FirstName,
+			"last_name":  params.MP.LastName,
+			"email":      params.MP.Email,
+			"party":      params.MP.Party,
+			"office":     params.MP.ElectedOffice,
+			"district":   params.MP.DistrictName,
+		},
+		"campaign": templating.Data{
+			"name": params.Campaign.Name,
+		},
+		"date": now,
+	}
+
+	for _, field := range ConstituentFields {
+		data[field] = params.UserData[field]
+	}
+
+	// Unanswered fields still need a value for the template to execute
+	fields := make(templating.Data, len(params.Campaign.CustomFields))
+	for _, f := range params.Campaign.CustomFields {
+		if value, ok := params.FieldValues[f.Key]; ok {
+			fields[f.Key] = value
+		} else if f.Type == FieldTypeCheckbox {
+			fields[f.Key] = false
+		} else {
+			fields[f.Key] = ""
+		}
+	}
+	data[customFieldsVariable] = fields
+
+	return data
+}
+
+// RequiredFields returns the constituent fields the campaign's letter uses,
+// in form order
+func (c *Campaign) RequiredFields() []string {
+	used := make(map[string]bool, len(c.Tokens))
+	for _, token := range c.Tokens {
+		used[token] = true
+	}
+
+	fields := make([]string, 0, len(used))
+	for _, field := range ConstituentFields {
+		if used[field] {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// EmailSubject returns the subject line of the campaign's letters
+func (c *Campaign) EmailSubject() string {
+	if c.Subject != "" {
+		return c.Subject
+	}
+	return c.Name
+}
@@ -0,0 +1,221 @@
+package campaign
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jonesrussell/mp-emailer/database"
+)
+
+// Listing defaults and limits
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Sort fields a campaign list can be ordered by
+const (
+	SortCreated = "created"
+	SortUpdated = "updated"
+	SortName    = "name"
+	// SortRelevance orders search results by how well they match the query
+	SortRelevance = "relevance"
+)
+
+// sortColumns maps sort fields to their database columns
+//
+//nolint:gochecknoglobals
+var sortColumns = map[string]string{
+	SortCreated: "created_at",
+	SortUpdated: "updated_at",
+	SortName:    "name",
+}
+
+// ListCampaignsParams filters, sorts and paginates a campaign listing.
+// Zero values mean "no filter" and the defaults below.
+type ListCampaignsParams struct {
+	OwnerID        *uuid.UUID
+	OrganizationID *uuid.UUID
+	Status         Status `validate:"omitempty,oneof=draft scheduled active paused closed archived"`
+	Tag            string `validate:"omitempty,max=50"`
+	// Query searches the name, description and template text
+	Query string `validate:"omitempty,max=200"`
+	// OpenAt limits the list to campaigns accepting letters at that time
+	OpenAt *time.Time
+	// Sort is one of created, updated, name or relevance. Searches default
+	// to relevance, everything else to created.
+	Sort string `validate:"omitempty,oneof=created updated name relevance"`
+	// Desc reverses the order; newest first is the default for dates
+	Desc     bool
+	Page     int `validate:"gte=0"`
+	PageSize int `validate:"gte=0,lte=100"`
+}
+
+// WithDefaults returns a copy with the page, page size and sort filled in
+func (p ListCampaignsParams) WithDefaults() ListCampaignsParams {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	p.Query = strings.TrimSpace(p.Query)
+	if p.Sort == SortRelevance && p.Query == "" {
+		p.Sort = ""
+	}
+	if p.Sort == "" {
+		p.Sort = SortCreated
+		if p.Query != "" {
+			p.Sort = SortRelevance
+		}
+		p.Desc = true
+	}
+	p.Tag = NormalizeTag(p.Tag)
+	return p
+}
+
+// Offset returns the number of rows before the requested page
+func (p ListCampaignsParams) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+// orderClause returns the ORDER BY clause for the requested sort and its
+// arguments. The ID breaks ties so pages never overlap.
+func (p ListCampaignsParams) orderClause() (string, []interface{}) {
+	if p.Sort == SortRelevance {
+		// The best match always comes first
+		return matchExpr + " DESC, id DESC", []interface{}{p.Query}
+	}
+	column, ok := sortColumns[p.Sort]
+	if !ok {
+		column = sortColumns[SortCreated]
+	}
+	direction := "ASC"
+	if p.Desc {
+		direction = "DESC"
+	}
+	return column + " " + direction + ", id " + direction, nil
+}
+
+// conditions returns the WHERE clauses for the filters, leaving out the
+// search query
+func (p ListCampaignsParams) conditions() []database.Condition {
+	var conditions []database.Condition
+	if p.OwnerID != nil {
+		conditions = append(conditions, database.Where("owner_id = ?", *p.OwnerID))
+	}
+	if p.OrganizationID != nil {
+		conditions = append(conditions, database.Where("organization_id = ?", *p.OrganizationID))
+	}
+	if p.Status != "" {
+		conditions = append(conditions, database.Where("status = ?", p.Status))
+	}
+	if p.OpenAt != nil {
+		conditions = append(conditions, database.Where(
+			"status = ? AND (starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)",
+			StatusActive, *p.OpenAt, *p.OpenAt))
+	}
+	if p.Tag != "" {
+		conditions = append(conditions, database.Where("JSON_CONTAINS(tags, JSON_QUOTE(?))", p.Tag))
+	}
+	return conditions
+}
+
+// CampaignPage is one page of a campaign listing
+type CampaignPage struct {
+	Campaigns []Campaign
+	Total     int64
+	Page      int
+	PageSize  int
+	// Snippets holds an HTML-escaped excerpt of each matching campaign,
+	// with the search terms wrapped in <mark>. It is only set for searches.
+	Snippets map[uuid.UUID]string
+}
+
+// TotalPages returns the number of pages in the listing
+func (p *CampaignPage) TotalPages() int {
+	if p.PageSize < 1 {
+		return 0
+	}
+	return int((p.Total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+// HasNext reports whether a later page exists
+func (p *CampaignPage) HasNext() bool {
+	return p.Page < p.TotalPages()
+}
+
+// HasPrev reports whether an earlier page exists
+func (p *CampaignPage) HasPrev() bool {
+	return p.Page > 1
+}
+
+// NormalizeTag lower-cases a tag and trims its surrounding whitespace
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// NormalizeTags normalizes, de-duplicates and drops empty tags, keeping
+// their order
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// ParseListQuery reads the q, page, page_size, sort, order, status and tag
+// query parameters. Owner and organization filters are left to the caller,
+// which knows who is asking.
+func ParseListQuery(q url.Values) (ListCampaignsParams, error) {
+	params := ListCampaignsParams{
+		Status: Status(strings.TrimSpace(q.Get("status"))),
+		Tag:    q.Get("tag"),
+		Query:  strings.TrimSpace(q.Get("q")),
+		Sort:   strings.TrimSpace(q.Get("sort")),
+	}
+
+	switch strings.ToLower(q.Get("order")) {
+	case "":
+		// Dates read newest first unless asked otherwise
+		params.Desc = params.Sort == SortCreated || params.Sort == SortUpdated || params.Sort == SortRelevance
+	case "asc":
+	case "desc":
+		params.Desc = true
+	default:
+		return params, ErrInvalidListParams
+	}
+
+	for name, dest := range map[string]*int{"page": &params.Page, "page_size": &params.PageSize} {
+		if v := q.Get(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return params, ErrInvalidListParams
+			}
+			*dest = n
+		}
+	}
+
+	return params, nil
+}
+
+// PageURL returns path with the query q, its page parameter set to page
+func PageURL(path string, q url.Values, page int) string {
+	values := url.Values{}
+	for k, v := range q {
+		values[k] = append([]string(nil), v...)
+	}
+	values.Set("page", strconv.Itoa(page))
+	return path + "?" + values.Encode()
+}
@@ -0,0 +1,113 @@
+package campaign_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    campaign.ListCampaignsParams
+		wantErr bool
+	}{
+		{
+			name:  "empty query",
+			query: "",
+			want:  campaign.ListCampaignsParams{},
+		},
+		{
+			name:  "dates sort newest first by default",
+			query: "sort=updated&page=3&page_size=50",
+			want:  campaign.ListCampaignsParams{Sort: campaign.SortUpdated, Desc: true, Page: 3, PageSize: 50},
+		},
+		{
+			name:  "names sort alphabetically by default",
+			query: "sort=name&status=draft&tag=Housing",
+			want:  campaign.ListCampaignsParams{Sort: campaign.SortName, Status: campaign.StatusDraft, Tag: "Housing"},
+		},
+		{
+			name:  "explicit order",
+			query: "sort=created&order=asc",
+			want:  campaign.ListCampaignsParams{Sort: campaign.SortCreated},
+		},
+		{
+			name:  "searches rank best match first by default",
+			query: "q=+bike+lanes+&sort=relevance",
+			want:  campaign.ListCampaignsParams{Query: "bike lanes", Sort: campaign.SortRelevance, Desc: true},
+		},
+		{name: "bad order", query: "order=sideways", wantErr: true},
+		{name: "bad page", query: "page=0", wantErr: true},
+		{name: "bad page size", query: "page_size=lots", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			got, err := campaign.ParseListQuery(q)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, campaign.ErrInvalidListParams)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListCampaignsParams_WithDefaults(t *testing.T) {
+	got := campaign.ListCampaignsParams{Tag: "  Housing "}.WithDefaults()
+
+	assert.Equal(t, 1, got.Page)
+	assert.Equal(t, campaign.DefaultPageSize, got.PageSize)
+	assert.Equal(t, campaign.SortCreated, got.Sort)
+	assert.True(t, got.Desc)
+	assert.Equal(t, "housing", got.Tag)
+	assert.Equal(t, 0, got.Offset())
+
+	got = campaign.ListCampaignsParams{Page: 3, PageSize: 10, Sort: campaign.SortName}.WithDefaults()
+	assert.False(t, got.Desc)
+	assert.Equal(t, 20, got.Offset())
+
+	got = campaign.ListCampaignsParams{Query: " parks "}.WithDefaults()
+	assert.Equal(t, campaign.SortRelevance, got.Sort)
+	assert.Equal(t, "parks", got.Query)
+
+	got = campaign.ListCampaignsParams{Sort: campaign.SortRelevance}.WithDefaults()
+	assert.Equal(t, campaign.SortCreated, got.Sort, "relevance needs a query")
+}
+
+func TestCampaignPage(t *testing.T) {
+	page := &campaign.CampaignPage{Total: 41, Page: 2, PageSize: 20}
+
+	assert.Equal(t, 3, page.TotalPages())
+	assert.True(t, page.HasNext())
+	assert.True(t, page.HasPrev())
+
+	page.Page = 3
+	assert.False(t, page.HasNext())
+
+	empty := &campaign.CampaignPage{Page: 1, PageSize: 20}
+	assert.Equal(t, 0, empty.TotalPages())
+	assert.False(t, empty.HasNext())
+	assert.False(t, empty.HasPrev())
+}
+
+func TestPageURL(t *testing.T) {
+	q := url.Values{"tag": {"housing"}, "page": {"1"}}
+
+	assert.Equal(t, "/campaigns?page=2&tag=housing", campaign.PageURL("/campaigns", q, 2))
+	assert.Equal(t, "1", q.Get("page"), "the original query is not modified")
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"housing", "transit"},
+		campaign.NormalizeTags([]string{" Housing", "", "transit", "HOUSING "}))
+}
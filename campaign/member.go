@@ -0,0 +1,94 @@
+package campaign
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"gorm.io/gorm"
+)
+
+// MemberRole is the access a collaborator has to a campaign
+type MemberRole string
+
+const (
+	RoleEditor MemberRole = "editor"
+	RoleViewer MemberRole = "viewer"
+)
+
+// MemberRoles lists the roles a collaborator may be invited with
+//
+//nolint:gochecknoglobals
+var MemberRoles = []MemberRole{RoleEditor, RoleViewer}
+
+// Permission is an action on a campaign that requires authorization
+type Permission int
+
+const (
+	// PermissionView allows seeing the campaign in any state
+	PermissionView Permission = iota
+	// PermissionEdit allows changing the campaign's content and schedule
+	PermissionEdit
+	// PermissionManage allows changing status, sharing and deleting; only the owner has it
+	PermissionManage
+)
+
+// Allows reports whether the role grants the permission
+func (r MemberRole) Allows(p Permission) bool {
+	switch r {
+	case RoleEditor:
+		return p == PermissionView || p == PermissionEdit
+	case RoleViewer:
+		return p == PermissionView
+	default:
+		return false
+	}
+}
+
+// inviteTTL is how long an invitation can be accepted for
+const inviteTTL = 7 * 24 * time.Hour
+
+// Member is a collaborator invited to a campaign. UserID is set once the
+// invitation has been accepted.
+type Member struct {
+	shared.BaseModel
+	CampaignID      uuid.UUID  `gorm:"type:char(36);not null;index" json:"campaign_id"`
+	UserID          *uuid.UUID `gorm:"type:char(36);index" json:"user_id,omitempty"`
+	Email           string     `gorm:"type:varchar(255);not null" json:"email"`
+	Role            MemberRole `gorm:"type:varchar(20);not null" json:"role"`
+	InviteToken     string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	InviteExpiresAt time.Time  `gorm:"not null" json:"-"`
+	InvitedByID     uuid.UUID  `gorm:"type:char(36);not null" json:"invited_by_id"`
+	AcceptedAt      *time.Time `json:"accepted_at,omitempty"`
+}
+
+// TableName overrides the default table name
+func (Member) TableName() string {
+	return "campaign_members"
+}
+
+// BeforeCreate assigns an ID so the record can be referenced after insert
+func (m *Member) BeforeCreate(_ *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsPending reports whether the invitation has not been accepted yet
+func (m *Member) IsPending() bool {
+	return m.AcceptedAt == nil
+}
+
+// newLinkToken returns a random, URL-safe token for a link sent by email,
+// such as an invitation or a letter confirmation
+func newLinkToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate link token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,169 @@
+package campaign
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/labstack/echo/v4"
+)
+
+// MembersGET handles GET requests for a campaign's collaborators page
+func (h *Handler) MembersGET(c echo.Context) error {
+	h.Logger.Debug("Handling MembersGET request")
+
+	campaign, userID, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorize(c, campaign, userID, PermissionManage); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return h.renderMembers(c, http.StatusOK, campaign, nil)
+}
+
+// InviteMember handles POST requests for inviting a collaborator by email
+func (h *Handler) InviteMember(c echo.Context) error {
+	h.Logger.Debug("Handling InviteMember request")
+
+	campaign, userID, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorize(c, campaign, userID, PermissionManage); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	dto := &InviteMemberDTO{
+		CampaignID:  campaign.ID,
+		Email:       strings.TrimSpace(c.FormValue("email")),
+		Role:        MemberRole(c.FormValue("role")),
+		InvitedByID: uuid.MustParse(userID),
+	}
+
+	if _, err := h.service.InviteMember(c.Request().Context(), dto); err != nil {
+		status, msg := h.MapError(err)
+		if status >= http.StatusInternalServerError {
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		if status == http.StatusBadRequest {
+			msg = "Enter a valid email address and role"
+		}
+		return h.renderMembers(c, status, campaign, []string{msg})
+	}
+
+	if err := h.AddFlashMessage(c, "Invitation sent to "+dto.Email); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+campaign.ID.String()+"/members")
+}
+
+// RemoveMember handles DELETE requests for removing a collaborator
+func (h *Handler) RemoveMember(c echo.Context) error {
+	h.Logger.Debug("Handling RemoveMember request")
+
+	campaign, userID, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorize(c, campaign, userID, PermissionManage); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberID"))
+	if err != nil {
+		status, msg := h.MapError(ErrMemberNotFound)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.service.RemoveMember(c.Request().Context(), RemoveMemberDTO{
+		CampaignID: campaign.ID,
+		MemberID:   memberID,
+	}); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.AddFlashMessage(c, "Member removed"); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+campaign.ID.String()+"/members")
+}
+
+// AcceptInvite handles GET requests for the link in an invitation email
+func (h *Handler) AcceptInvite(c echo.Context) error {
+	h.Logger.Debug("Handling AcceptInvite request")
+
+	userID, err := h.GetUserIDFromSession(c)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	member, err := h.service.AcceptInvite(c.Request().Context(), &AcceptInviteDTO{
+		Token:  c.Param("token"),
+		UserID: uuid.MustParse(userID),
+	})
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.AddFlashMessage(c, "You now have "+string(member.Role)+" access to this campaign"); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/campaign/"+member.CampaignID.String())
+}
+
+// fetchForMember loads the campaign named in the URL and the signed-in user's ID.
+// On failure the returned error is the rendered HTTP error.
+func (h *Handler) fetchForMember(c echo.Context) (*Campaign, string, error) {
+	userID, err := h.GetUserIDFromSession(c)
+	if err != nil {
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	campaignID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		status, msg := h.MapError(ErrInvalidCampaignID)
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	campaign, err := h.service.FetchCampaign(c.Request().Context(), GetCampaignParams{ID: campaignID})
+	if err != nil {
+		status, msg := h.MapError(err)
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return campaign, userID, nil
+}
+
+func (h *Handler) renderMembers(c echo.Context, code int, campaign *Campaign, errs []string) error {
+	members, err := h.service.ListMembers(c.Request().Context(), campaign.ID)
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return c.Render(code, "campaign_members", shared.Data{
+		Title:           "Campaign Members",
+		PageName:        "campaign_members",
+		IsAuthenticated: true,
+		Content: map[string]interface{}{
+			"Campaign": campaign,
+			"Members":  members,
+			"Roles":    MemberRoles,
+			"Errors":   errs,
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package campaign
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/database"
+	"gorm.io/gorm"
+)
+
+// MemberRepositoryInterface defines the contract for campaign member persistence
+type MemberRepositoryInterface interface {
+	Create(ctx context.Context, member *Member) error
+	Update(ctx context.Context, member *Member) error
+	Delete(ctx context.Context, member *Member) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Member, error)
+	GetByToken(ctx context.Context, token string) (*Member, error)
+	GetByCampaignAndUser(ctx context.Context, campaignID, userID uuid.UUID) (*Member, error)
+	ListByCampaign(ctx context.Context, campaignID uuid.UUID) ([]Member, error)
+}
+
+// MemberRepository implements MemberRepositoryInterface
+type MemberRepository struct {
+	db database.Database
+}
+
+// NewMemberRepository creates a new instance of MemberRepository
+func NewMemberRepository(params RepositoryParams) MemberRepositoryInterface {
+	return &MemberRepository{db: params.DB}
+}
+
+// Create records a new member in the database
+func (r *MemberRepository) Create(ctx context.Context, member *Member) error {
+	if err := r.db.Create(ctx, member); err != nil {
+		return fmt.Errorf("error creating campaign member: %w", err)
+	}
+	return nil
+}
+
+// Update saves changes to an existing member
+func (r *MemberRepository) Update(ctx context.Context, member *Member) error {
+	if err := r.db.Update(ctx, member); err != nil {
+		return fmt.Errorf("error updating campaign member: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a member from its campaign
+func (r *MemberRepository) Delete(ctx context.Context, member *Member) error {
+	if err := r.db.Delete(ctx, member); err != nil {
+		return fmt.Errorf("error deleting campaign member: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a member by its ID
+func (r *MemberRepository) GetByID(ctx context.Context, id uuid.UUID) (*Member, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+// GetByToken retrieves a member by its invitation token
+func (r *MemberRepository) GetByToken(ctx context.Context, token string) (*Member, error) {
+	return r.findOne(ctx, "invite_token = ?", token)
+}
+
+// GetByCampaignAndUser retrieves the accepted membership of a user in a campaign
+func (r *MemberRepository) GetByCampaignAndUser(ctx context.Context, campaignID, userID uuid.UUID) (*Member, error) {
+	return r.findOne(ctx, "campaign_id = ? AND user_id = ?", campaignID, userID)
+}
+
+// ListByCampaign retrieves all members of a campaign, including pending invitations
+func (r *MemberRepository) ListByCampaign(ctx context.Context, campaignID uuid.UUID) ([]Member, error) {
+	var members []Member
+	err := r.db.FindAll(ctx, &members, "campaign_id = ?", campaignID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error listing campaign members: %w", err)
+	}
+	if len(members) == 0 {
+		return []Member{}, nil
+	}
+	return members, nil
+}
+
+func (r *MemberRepository) findOne(ctx context.Context, query string, args ...interface{}) (*Member, error) {
+	var member Member
+	if err := r.db.FindOne(ctx, &member, query, args...); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("error retrieving campaign member: %w", err)
+	}
+	return &member, nil
+}
@@ -0,0 +1,235 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/email"
+	"gorm.io/gorm"
+)
+
+// CheckPermission returns ErrUnauthorizedAccess unless a user may perform an action on a campaign
+func (s *Service) CheckPermission(
+	ctx context.Context,
+	campaign *Campaign,
+	userID uuid.UUID,
+	permission Permission,
+) error {
+	if campaign == nil || userID == uuid.Nil {
+		return ErrUnauthorizedAccess
+	}
+	// The owner may do anything; everyone else is limited by their role
+	if campaign.OwnerID == userID {
+		return nil
+	}
+
+	if campaign.OrganizationID != nil {
+		err := s.checkOrganizationPermission(ctx, *campaign.OrganizationID, userID, permission)
+		if !errors.Is(err, ErrUnauthorizedAccess) {
+			return err
+		}
+		// Fall through: a campaign collaborator may hold more access than their org role
+	}
+
+	member, err := s.memberRepo.GetByCampaignAndUser(ctx, campaign.ID, userID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return ErrUnauthorizedAccess
+		}
+		return fmt.Errorf("failed to check campaign permission: %w", err)
+	}
+
+	if !member.Role.Allows(permission) {
+		return ErrUnauthorizedAccess
+	}
+	return nil
+}
+
+// GetSharedCampaigns retrieves the campaigns other users have shared with a user
+func (s *Service) GetSharedCampaigns(ctx context.Context, userID uuid.UUID) ([]Campaign, error) {
+	campaigns, err := s.repo.GetSharedWith(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared campaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+// ListMembers retrieves a campaign's collaborators, including pending invitations
+func (s *Service) ListMembers(ctx context.Context, campaignID uuid.UUID) ([]Member, error) {
+	members, err := s.memberRepo.ListByCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list campaign members: %w", err)
+	}
+	return members, nil
+}
+
+// InviteMember records a pending membership and queues an email with a link to accept it
+func (s *Service) InviteMember(ctx context.Context, dto *InviteMemberDTO) (*Member, error) {
+	if dto == nil {
+		return nil, fmt.Errorf("invite data is required")
+	}
+
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	campaign, err := s.repo.GetByID(ctx, GetCampaignDTO{ID: dto.CampaignID})
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.memberRepo.ListByCampaign(ctx, campaign.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list campaign members: %w", err)
+	}
+	for _, m := range members {
+		if strings.EqualFold(m.Email, dto.Email) {
+			return nil, ErrMemberExists
+		}
+	}
+
+	token, err := newLinkToken()
+	if err != nil {
+		return nil, err
+	}
+
+	member := &Member{
+		CampaignID:      campaign.ID,
+		Email:           strings.TrimSpace(dto.Email),
+		Role:            dto.Role,
+		InviteToken:     token,
+		InviteExpiresAt: time.Now().Add(inviteTTL),
+		InvitedByID:     dto.InvitedByID,
+	}
+
+	if err := s.memberRepo.Create(ctx, member); err != nil {
+		s.Logger.Error("Failed to record campaign invitation", err, "campaignID", campaign.ID)
+		return nil, fmt.Errorf("failed to record invitation: %w", err)
+	}
+
+	subject, body := s.inviteEmail(campaign, member)
+	msg := &email.QueuedMessage{To: member.Email, Subject: subject, Body: body}
+	if err := s.emailQueue.Enqueue(ctx, msg); err != nil {
+		// An invitation nobody will receive cannot be accepted, so don't keep it
+		if deleteErr := s.memberRepo.Delete(ctx, member); deleteErr != nil {
+			s.Logger.Error("Failed to remove unsent invitation", deleteErr, "memberID", member.ID)
+		}
+		return nil, fmt.Errorf("failed to queue invitation: %w", err)
+	}
+
+	s.Logger.Info("Campaign invitation queued", "campaignID", campaign.ID, "memberID", member.ID, "role", member.Role)
+	return member, nil
+}
+
+// inviteEmail builds the subject and plain-text body of an invitation
+func (s *Service) inviteEmail(campaign *Campaign, member *Member) (string, string) {
+	subject := fmt.Sprintf("You've been invited to collaborate on %q", campaign.Name)
+	body := fmt.Sprintf(`Hello,
+
+You have been invited to join the campaign "%s" as %s.
+
+To accept, sign in with this email address and open the following link:
+%s/campaign/invite/%s
+
+This invitation expires on %s.
+
+Best regards,
+Your Application Team`,
+		campaign.Name,
+		articleFor(string(member.Role)),
+		strings.TrimRight(s.baseURL, "/"),
+		member.InviteToken,
+		member.InviteExpiresAt.Format("January 2, 2006"))
+	return subject, body
+}
+
+// articleFor prefixes a role with "a" or "an"
+func articleFor(role string) string {
+	if role != "" && strings.ContainsRune("aeiou", rune(role[0])) {
+		return "an " + role
+	}
+	return "a " + role
+}
+
+// AcceptInvite attaches the signed-in user to the membership an invitation token refers to
+func (s *Service) AcceptInvite(ctx context.Context, dto *AcceptInviteDTO) (*Member, error) {
+	if dto == nil {
+		return nil, fmt.Errorf("invite data is required")
+	}
+
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	member, err := s.memberRepo.GetByToken(ctx, dto.Token)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return nil, ErrInviteNotFound
+		}
+		return nil, err
+	}
+
+	if !member.IsPending() {
+		// Following the link again is harmless; anyone else gets nothing
+		if member.UserID != nil && *member.UserID == dto.UserID {
+			return member, nil
+		}
+		return nil, ErrInviteNotFound
+	}
+
+	if time.Now().After(member.InviteExpiresAt) {
+		return nil, ErrInviteExpired
+	}
+
+	// Links can be forwarded, so only the account the invitation was sent to may accept it
+	invitee, err := s.users.FindByEmail(ctx, member.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInviteWrongUser
+		}
+		return nil, fmt.Errorf("failed to find invited user: %w", err)
+	}
+	if invitee.ID != dto.UserID {
+		return nil, ErrInviteWrongUser
+	}
+
+	acceptedAt := time.Now()
+	userID := dto.UserID
+	member.UserID = &userID
+	member.AcceptedAt = &acceptedAt
+
+	if err := s.memberRepo.Update(ctx, member); err != nil {
+		s.Logger.Error("Failed to accept campaign invitation", err, "memberID", member.ID)
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	s.Logger.Info("Campaign invitation accepted", "campaignID", member.CampaignID, "memberID", member.ID)
+	return member, nil
+}
+
+// RemoveMember revokes a collaborator's access or cancels a pending invitation
+func (s *Service) RemoveMember(ctx context.Context, dto RemoveMemberDTO) error {
+	if err := s.validate.Struct(dto); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+
+	member, err := s.memberRepo.GetByID(ctx, dto.MemberID)
+	if err != nil {
+		return err
+	}
+	if member.CampaignID != dto.CampaignID {
+		return ErrMemberNotFound
+	}
+
+	if err := s.memberRepo.Delete(ctx, member); err != nil {
+		s.Logger.Error("Failed to remove campaign member", err, "memberID", member.ID)
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	s.Logger.Info("Campaign member removed", "campaignID", member.CampaignID, "memberID", member.ID)
+	return nil
+}
@@ -0,0 +1,213 @@
+package campaign_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/email"
+	"github.com/jonesrussell/mp-emailer/user"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func (s *CampaignServiceTestSuite) TestCheckPermission() {
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	c := &campaign.Campaign{OwnerID: ownerID}
+	c.ID = uuid.New()
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		permission campaign.Permission
+		member     *campaign.Member
+		wantErr    error
+	}{
+		{name: "owner can manage", userID: ownerID, permission: campaign.PermissionManage},
+		{name: "editor can edit", userID: memberID, permission: campaign.PermissionEdit,
+			member: &campaign.Member{Role: campaign.RoleEditor}},
+		{name: "editor cannot manage", userID: memberID, permission: campaign.PermissionManage,
+			member: &campaign.Member{Role: campaign.RoleEditor}, wantErr: campaign.ErrUnauthorizedAccess},
+		{name: "viewer can view", userID: memberID, permission: campaign.PermissionView,
+			member: &campaign.Member{Role: campaign.RoleViewer}},
+		{name: "viewer cannot edit", userID: memberID, permission: campaign.PermissionEdit,
+			member: &campaign.Member{Role: campaign.RoleViewer}, wantErr: campaign.ErrUnauthorizedAccess},
+		{name: "stranger cannot view", userID: memberID, permission: campaign.PermissionView,
+			wantErr: campaign.ErrUnauthorizedAccess},
+		{name: "anonymous cannot view", userID: uuid.Nil, permission: campaign.PermissionView,
+			wantErr: campaign.ErrUnauthorizedAccess},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockMembers.ExpectedCalls = nil
+			if tt.userID != ownerID && tt.userID != uuid.Nil {
+				var err error
+				if tt.member == nil {
+					err = campaign.ErrMemberNotFound
+				}
+				s.mockMembers.EXPECT().GetByCampaignAndUser(mock.Anything, c.ID, tt.userID).Return(tt.member, err).Once()
+			}
+
+			err := s.service.CheckPermission(context.Background(), c, tt.userID, tt.permission)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestInviteMember() {
+	campaignID := uuid.New()
+	inviterID := uuid.New()
+	existing := &campaign.Campaign{Name: "Save the Park"}
+	existing.ID = campaignID
+
+	s.Run("sends an invitation link", func() {
+		s.mockRepo.ExpectedCalls = nil
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: campaignID}).Return(existing, nil).Once()
+		s.mockMembers.EXPECT().ListByCampaign(mock.Anything, campaignID).Return([]campaign.Member{}, nil).Once()
+
+		var created *campaign.Member
+		s.mockMembers.EXPECT().Create(mock.Anything, mock.AnythingOfType("*campaign.Member")).
+			Run(func(_ context.Context, m *campaign.Member) { created = m }).
+			Return(nil).Once()
+		s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+			return msg.To == "friend@example.com" && !msg.IsHTML &&
+				strings.Contains(msg.Body, "https://example.com/campaign/invite/"+created.InviteToken) &&
+				strings.Contains(msg.Body, "as an editor")
+		})).Return(nil).Once()
+		s.mockLogger.EXPECT().Info("Campaign invitation queued", "campaignID", campaignID,
+			"memberID", mock.Anything, "role", campaign.RoleEditor).Return().Once()
+
+		member, err := s.service.InviteMember(context.Background(), &campaign.InviteMemberDTO{
+			CampaignID:  campaignID,
+			Email:       "friend@example.com",
+			Role:        campaign.RoleEditor,
+			InvitedByID: inviterID,
+		})
+
+		s.NoError(err)
+		s.Same(created, member)
+		s.True(member.IsPending())
+		s.Len(member.InviteToken, 64)
+		s.Equal(inviterID, member.InvitedByID)
+	})
+
+	s.Run("rejects an existing member", func() {
+		s.mockRepo.ExpectedCalls = nil
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: campaignID}).Return(existing, nil).Once()
+		s.mockMembers.EXPECT().ListByCampaign(mock.Anything, campaignID).
+			Return([]campaign.Member{{Email: "Friend@Example.com"}}, nil).Once()
+
+		_, err := s.service.InviteMember(context.Background(), &campaign.InviteMemberDTO{
+			CampaignID:  campaignID,
+			Email:       "friend@example.com",
+			Role:        campaign.RoleViewer,
+			InvitedByID: inviterID,
+		})
+
+		s.ErrorIs(err, campaign.ErrMemberExists)
+	})
+
+	s.Run("discards the invitation when it can't be queued", func() {
+		s.mockRepo.ExpectedCalls = nil
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: campaignID}).Return(existing, nil).Once()
+		s.mockMembers.EXPECT().ListByCampaign(mock.Anything, campaignID).Return([]campaign.Member{}, nil).Once()
+		s.mockMembers.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.Anything).Return(errors.New("database down")).Once()
+		s.mockMembers.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := s.service.InviteMember(context.Background(), &campaign.InviteMemberDTO{
+			CampaignID:  campaignID,
+			Email:       "friend@example.com",
+			Role:        campaign.RoleViewer,
+			InvitedByID: inviterID,
+		})
+
+		s.ErrorContains(err, "database down")
+	})
+}
+
+func (s *CampaignServiceTestSuite) TestAcceptInvite() {
+	userID := uuid.New()
+	invitee := &user.User{Email: "friend@example.com"}
+	invitee.ID = userID
+
+	s.Run("attaches the user", func() {
+		member := &campaign.Member{Email: "friend@example.com", InviteToken: "token",
+			InviteExpiresAt: time.Now().Add(time.Hour)}
+		s.mockMembers.EXPECT().GetByToken(mock.Anything, "token").Return(member, nil).Once()
+		s.mockUsers.EXPECT().FindByEmail(mock.Anything, "friend@example.com").Return(invitee, nil).Once()
+		s.mockMembers.EXPECT().Update(mock.Anything, member).Return(nil).Once()
+		s.mockLogger.EXPECT().Info("Campaign invitation accepted", "campaignID", mock.Anything,
+			"memberID", mock.Anything).Return().Once()
+
+		got, err := s.service.AcceptInvite(context.Background(), &campaign.AcceptInviteDTO{Token: "token", UserID: userID})
+
+		s.NoError(err)
+		s.Equal(userID, *got.UserID)
+		s.False(got.IsPending())
+	})
+
+	s.Run("rejects a forwarded invitation", func() {
+		member := &campaign.Member{Email: "friend@example.com", InviteToken: "forwarded",
+			InviteExpiresAt: time.Now().Add(time.Hour)}
+		s.mockMembers.EXPECT().GetByToken(mock.Anything, "forwarded").Return(member, nil).Once()
+		s.mockUsers.EXPECT().FindByEmail(mock.Anything, "friend@example.com").Return(invitee, nil).Once()
+
+		_, err := s.service.AcceptInvite(context.Background(),
+			&campaign.AcceptInviteDTO{Token: "forwarded", UserID: uuid.New()})
+
+		s.ErrorIs(err, campaign.ErrInviteWrongUser)
+		s.True(member.IsPending())
+	})
+
+	s.Run("rejects an invitation to an address without an account", func() {
+		member := &campaign.Member{Email: "nobody@example.com", InviteToken: "unknown",
+			InviteExpiresAt: time.Now().Add(time.Hour)}
+		s.mockMembers.EXPECT().GetByToken(mock.Anything, "unknown").Return(member, nil).Once()
+		s.mockUsers.EXPECT().FindByEmail(mock.Anything, "nobody@example.com").
+			Return(nil, fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)).Once()
+
+		_, err := s.service.AcceptInvite(context.Background(),
+			&campaign.AcceptInviteDTO{Token: "unknown", UserID: userID})
+
+		s.ErrorIs(err, campaign.ErrInviteWrongUser)
+	})
+
+	s.Run("rejects an expired invitation", func() {
+		member := &campaign.Member{InviteToken: "old", InviteExpiresAt: time.Now().Add(-time.Hour)}
+		s.mockMembers.EXPECT().GetByToken(mock.Anything, "old").Return(member, nil).Once()
+
+		_, err := s.service.AcceptInvite(context.Background(), &campaign.AcceptInviteDTO{Token: "old", UserID: userID})
+
+		s.ErrorIs(err, campaign.ErrInviteExpired)
+	})
+
+	s.Run("rejects an invitation accepted by someone else", func() {
+		other := uuid.New()
+		acceptedAt := time.Now()
+		member := &campaign.Member{InviteToken: "used", UserID: &other, AcceptedAt: &acceptedAt}
+		s.mockMembers.EXPECT().GetByToken(mock.Anything, "used").Return(member, nil).Once()
+
+		_, err := s.service.AcceptInvite(context.Background(), &campaign.AcceptInviteDTO{Token: "used", UserID: userID})
+
+		s.ErrorIs(err, campaign.ErrInviteNotFound)
+	})
+
+	s.Run("unknown token", func() {
+		s.mockMembers.EXPECT().GetByToken(mock.Anything, "nope").Return(nil, campaign.ErrMemberNotFound).Once()
+
+		_, err := s.service.AcceptInvite(context.Background(), &campaign.AcceptInviteDTO{Token: "nope", UserID: userID})
+
+		s.ErrorIs(err, campaign.ErrInviteNotFound)
+	})
+}
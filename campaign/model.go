@@ -1,6 +1,8 @@
 package campaign
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jonesrussell/mp-emailer/shared"
 	"github.com/jonesrussell/mp-emailer/user"
@@ -9,12 +11,35 @@ import (
 // Campaign represents an email campaign.
 type Campaign struct {
 	shared.BaseModel
-	Name        string    `gorm:"type:varchar(255);not null" json:"name"`
-	Description string    `gorm:"type:text;not null" json:"description"`
-	Template    string    `gorm:"type:text;not null" json:"template"`
-	OwnerID     uuid.UUID `gorm:"type:uuid;not null" json:"owner_id"`
-	Owner       user.User `gorm:"foreignKey:OwnerID" json:"-"`
-	Tokens      []string  `gorm:"-" json:"tokens"`
+	Name        string `gorm:"type:varchar(255);not null" json:"name"`
+	Description string `gorm:"type:text;not null" json:"description"`
+	// Subject is the email subject line; the name is used when it is empty
+	Subject string `gorm:"type:varchar(255);not null;default:''" json:"subject"`
+	// Language is the language of Subject and Template
+	Language string `gorm:"type:varchar(5);not null;default:en" json:"language"`
+	Template string `gorm:"type:text;not null" json:"template"`
+	// Translations are the letter in other languages, sent to representatives
+	// who prefer them
+	Translations []Translation `gorm:"type:json;serializer:json" json:"translations"`
+	Targets      []Target      `gorm:"type:json;serializer:json" json:"targets"`
+	OwnerID      uuid.UUID     `gorm:"type:uuid;not null" json:"owner_id"`
+	Owner        user.User     `gorm:"foreignKey:OwnerID" json:"-"`
+	// OrganizationID is set when the campaign is run by an organization
+	// rather than by its owner alone
+	OrganizationID *uuid.UUID `gorm:"type:char(36);index" json:"organization_id,omitempty"`
+	Tokens         []string   `gorm:"type:json;serializer:json" json:"tokens"`
+	Tags           []string   `gorm:"type:json;serializer:json" json:"tags"`
+	// CustomFields are asked on the send form in addition to the built-in
+	// constituent fields
+	CustomFields []CustomField `gorm:"type:json;serializer:json" json:"custom_fields"`
+	// RequireConfirmation holds each letter until the constituent confirms
+	// their email address by following a link sent to it. CopyConstituent
+	// sends constituents a copy of their letter.
+	RequireConfirmation bool       `gorm:"not null;default:false" json:"require_confirmation"`
+	CopyConstituent     bool       `gorm:"not null;default:false" json:"copy_constituent"`
+	Status              Status     `gorm:"type:varchar(20);not null;default:draft;index" json:"status"`
+	StartsAt            *time.Time `json:"starts_at,omitempty"`
+	EndsAt              *time.Time `json:"ends_at,omitempty"`
 }
 
 // Representative represents a government representative.
@@ -33,6 +58,8 @@ type Representative struct {
 	Offices           []Office `json:"offices"`
 	Extra             Extra    `json:"extra"`
 	RepresentativeSet string   `json:"representative_set_name"`
+	// Provider names the lookup provider that found the representative
+	Provider string `json:"provider,omitempty"`
 }
 
 // Office represents an office held by a representative.
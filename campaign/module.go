@@ -2,6 +2,7 @@ package campaign
 
 import (
 	"github.com/jonesrussell/mp-emailer/database"
+	"github.com/jonesrussell/mp-emailer/email"
 	"github.com/jonesrussell/mp-emailer/logger"
 	"go.uber.org/fx"
 )
@@ -11,8 +12,13 @@ import (
 //nolint:gochecknoglobals
 var Module = fx.Options(
 	fx.Provide(
-		// Repository
+		// Repositories
 		NewRepository,
+		NewSendRepository,
+		NewMemberRepository,
+		NewStarterTemplateRepository,
+		NewRepresentativeCacheRepository,
+		NewDatasetRepository,
 
 		// Base service
 		fx.Annotate(
@@ -24,15 +30,29 @@ var Module = fx.Options(
 			fx.As(new(RepresentativeLookupServiceInterface)),
 		),
 		fx.Annotate(
-			NewClient,
-			fx.As(new(ClientInterface)),
+			NewRepresentProvider,
+			fx.As(new(RepresentativeProvider)),
+			fx.ResultTags(`group:"representative_providers"`),
+		),
+		fx.Annotate(
+			NewDatasetProvider,
+			fx.As(new(RepresentativeProvider)),
+			fx.ResultTags(`group:"representative_providers"`),
+		),
+		fx.Annotate(
+			NewSendDeliveryListener,
+			fx.As(new(email.DeliveryListener)),
+			fx.ResultTags(`group:"email_delivery_listeners"`),
 		),
 		NewHandler,
+		NewScheduler,
 	),
+	fx.Invoke(registerSchedulerHooks),
 	fx.Decorate(
 		func(base ServiceInterface, logger logger.Interface) ServiceInterface {
 			return NewLoggingServiceDecorator(base, logger)
 		},
+		NewCachingLookupService,
 	),
 )
 
@@ -43,22 +63,6 @@ type RepositoryParams struct {
 	Logger logger.Interface
 }
 
-// ClientParams for dependency injection
-type ClientParams struct {
-	fx.In
-	Logger        logger.Interface
-	LookupService RepresentativeLookupServiceInterface
-}
-
-// NewClient creates a new instance of ClientInterface
-func NewClient(params ClientParams) (ClientInterface, error) {
-	client := &DefaultClient{
-		Logger:        params.Logger,
-		lookupService: params.LookupService,
-	}
-	return client, nil
-}
-
 func NewLoggingServiceDecorator(service ServiceInterface, Logger logger.Interface) ServiceInterface {
 	return NewLoggingDecorator(service, Logger)
 }
@@ -1,6 +1,7 @@
 package campaign
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jonesrussell/mp-emailer/logger"
@@ -11,31 +12,31 @@ import (
 type MPFinderParams struct {
 	fx.In
 
-	Client ClientInterface
+	Lookup RepresentativeLookupServiceInterface
 	Logger logger.Interface
 }
 
 // MPFinder is a service that finds Members of Parliament (MPs) based on postal codes.
 type MPFinder struct {
-	client ClientInterface
+	lookup RepresentativeLookupServiceInterface
 	Logger logger.Interface
 }
 
 // NewMPFinder is the constructor for the MPFinder service.
 func NewMPFinder(params MPFinderParams) *MPFinder {
 	return &MPFinder{
-		client: params.Client,
+		lookup: params.Lookup,
 		Logger: params.Logger,
 	}
 }
 
 // FindMP finds the MP for a given postal code.
-func (f *MPFinder) FindMP(postalCode string) (Representative, error) {
-	if f.client == nil {
-		return Representative{}, fmt.Errorf("API client is not initialized")
+func (f *MPFinder) FindMP(ctx context.Context, postalCode string) (Representative, error) {
+	if f.lookup == nil {
+		return Representative{}, fmt.Errorf("representative lookup is not initialized")
 	}
 
-	representatives, err := f.client.FetchRepresentatives(postalCode)
+	representatives, err := f.lookup.FetchRepresentatives(ctx, postalCode)
 	if err != nil {
 		return Representative{}, fmt.Errorf("error fetching representatives for postal code %s: %w", postalCode, err)
 	}
@@ -0,0 +1,83 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/organization"
+)
+
+// orgRoleAllows reports whether an organization role grants a permission on
+// the organization's campaigns
+func orgRoleAllows(role organization.Role, p Permission) bool {
+	switch role {
+	case organization.RoleAdmin:
+		return true
+	case organization.RoleEditor:
+		return p == PermissionView || p == PermissionEdit
+	case organization.RoleViewer:
+		return p == PermissionView
+	default:
+		return false
+	}
+}
+
+// checkOrganizationPermission returns ErrUnauthorizedAccess unless the user's
+// role in the organization grants the permission
+func (s *Service) checkOrganizationPermission(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+	permission Permission,
+) error {
+	role, err := s.orgs.MemberRole(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, organization.ErrMemberNotFound) {
+			return ErrUnauthorizedAccess
+		}
+		return fmt.Errorf("failed to check organization role: %w", err)
+	}
+
+	if !orgRoleAllows(role, permission) {
+		return ErrUnauthorizedAccess
+	}
+	return nil
+}
+
+// GetCampaignsByOrganization retrieves every campaign run by an organization
+func (s *Service) GetCampaignsByOrganization(ctx context.Context, orgID uuid.UUID) ([]Campaign, error) {
+	campaigns, err := s.repo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaigns for organization: %w", err)
+	}
+	return campaigns, nil
+}
+
+// GetOrganizationDashboard lists an organization's campaigns with their send counts
+func (s *Service) GetOrganizationDashboard(ctx context.Context, orgID uuid.UUID) (*OrganizationDashboard, error) {
+	campaigns, err := s.GetCampaignsByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(campaigns))
+	for i, c := range campaigns {
+		ids[i] = c.ID
+	}
+
+	stats, err := s.sendRepo.CountByCampaign(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get send statistics: %w", err)
+	}
+
+	dashboard := &OrganizationDashboard{
+		OrganizationID: orgID,
+		Campaigns:      make([]CampaignSummary, len(campaigns)),
+	}
+	for i, c := range campaigns {
+		dashboard.Campaigns[i] = CampaignSummary{Campaign: c, Stats: stats[c.ID]}
+		dashboard.Totals = dashboard.Totals.Add(stats[c.ID])
+	}
+	return dashboard, nil
+}
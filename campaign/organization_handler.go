@@ -0,0 +1,64 @@
+package campaign
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/labstack/echo/v4"
+)
+
+// OrganizationDashboard handles GET requests for an organization's campaigns
+// and send statistics. Any member of the organization may view it.
+func (h *Handler) OrganizationDashboard(c echo.Context) error {
+	h.Logger.Debug("Handling OrganizationDashboard request")
+
+	userID, err := h.GetUserIDFromSession(c)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Invalid organization ID", http.StatusBadRequest)
+	}
+
+	ctx := c.Request().Context()
+	role, err := h.orgs.MemberRole(ctx, orgID, uuid.MustParse(userID))
+	if err != nil {
+		// Non-members get the same response as a missing organization
+		if errors.Is(err, organization.ErrMemberNotFound) {
+			return h.ErrorHandler.HandleHTTPError(c, err, "Organization not found", http.StatusNotFound)
+		}
+		return h.ErrorHandler.HandleHTTPError(c, err, "Internal server error", http.StatusInternalServerError)
+	}
+
+	org, err := h.orgs.GetOrganization(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, organization.ErrOrganizationNotFound) {
+			return h.ErrorHandler.HandleHTTPError(c, err, "Organization not found", http.StatusNotFound)
+		}
+		return h.ErrorHandler.HandleHTTPError(c, err, "Internal server error", http.StatusInternalServerError)
+	}
+
+	dashboard, err := h.service.GetOrganizationDashboard(ctx, orgID)
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return c.Render(http.StatusOK, "organization_dashboard", shared.Data{
+		Title:           org.Name,
+		PageName:        "organization_dashboard",
+		IsAuthenticated: true,
+		Content: map[string]interface{}{
+			"Organization": org,
+			"Role":         role,
+			"IsAdmin":      role == organization.RoleAdmin,
+			"CanCreate":    orgRoleAllows(role, PermissionEdit),
+			"Dashboard":    dashboard,
+		},
+	})
+}
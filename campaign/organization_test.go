@@ -0,0 +1,129 @@
+package campaign_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *CampaignServiceTestSuite) TestCheckPermission_OrganizationRoles() {
+	orgID := uuid.New()
+	userID := uuid.New()
+	c := &campaign.Campaign{OwnerID: uuid.New(), OrganizationID: &orgID}
+	c.ID = uuid.New()
+
+	tests := []struct {
+		name       string
+		role       organization.Role
+		permission campaign.Permission
+		// collaborator is consulted when the org role doesn't grant the permission
+		collaborator *campaign.Member
+		wantErr      error
+	}{
+		{name: "admin can manage", role: organization.RoleAdmin, permission: campaign.PermissionManage},
+		{name: "editor can edit", role: organization.RoleEditor, permission: campaign.PermissionEdit},
+		{name: "editor cannot manage", role: organization.RoleEditor, permission: campaign.PermissionManage,
+			wantErr: campaign.ErrUnauthorizedAccess},
+		{name: "viewer can view", role: organization.RoleViewer, permission: campaign.PermissionView},
+		{name: "viewer cannot edit", role: organization.RoleViewer, permission: campaign.PermissionEdit,
+			wantErr: campaign.ErrUnauthorizedAccess},
+		{name: "viewer who is also a campaign editor can edit", role: organization.RoleViewer,
+			permission: campaign.PermissionEdit, collaborator: &campaign.Member{Role: campaign.RoleEditor}},
+		{name: "non-member cannot view", permission: campaign.PermissionView,
+			wantErr: campaign.ErrUnauthorizedAccess},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockOrgs.ExpectedCalls = nil
+			s.mockMembers.ExpectedCalls = nil
+
+			if tt.role == "" {
+				s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, userID).
+					Return("", organization.ErrMemberNotFound).Once()
+			} else {
+				s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, userID).Return(tt.role, nil).Once()
+			}
+			if tt.wantErr != nil || tt.collaborator != nil {
+				var err error
+				if tt.collaborator == nil {
+					err = campaign.ErrMemberNotFound
+				}
+				s.mockMembers.EXPECT().GetByCampaignAndUser(mock.Anything, c.ID, userID).
+					Return(tt.collaborator, err).Once()
+			}
+
+			err := s.service.CheckPermission(context.Background(), c, userID, tt.permission)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestCreateCampaign_InOrganization() {
+	orgID := uuid.New()
+	ownerID := uuid.New()
+	dto := func() *campaign.CreateCampaignDTO {
+		return &campaign.CreateCampaignDTO{
+			Name:           "Coalition Campaign",
+			Description:    "Run by the coalition",
+			Template:       "Dear {{.representative.name}}",
+			OwnerID:        ownerID,
+			OrganizationID: &orgID,
+		}
+	}
+
+	s.Run("editors may add campaigns", func() {
+		s.mockRepo.ExpectedCalls = nil
+		s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, ownerID).Return(organization.RoleEditor, nil).Once()
+		s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(d *campaign.CreateCampaignDTO) bool {
+			return d.OrganizationID != nil && *d.OrganizationID == orgID
+		})).Return(&campaign.Campaign{OwnerID: ownerID, OrganizationID: &orgID}, nil).Once()
+		s.mockLogger.EXPECT().Info("Campaign created successfully", "id", mock.Anything).Return().Once()
+
+		created, err := s.service.CreateCampaign(context.Background(), dto())
+		s.NoError(err)
+		s.Equal(&orgID, created.OrganizationID)
+	})
+
+	s.Run("viewers may not", func() {
+		s.mockOrgs.EXPECT().MemberRole(mock.Anything, orgID, ownerID).Return(organization.RoleViewer, nil).Once()
+		s.mockLogger.EXPECT().Debug("Creator may not add campaigns to organization",
+			"organizationID", mock.Anything, "ownerID", ownerID).Return().Once()
+
+		_, err := s.service.CreateCampaign(context.Background(), dto())
+		s.ErrorIs(err, campaign.ErrUnauthorizedAccess)
+	})
+}
+
+func (s *CampaignServiceTestSuite) TestGetOrganizationDashboard() {
+	orgID := uuid.New()
+	first := campaign.Campaign{Name: "First", OrganizationID: &orgID}
+	first.ID = uuid.New()
+	second := campaign.Campaign{Name: "Second", OrganizationID: &orgID}
+	second.ID = uuid.New()
+
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetByOrganization(mock.Anything, orgID).
+		Return([]campaign.Campaign{first, second}, nil).Once()
+	s.mockSendRepo.EXPECT().CountByCampaign(mock.Anything, []uuid.UUID{first.ID, second.ID}).
+		Return(map[uuid.UUID]campaign.SendStats{
+			first.ID: {Sent: 4, Pending: 1, Failed: 2},
+		}, nil).Once()
+
+	dashboard, err := s.service.GetOrganizationDashboard(context.Background(), orgID)
+	s.Require().NoError(err)
+
+	s.Equal(orgID, dashboard.OrganizationID)
+	s.Require().Len(dashboard.Campaigns, 2)
+	s.Equal(campaign.SendStats{Sent: 4, Pending: 1, Failed: 2}, dashboard.Campaigns[0].Stats)
+	s.Equal(campaign.SendStats{}, dashboard.Campaigns[1].Stats)
+	s.Equal(campaign.SendStats{Sent: 4, Pending: 1, Failed: 2}, dashboard.Totals)
+	s.Equal(int64(7), dashboard.Totals.Total())
+}
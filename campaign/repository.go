@@ -5,6 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/jonesrussell/mp-emailer/database"
 	"github.com/jonesrussell/mp-emailer/shared"
@@ -14,9 +18,18 @@ import (
 type RepositoryInterface interface {
 	Create(ctx context.Context, dto *CreateCampaignDTO) (*Campaign, error)
 	GetAll(ctx context.Context) ([]Campaign, error)
+	List(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error)
+	Search(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error)
 	Update(ctx context.Context, dto *UpdateCampaignDTO) error
 	Delete(ctx context.Context, dto DeleteCampaignDTO) error
 	GetByID(ctx context.Context, dto GetCampaignDTO) (*Campaign, error)
+	GetActive(ctx context.Context, now time.Time) ([]Campaign, error)
+	GetByOwner(ctx context.Context, ownerID uuid.UUID) ([]Campaign, error)
+	GetSharedWith(ctx context.Context, userID uuid.UUID) ([]Campaign, error)
+	GetByOrganization(ctx context.Context, orgID uuid.UUID) ([]Campaign, error)
+	GetDueForActivation(ctx context.Context, now time.Time) ([]Campaign, error)
+	GetDueForClosing(ctx context.Context, now time.Time) ([]Campaign, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, status Status) error
 }
 
 // Repository implements the RepositoryInterface
@@ -32,10 +45,23 @@ func NewRepository(params RepositoryParams) RepositoryInterface {
 // Create creates a new campaign in the database
 func (r *Repository) Create(ctx context.Context, dto *CreateCampaignDTO) (*Campaign, error) {
 	campaign := &Campaign{
-		Name:        dto.Name,
-		Description: dto.Description,
-		Template:    dto.Template,
-		OwnerID:     dto.OwnerID,
+		Name:                dto.Name,
+		Description:         dto.Description,
+		Subject:             strings.TrimSpace(dto.Subject),
+		Language:            dto.Language,
+		Template:            dto.Template,
+		Translations:        dto.Translations,
+		Targets:             NormalizeTargets(dto.Targets),
+		Tokens:              dto.Tokens,
+		Tags:                NormalizeTags(dto.Tags),
+		CustomFields:        dto.CustomFields,
+		OwnerID:             dto.OwnerID,
+		OrganizationID:      dto.OrganizationID,
+		RequireConfirmation: dto.RequireConfirmation,
+		CopyConstituent:     dto.CopyConstituent,
+		Status:              dto.Status,
+		StartsAt:            dto.StartsAt,
+		EndsAt:              dto.EndsAt,
 	}
 
 	if err := r.db.Create(ctx, campaign); err != nil {
@@ -65,6 +91,64 @@ func (r *Repository) GetAll(ctx context.Context) ([]Campaign, error) {
 	return campaigns, nil
 }
 
+// List retrieves one page of campaigns matching the filters
+func (r *Repository) List(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error) {
+	params = params.WithDefaults()
+	page, err := r.findPage(ctx, params, params.conditions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list campaigns: %w", err)
+	}
+	return page, nil
+}
+
+// Search retrieves one page of campaigns whose name, description or
+// template match params.Query, most relevant first unless another sort is
+// requested. The other filters apply as they do for List.
+func (r *Repository) Search(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error) {
+	params = params.WithDefaults()
+	if params.Query == "" {
+		return nil, fmt.Errorf("%w: empty search query", ErrInvalidListParams)
+	}
+
+	conditions := append(params.conditions(), database.Where(matchExpr, params.Query))
+	page, err := r.findPage(ctx, params, conditions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search campaigns: %w", err)
+	}
+	return page, nil
+}
+
+// findPage loads the page of campaigns params asks for
+func (r *Repository) findPage(
+	ctx context.Context,
+	params ListCampaignsParams,
+	conditions []database.Condition,
+) (*CampaignPage, error) {
+	order, orderArgs := params.orderClause()
+
+	var campaigns []Campaign
+	total, err := r.db.FindPage(ctx, &campaigns, database.QueryOptions{
+		Conditions: conditions,
+		Order:      order,
+		OrderArgs:  orderArgs,
+		Limit:      params.PageSize,
+		Offset:     params.Offset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if campaigns == nil {
+		campaigns = []Campaign{}
+	}
+
+	return &CampaignPage{
+		Campaigns: campaigns,
+		Total:     total,
+		Page:      params.Page,
+		PageSize:  params.PageSize,
+	}, nil
+}
+
 // Update updates an existing campaign in the database
 func (r *Repository) Update(ctx context.Context, dto *UpdateCampaignDTO) error {
 	// First fetch the existing campaign to preserve owner_id
@@ -75,11 +159,24 @@ func (r *Repository) Update(ctx context.Context, dto *UpdateCampaignDTO) error {
 
 	// Create updated campaign with preserved owner_id
 	campaign := &Campaign{
-		BaseModel:   shared.BaseModel{ID: dto.ID},
-		Name:        dto.Name,
-		Description: dto.Description,
-		Template:    dto.Template,
-		OwnerID:     existing.OwnerID, // Preserve the owner_id
+		BaseModel:           shared.BaseModel{ID: dto.ID},
+		Name:                dto.Name,
+		Description:         dto.Description,
+		Subject:             strings.TrimSpace(dto.Subject),
+		Language:            dto.Language,
+		Template:            dto.Template,
+		Translations:        dto.Translations,
+		Targets:             NormalizeTargets(dto.Targets),
+		Tokens:              dto.Tokens,
+		Tags:                NormalizeTags(dto.Tags),
+		CustomFields:        dto.CustomFields,
+		RequireConfirmation: dto.RequireConfirmation,
+		CopyConstituent:     dto.CopyConstituent,
+		OwnerID:             existing.OwnerID, // Preserve the owner_id
+		OrganizationID:      existing.OrganizationID,
+		Status:              existing.Status, // Status only changes through UpdateStatus
+		StartsAt:            dto.StartsAt,
+		EndsAt:              dto.EndsAt,
 	}
 
 	if err := r.db.Update(ctx, campaign); err != nil {
@@ -107,3 +204,63 @@ func (r *Repository) GetByID(ctx context.Context, dto GetCampaignDTO) (*Campaign
 	}
 	return &campaign, nil
 }
+
+// GetActive retrieves the campaigns that are accepting letters at the given time
+func (r *Repository) GetActive(ctx context.Context, now time.Time) ([]Campaign, error) {
+	return r.findAll(ctx,
+		"status = ? AND (starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)",
+		StatusActive, now, now)
+}
+
+// GetByOwner retrieves every campaign owned by a user, whatever its status
+func (r *Repository) GetByOwner(ctx context.Context, ownerID uuid.UUID) ([]Campaign, error) {
+	return r.findAll(ctx, "owner_id = ?", ownerID)
+}
+
+// GetSharedWith retrieves the campaigns a user has accepted an invitation to
+func (r *Repository) GetSharedWith(ctx context.Context, userID uuid.UUID) ([]Campaign, error) {
+	return r.findAll(ctx,
+		"id IN (SELECT campaign_id FROM campaign_members WHERE user_id = ? AND deleted_at IS NULL)",
+		userID)
+}
+
+// GetByOrganization retrieves every campaign run by an organization, whatever its status
+func (r *Repository) GetByOrganization(ctx context.Context, orgID uuid.UUID) ([]Campaign, error) {
+	return r.findAll(ctx, "organization_id = ?", orgID)
+}
+
+// GetDueForActivation retrieves scheduled campaigns whose start time has passed
+func (r *Repository) GetDueForActivation(ctx context.Context, now time.Time) ([]Campaign, error) {
+	return r.findAll(ctx, "status = ? AND starts_at IS NOT NULL AND starts_at <= ?", StatusScheduled, now)
+}
+
+// GetDueForClosing retrieves active campaigns whose end time has passed
+func (r *Repository) GetDueForClosing(ctx context.Context, now time.Time) ([]Campaign, error) {
+	return r.findAll(ctx, "status = ? AND ends_at IS NOT NULL AND ends_at <= ?", StatusActive, now)
+}
+
+// UpdateStatus moves a campaign to a new status
+func (r *Repository) UpdateStatus(ctx context.Context, id uuid.UUID, status Status) error {
+	var campaign Campaign
+	if err := r.db.FindOne(ctx, &campaign, "id = ?", id); err != nil {
+		return fmt.Errorf("error finding campaign: %w", err)
+	}
+
+	campaign.Status = status
+	if err := r.db.Update(ctx, &campaign); err != nil {
+		return fmt.Errorf("error updating campaign status: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) findAll(ctx context.Context, query string, args ...interface{}) ([]Campaign, error) {
+	var campaigns []Campaign
+	err := r.db.FindAll(ctx, &campaigns, query, args...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get campaigns: %w", err)
+	}
+	if len(campaigns) == 0 {
+		return []Campaign{}, nil
+	}
+	return campaigns, nil
+}
@@ -3,9 +3,11 @@ package campaign_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/database"
 	mockdb "github.com/jonesrussell/mp-emailer/mocks/database"
 	"github.com/jonesrussell/mp-emailer/shared"
 	"github.com/stretchr/testify/assert"
@@ -97,6 +99,26 @@ func (s *RepositoryTestSuite) TestCreate() {
 	}
 }
 
+func (s *RepositoryTestSuite) TestCreate_NormalizesTags() {
+	s.mockDB.EXPECT().Create(
+		mock.Anything,
+		mock.MatchedBy(func(c *campaign.Campaign) bool {
+			return s.Equal([]string{"housing", "rent"}, c.Tags)
+		}),
+	).Return(nil)
+	s.mockDB.EXPECT().FindOne(mock.Anything, mock.AnythingOfType("*campaign.Campaign"), "id = ?", mock.Anything).
+		Return(nil)
+
+	_, err := s.repo.Create(context.Background(), &campaign.CreateCampaignDTO{
+		Name:        "Rent Relief",
+		Description: "d",
+		Template:    "t",
+		Tags:        []string{" Housing", "rent", "housing", ""},
+		OwnerID:     uuid.New(),
+	})
+	s.NoError(err)
+}
+
 func (s *RepositoryTestSuite) TestGetAll() {
 	tests := []struct {
 		name      string
@@ -294,3 +316,100 @@ func (s *RepositoryTestSuite) TestGetByID() {
 		})
 	}
 }
+
+func (s *RepositoryTestSuite) TestList() {
+	ownerID := uuid.New()
+
+	s.mockDB.EXPECT().FindPage(
+		mock.Anything,
+		mock.AnythingOfType("*[]campaign.Campaign"),
+		database.QueryOptions{
+			Conditions: []database.Condition{
+				database.Where("owner_id = ?", ownerID),
+				database.Where("status = ?", campaign.StatusDraft),
+				database.Where("JSON_CONTAINS(tags, JSON_QUOTE(?))", "housing"),
+			},
+			Order:  "name ASC, id ASC",
+			Limit:  10,
+			Offset: 10,
+		},
+	).Run(func(_ context.Context, dest interface{}, _ database.QueryOptions) {
+		*dest.(*[]campaign.Campaign) = []campaign.Campaign{{Name: "Affordable Housing"}}
+	}).Return(int64(11), nil)
+
+	page, err := s.repo.List(context.Background(), campaign.ListCampaignsParams{
+		OwnerID:  &ownerID,
+		Status:   campaign.StatusDraft,
+		Tag:      "Housing",
+		Sort:     campaign.SortName,
+		Page:     2,
+		PageSize: 10,
+	})
+
+	s.Require().NoError(err)
+	s.Len(page.Campaigns, 1)
+	s.Equal(int64(11), page.Total)
+	s.Equal(2, page.TotalPages())
+	s.False(page.HasNext())
+}
+
+func (s *RepositoryTestSuite) TestSearch() {
+	const match = "MATCH(name, description, template) AGAINST (? IN NATURAL LANGUAGE MODE)"
+	now := time.Now()
+
+	s.Run("ranks matches by relevance", func() {
+		s.mockDB.EXPECT().FindPage(
+			mock.Anything,
+			mock.AnythingOfType("*[]campaign.Campaign"),
+			database.QueryOptions{
+				Conditions: []database.Condition{
+					database.Where(
+						"status = ? AND (starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)",
+						campaign.StatusActive, now, now),
+					database.Where(match, "bike lanes"),
+				},
+				Order:     match + " DESC, id DESC",
+				OrderArgs: []interface{}{"bike lanes"},
+				Limit:     campaign.DefaultPageSize,
+				Offset:    0,
+			},
+		).Run(func(_ context.Context, dest interface{}, _ database.QueryOptions) {
+			*dest.(*[]campaign.Campaign) = []campaign.Campaign{{Name: "Safe Bike Lanes"}}
+		}).Return(int64(1), nil).Once()
+
+		page, err := s.repo.Search(context.Background(), campaign.ListCampaignsParams{
+			Query:  " bike lanes ",
+			OpenAt: &now,
+		})
+
+		s.Require().NoError(err)
+		s.Len(page.Campaigns, 1)
+		s.Equal(int64(1), page.Total)
+	})
+
+	s.Run("honours an explicit sort", func() {
+		s.mockDB.EXPECT().FindPage(
+			mock.Anything,
+			mock.AnythingOfType("*[]campaign.Campaign"),
+			database.QueryOptions{
+				Conditions: []database.Condition{database.Where(match, "parks")},
+				Order:      "name ASC, id ASC",
+				Limit:      campaign.DefaultPageSize,
+				Offset:     0,
+			},
+		).Return(int64(0), nil).Once()
+
+		page, err := s.repo.Search(context.Background(), campaign.ListCampaignsParams{
+			Query: "parks",
+			Sort:  campaign.SortName,
+		})
+
+		s.Require().NoError(err)
+		s.Empty(page.Campaigns)
+	})
+
+	s.Run("requires a query", func() {
+		_, err := s.repo.Search(context.Background(), campaign.ListCampaignsParams{Query: "  "})
+		s.ErrorIs(err, campaign.ErrInvalidListParams)
+	})
+}
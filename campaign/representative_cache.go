@@ -0,0 +1,168 @@
+package campaign
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+	"golang.org/x/sync/singleflight"
+)
+
+// CachingLookupService caches the representatives of each postal code in
+// memory and, optionally, in the database. Fresh lookups are served as they
+// are; stale ones are served while a single background request refreshes
+// them; and concurrent lookups of the same uncached postal code share one
+// request to the API.
+type CachingLookupService struct {
+	lookup   RepresentativeLookupServiceInterface
+	store    RepresentativeCacheRepositoryInterface
+	cache    *lru.Cache[string, CachedLookup]
+	group    singleflight.Group
+	ttl      time.Duration
+	staleTTL time.Duration
+	now      func() time.Time
+	Logger   logger.Interface
+}
+
+// CachingLookupServiceParams holds the dependencies of the caching decorator
+type CachingLookupServiceParams struct {
+	fx.In
+
+	Lookup RepresentativeLookupServiceInterface
+	Store  RepresentativeCacheRepositoryInterface
+	Config *config.Config
+	Logger logger.Interface
+}
+
+// NewCachingLookupService wraps a lookup service in the representative
+// cache, unless the configuration turns it off
+func NewCachingLookupService(params CachingLookupServiceParams) (RepresentativeLookupServiceInterface, error) {
+	cfg := params.Config.Representatives.Cache
+	if !cfg.Enabled {
+		return params.Lookup, nil
+	}
+
+	cache, err := lru.New[string, CachedLookup](max(cfg.Size, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &CachingLookupService{
+		lookup:   params.Lookup,
+		cache:    cache,
+		ttl:      cfg.TTL,
+		staleTTL: cfg.StaleTTL,
+		now:      time.Now,
+		Logger:   params.Logger,
+	}
+	if cfg.Database {
+		s.store = params.Store
+	}
+	return s, nil
+}
+
+// FetchRepresentatives implements RepresentativeLookupServiceInterface.
+// Concurrent lookups share one request, so one caller giving up mustn't
+// cancel it for the others: the request keeps the caller's context values
+// but not its cancellation, and a cancelled caller just stops waiting.
+func (s *CachingLookupService) FetchRepresentatives(ctx context.Context, postalCode string) ([]Representative, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	key := normalizePostalCode(postalCode)
+	shared := context.WithoutCancel(ctx)
+
+	if cached, ok := s.cached(ctx, key); ok {
+		age := s.now().Sub(cached.FetchedAt)
+		switch {
+		case age < s.ttl:
+			return slices.Clone(cached.Representatives), nil
+		case age < s.ttl+s.staleTTL:
+			// The result channel is buffered, so nobody has to wait for the refresh
+			s.group.DoChan(key, func() (interface{}, error) {
+				representatives, err := s.refresh(shared, key)
+				if err != nil {
+					s.Logger.Warn("Failed to refresh cached representatives", "postalCode", key, "error", err)
+				}
+				return representatives, err
+			})
+			return slices.Clone(cached.Representatives), nil
+		}
+	}
+
+	result := s.group.DoChan(key, func() (interface{}, error) {
+		return s.refresh(shared, key)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-result:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		return slices.Clone(r.Val.([]Representative)), nil
+	}
+}
+
+// FilterRepresentatives implements RepresentativeLookupServiceInterface
+func (s *CachingLookupService) FilterRepresentatives(
+	representatives []Representative,
+	filters map[string]string,
+) []Representative {
+	return s.lookup.FilterRepresentatives(representatives, filters)
+}
+
+// cached returns the lookup held for a postal code in memory, falling back
+// to the database
+func (s *CachingLookupService) cached(ctx context.Context, key string) (CachedLookup, bool) {
+	if cached, ok := s.cache.Get(key); ok {
+		return cached, true
+	}
+	if s.store == nil {
+		return CachedLookup{}, false
+	}
+
+	stored, err := s.store.Get(ctx, key)
+	if err != nil {
+		// The cache only saves requests; the API can still answer
+		s.Logger.Warn("Failed to read cached representatives", "postalCode", key, "error", err)
+		return CachedLookup{}, false
+	}
+	if stored == nil {
+		return CachedLookup{}, false
+	}
+	s.cache.Add(key, *stored)
+	return *stored, true
+}
+
+// refresh asks the API for a postal code's representatives and caches them.
+// Lookups that found nobody aren't cached, so that a bad answer isn't kept.
+func (s *CachingLookupService) refresh(ctx context.Context, key string) ([]Representative, error) {
+	representatives, err := s.lookup.FetchRepresentatives(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(representatives) == 0 {
+		return representatives, nil
+	}
+
+	lookup := CachedLookup{PostalCode: key, Representatives: representatives, FetchedAt: s.now()}
+	s.cache.Add(key, lookup)
+	if s.store != nil {
+		if err := s.store.Put(ctx, &lookup); err != nil {
+			s.Logger.Warn("Failed to cache representatives", "postalCode", key, "error", err)
+		}
+	}
+	return representatives, nil
+}
+
+// normalizePostalCode upper-cases a postal code and removes its spaces, so
+// "k1a 0a6" and "K1A0A6" share a cache entry
+func normalizePostalCode(postalCode string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(postalCode), ""))
+}
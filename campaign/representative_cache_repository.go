@@ -0,0 +1,62 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/database"
+	"gorm.io/gorm"
+)
+
+// CachedLookup is the result of looking up the representatives of a postal
+// code, kept so the lookup API isn't asked again for a while
+type CachedLookup struct {
+	PostalCode      string           `gorm:"type:varchar(6);primarykey" json:"postal_code"`
+	Representatives []Representative `gorm:"type:json;serializer:json;not null" json:"representatives"`
+	FetchedAt       time.Time        `gorm:"not null" json:"fetched_at"`
+}
+
+// TableName overrides the default table name
+func (CachedLookup) TableName() string {
+	return "representative_lookups"
+}
+
+// RepresentativeCacheRepositoryInterface persists representative lookups
+type RepresentativeCacheRepositoryInterface interface {
+	// Get returns the lookup cached for a postal code, or nil if there is none
+	Get(ctx context.Context, postalCode string) (*CachedLookup, error)
+	// Put stores a lookup, replacing any cached for the same postal code
+	Put(ctx context.Context, lookup *CachedLookup) error
+}
+
+// RepresentativeCacheRepository implements RepresentativeCacheRepositoryInterface
+type RepresentativeCacheRepository struct {
+	db database.Database
+}
+
+// NewRepresentativeCacheRepository creates a new instance of RepresentativeCacheRepository
+func NewRepresentativeCacheRepository(params RepositoryParams) RepresentativeCacheRepositoryInterface {
+	return &RepresentativeCacheRepository{db: params.DB}
+}
+
+// Get implements RepresentativeCacheRepositoryInterface
+func (r *RepresentativeCacheRepository) Get(ctx context.Context, postalCode string) (*CachedLookup, error) {
+	var lookup CachedLookup
+	if err := r.db.FindOne(ctx, &lookup, "postal_code = ?", postalCode); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving cached lookup: %w", err)
+	}
+	return &lookup, nil
+}
+
+// Put implements RepresentativeCacheRepositoryInterface
+func (r *RepresentativeCacheRepository) Put(ctx context.Context, lookup *CachedLookup) error {
+	if err := r.db.Update(ctx, lookup); err != nil {
+		return fmt.Errorf("error caching lookup: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/config"
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLookup answers lookups with a fixed result, counts its calls and
+// keeps the context of the last one. When release is set, each call waits
+// for it to be closed.
+type fakeLookup struct {
+	RepresentativeLookupService
+	calls   atomic.Int32
+	result  []Representative
+	err     error
+	release chan struct{}
+	ctx     context.Context
+}
+
+func (f *fakeLookup) FetchRepresentatives(ctx context.Context, _ string) ([]Representative, error) {
+	f.calls.Add(1)
+	f.ctx = ctx
+	if f.release != nil {
+		<-f.release
+	}
+	return f.result, f.err
+}
+
+// fakeCacheStore keeps cached lookups in a map
+type fakeCacheStore struct {
+	mu      sync.Mutex
+	lookups map[string]CachedLookup
+}
+
+func (f *fakeCacheStore) Get(_ context.Context, postalCode string) (*CachedLookup, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if lookup, ok := f.lookups[postalCode]; ok {
+		return &lookup, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeCacheStore) Put(_ context.Context, lookup *CachedLookup) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lookups[lookup.PostalCode] = *lookup
+	return nil
+}
+
+func newTestCachingLookup(
+	t *testing.T,
+	lookup *fakeLookup,
+	store RepresentativeCacheRepositoryInterface,
+	now *time.Time,
+) *CachingLookupService {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Representatives.Cache = config.RepresentativeCacheConfig{
+		Enabled:  true,
+		Size:     10,
+		TTL:      time.Hour,
+		StaleTTL: 24 * time.Hour,
+		Database: store != nil,
+	}
+	log := mocksLogger.NewMockInterface(t)
+	log.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+	service, err := NewCachingLookupService(CachingLookupServiceParams{
+		Lookup: lookup,
+		Store:  store,
+		Config: cfg,
+		Logger: log,
+	})
+	require.NoError(t, err)
+
+	cached := service.(*CachingLookupService)
+	cached.now = func() time.Time { return *now }
+	return cached
+}
+
+func TestCachingLookupService(t *testing.T) {
+	janeDoe := []Representative{{Name: "Jane Doe", Email: "jane.doe@parl.gc.ca"}}
+	marcRoy := []Representative{{Name: "Marc Roy", Email: "marc.roy@parl.gc.ca"}}
+
+	t.Run("fresh lookups are served from memory", func(t *testing.T) {
+		now := time.Now()
+		lookup := &fakeLookup{result: janeDoe}
+		service := newTestCachingLookup(t, lookup, nil, &now)
+
+		first, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+		second, err := service.FetchRepresentatives(context.Background(), "k1a 0a6")
+		require.NoError(t, err)
+
+		assert.Equal(t, janeDoe, first)
+		assert.Equal(t, janeDoe, second)
+		assert.Equal(t, int32(1), lookup.calls.Load())
+	})
+
+	t.Run("stale lookups are served while they refresh", func(t *testing.T) {
+		now := time.Now()
+		lookup := &fakeLookup{result: janeDoe}
+		service := newTestCachingLookup(t, lookup, nil, &now)
+		_, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+
+		now = now.Add(2 * time.Hour)
+		lookup.result = marcRoy
+		lookup.release = make(chan struct{})
+
+		stale, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+		assert.Equal(t, janeDoe, stale)
+
+		close(lookup.release)
+		assert.Eventually(t, func() bool {
+			refreshed, _ := service.FetchRepresentatives(context.Background(), "K1A0A6")
+			return len(refreshed) == 1 && refreshed[0].Name == "Marc Roy"
+		}, time.Second, 5*time.Millisecond)
+		assert.Equal(t, int32(2), lookup.calls.Load())
+	})
+
+	t.Run("expired lookups are fetched again", func(t *testing.T) {
+		now := time.Now()
+		lookup := &fakeLookup{result: janeDoe}
+		service := newTestCachingLookup(t, lookup, nil, &now)
+		_, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+
+		now = now.Add(48 * time.Hour)
+		lookup.result = marcRoy
+
+		representatives, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+		assert.Equal(t, marcRoy, representatives)
+	})
+
+	t.Run("concurrent lookups share one request", func(t *testing.T) {
+		now := time.Now()
+		lookup := &fakeLookup{result: janeDoe, release: make(chan struct{})}
+		service := newTestCachingLookup(t, lookup, nil, &now)
+
+		var wg sync.WaitGroup
+		results := make([][]Representative, 5)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = service.FetchRepresentatives(context.Background(), "K1A0A6")
+			}()
+		}
+		assert.Eventually(t, func() bool { return lookup.calls.Load() == 1 }, time.Second, time.Millisecond)
+		// Give the other lookups time to join the request in flight
+		time.Sleep(20 * time.Millisecond)
+		close(lookup.release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), lookup.calls.Load())
+		for _, result := range results {
+			assert.Equal(t, janeDoe, result)
+		}
+	})
+
+	t.Run("a cancelled lookup stops waiting without cancelling the others", func(t *testing.T) {
+		now := time.Now()
+		lookup := &fakeLookup{result: janeDoe, release: make(chan struct{})}
+		service := newTestCachingLookup(t, lookup, nil, &now)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error)
+		go func() {
+			_, err := service.FetchRepresentatives(ctx, "K1A0A6")
+			cancelled <- err
+		}()
+		assert.Eventually(t, func() bool { return lookup.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+		waiting := make(chan []Representative)
+		go func() {
+			representatives, _ := service.FetchRepresentatives(context.Background(), "K1A0A6")
+			waiting <- representatives
+		}()
+		// Give the second lookup time to join the request in flight
+		time.Sleep(20 * time.Millisecond)
+
+		cancel()
+		assert.ErrorIs(t, <-cancelled, context.Canceled)
+
+		close(lookup.release)
+		assert.Equal(t, janeDoe, <-waiting)
+		assert.Equal(t, int32(1), lookup.calls.Load())
+		assert.NoError(t, lookup.ctx.Err(), "the shared request isn't cancelled")
+	})
+
+	t.Run("failures and empty results are not cached", func(t *testing.T) {
+		now := time.Now()
+		lookup := &fakeLookup{err: errors.New("rate limited")}
+		service := newTestCachingLookup(t, lookup, nil, &now)
+
+		_, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		assert.Error(t, err)
+
+		lookup.err = nil
+		representatives, err := service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+		assert.Empty(t, representatives)
+
+		lookup.result = janeDoe
+		representatives, err = service.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+		assert.Equal(t, janeDoe, representatives)
+		assert.Equal(t, int32(3), lookup.calls.Load())
+	})
+
+	t.Run("lookups are shared through the database", func(t *testing.T) {
+		now := time.Now()
+		store := &fakeCacheStore{lookups: map[string]CachedLookup{}}
+		first := newTestCachingLookup(t, &fakeLookup{result: janeDoe}, store, &now)
+		_, err := first.FetchRepresentatives(context.Background(), "K1A 0A6")
+		require.NoError(t, err)
+		assert.Contains(t, store.lookups, "K1A0A6")
+
+		// Another instance finds the lookup without asking the API
+		lookup := &fakeLookup{result: marcRoy}
+		second := newTestCachingLookup(t, lookup, store, &now)
+		representatives, err := second.FetchRepresentatives(context.Background(), "K1A0A6")
+		require.NoError(t, err)
+		assert.Equal(t, janeDoe, representatives)
+		assert.Zero(t, lookup.calls.Load())
+	})
+}
+
+func TestNewCachingLookupServiceDisabled(t *testing.T) {
+	lookup := &fakeLookup{}
+	service, err := NewCachingLookupService(CachingLookupServiceParams{
+		Lookup: lookup,
+		Config: &config.Config{},
+	})
+
+	require.NoError(t, err)
+	assert.Same(t, lookup, service)
+}
@@ -1,10 +1,9 @@
 package campaign
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
 
 	"github.com/jonesrussell/mp-emailer/config"
@@ -14,75 +13,100 @@ import (
 
 // RepresentativeLookupServiceInterface defines the interface for representative lookup
 type RepresentativeLookupServiceInterface interface {
-	FetchRepresentatives(postalCode string) ([]Representative, error)
+	FetchRepresentatives(ctx context.Context, postalCode string) ([]Representative, error)
 	FilterRepresentatives(representatives []Representative, filters map[string]string) []Representative
 }
 
 // RepresentativeLookupService implements RepresentativeLookupServiceInterface
+// with a chain of providers. Each provider is asked in turn until one finds
+// somebody, so that a failing or incomplete source falls back to the next.
 type RepresentativeLookupService struct {
-	Logger  logger.Interface
-	baseURL string
+	providers []RepresentativeProvider
+	Logger    logger.Interface
 }
 
 type RepresentativeLookupServiceParams struct {
 	fx.In
 
-	Config *config.Config
-	Logger logger.Interface
+	Config    *config.Config
+	Logger    logger.Interface
+	Providers []RepresentativeProvider `group:"representative_providers"`
 }
 
-// NewRepresentativeLookupService creates a new instance of RepresentativeLookupService
-func NewRepresentativeLookupService(params RepresentativeLookupServiceParams) RepresentativeLookupServiceInterface {
-	return &RepresentativeLookupService{
-		Logger:  params.Logger,
-		baseURL: params.Config.Server.RepresentativeLookupBaseURL,
+// NewRepresentativeLookupService chains the registered providers in the
+// order the configuration names them
+func NewRepresentativeLookupService(params RepresentativeLookupServiceParams) (RepresentativeLookupServiceInterface, error) {
+	registered := make(map[string]RepresentativeProvider, len(params.Providers))
+	for _, provider := range params.Providers {
+		registered[provider.Name()] = provider
 	}
-}
 
-func (s *RepresentativeLookupService) FetchRepresentatives(postalCode string) ([]Representative, error) {
-	url := fmt.Sprintf("%s/postcodes/%s/?format=json", s.baseURL, postalCode)
-	s.Logger.Info("Making request to", "url", url)
-
-	resp, err := http.Get(url)
-	if err != nil {
-		s.Logger.Error("Error making request", err)
-		return nil, fmt.Errorf("error making request: %w", err)
+	names := params.Config.Representatives.Providers
+	if len(names) == 0 {
+		names = []config.RepresentativeProvider{config.RepresentativeProviderRepresent}
 	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			s.Logger.Error("Error closing response body", err)
+	providers := make([]RepresentativeProvider, 0, len(names))
+	for _, name := range names {
+		provider, ok := registered[string(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown representative provider %q", name)
 		}
-	}(resp.Body)
+		providers = append(providers, provider)
+	}
 
-	s.Logger.Info("Response received", "status", resp.Status)
+	return &RepresentativeLookupService{
+		providers: providers,
+		Logger:    params.Logger,
+	}, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		s.Logger.Error("Error reading response body", err)
-		return nil, fmt.Errorf("error reading response: %w", err)
-	}
+// FetchRepresentatives asks each provider in turn and returns the first
+// representatives found, marked with the provider that found them. When
+// nobody is found, the providers' errors are returned together. A cancelled
+// lookup doesn't fall back.
+func (s *RepresentativeLookupService) FetchRepresentatives(ctx context.Context, postalCode string) ([]Representative, error) {
+	var errs []error
+	for _, provider := range s.providers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		representatives, err := provider.FetchRepresentatives(ctx, postalCode)
+		if err != nil {
+			s.Logger.Warn("Representative provider failed", "provider", provider.Name(), "postalCode", postalCode, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+			continue
+		}
+		if len(representatives) == 0 {
+			s.Logger.Debug("Representative provider found nobody", "provider", provider.Name(), "postalCode", postalCode)
+			continue
+		}
 
-	var apiResp APIResponse
-	err = json.Unmarshal(body, &apiResp)
-	if err != nil {
-		s.Logger.Error("Error unmarshaling JSON", err)
-		return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
+		for i := range representatives {
+			representatives[i].Provider = provider.Name()
+		}
+		s.Logger.Info("Representatives found", "provider", provider.Name(), "postalCode", postalCode,
+			"count", len(representatives))
+		return representatives, nil
 	}
-	return apiResp.RepresentativesCentroid, nil
+	return []Representative{}, errors.Join(errs...)
 }
 
 func (s *RepresentativeLookupService) FilterRepresentatives(representatives []Representative, filters map[string]string) []Representative {
+	return filterRepresentatives(s.Logger, representatives, filters)
+}
+
+// filterRepresentatives keeps the representatives that match every filter
+func filterRepresentatives(log logger.Interface, representatives []Representative, filters map[string]string) []Representative {
 	filtered := make([]Representative, 0)
 	for _, rep := range representatives {
-		if s.matchesFilters(rep, filters) {
+		if matchesFilters(log, rep, filters) {
 			filtered = append(filtered, rep)
 		}
 	}
 	return filtered
 }
 
-func (s *RepresentativeLookupService) matchesFilters(rep Representative, filters map[string]string) bool {
+func matchesFilters(log logger.Interface, rep Representative, filters map[string]string) bool {
 	for key, value := range filters {
 		switch key {
 		case "type":
@@ -102,7 +126,7 @@ func (s *RepresentativeLookupService) matchesFilters(rep Representative, filters
 				return false
 			}
 		default:
-			s.Logger.Warn("Unknown filter key", "key", key)
+			log.Warn("Unknown filter key", "key", key)
 		}
 	}
 	return true
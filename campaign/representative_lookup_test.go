@@ -0,0 +1,186 @@
+package campaign_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/httpclient"
+	mocksCampaign "github.com/jonesrussell/mp-emailer/mocks/campaign"
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProvider(t *testing.T, name string) *mocksCampaign.MockRepresentativeProvider {
+	provider := mocksCampaign.NewMockRepresentativeProvider(t)
+	provider.EXPECT().Name().Return(name).Maybe()
+	return provider
+}
+
+func newTestLookup(
+	t *testing.T,
+	chain []config.RepresentativeProvider,
+	providers ...campaign.RepresentativeProvider,
+) campaign.RepresentativeLookupServiceInterface {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Representatives.Providers = chain
+	log := mocksLogger.NewMockInterface(t)
+	log.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+	lookup, err := campaign.NewRepresentativeLookupService(campaign.RepresentativeLookupServiceParams{
+		Config:    cfg,
+		Logger:    log,
+		Providers: providers,
+	})
+	require.NoError(t, err)
+	return lookup
+}
+
+func TestRepresentativeLookupService_FallbackChain(t *testing.T) {
+	jane := campaign.Representative{Name: "Jane Doe", DistrictName: "Ottawa Centre"}
+	chain := []config.RepresentativeProvider{config.RepresentativeProviderRepresent, config.RepresentativeProviderDataset}
+
+	t.Run("the first provider answers", func(t *testing.T) {
+		represent := newTestProvider(t, "represent")
+		dataset := newTestProvider(t, "dataset")
+		represent.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return([]campaign.Representative{jane}, nil).Once()
+
+		reps, err := newTestLookup(t, chain, dataset, represent).FetchRepresentatives(context.Background(), "K1A0A6")
+
+		require.NoError(t, err)
+		require.Len(t, reps, 1)
+		assert.Equal(t, "represent", reps[0].Provider)
+		dataset.AssertNotCalled(t, "FetchRepresentatives", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a failing provider falls back to the next", func(t *testing.T) {
+		represent := newTestProvider(t, "represent")
+		dataset := newTestProvider(t, "dataset")
+		represent.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return(nil, errors.New("timeout")).Once()
+		dataset.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return([]campaign.Representative{jane}, nil).Once()
+
+		reps, err := newTestLookup(t, chain, represent, dataset).FetchRepresentatives(context.Background(), "K1A0A6")
+
+		require.NoError(t, err)
+		require.Len(t, reps, 1)
+		assert.Equal(t, "dataset", reps[0].Provider)
+	})
+
+	t.Run("a provider that finds nobody falls back to the next", func(t *testing.T) {
+		represent := newTestProvider(t, "represent")
+		dataset := newTestProvider(t, "dataset")
+		represent.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return([]campaign.Representative{}, nil).Once()
+		dataset.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return([]campaign.Representative{jane}, nil).Once()
+
+		reps, err := newTestLookup(t, chain, represent, dataset).FetchRepresentatives(context.Background(), "K1A0A6")
+
+		require.NoError(t, err)
+		require.Len(t, reps, 1)
+		assert.Equal(t, "dataset", reps[0].Provider)
+	})
+
+	t.Run("every provider fails", func(t *testing.T) {
+		represent := newTestProvider(t, "represent")
+		dataset := newTestProvider(t, "dataset")
+		timeout := errors.New("timeout")
+		represent.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return(nil, timeout).Once()
+		dataset.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return(nil, errors.New("no such table")).Once()
+
+		reps, err := newTestLookup(t, chain, represent, dataset).FetchRepresentatives(context.Background(), "K1A0A6")
+
+		assert.ErrorIs(t, err, timeout)
+		assert.ErrorContains(t, err, "dataset: no such table")
+		assert.Empty(t, reps)
+	})
+
+	t.Run("a cancelled lookup doesn't fall back", func(t *testing.T) {
+		represent := newTestProvider(t, "represent")
+		dataset := newTestProvider(t, "dataset")
+		ctx, cancel := context.WithCancel(context.Background())
+		represent.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").
+			RunAndReturn(func(ctx context.Context, _ string) ([]campaign.Representative, error) {
+				cancel()
+				return nil, ctx.Err()
+			}).Once()
+
+		_, err := newTestLookup(t, chain, represent, dataset).FetchRepresentatives(ctx, "K1A0A6")
+
+		assert.ErrorIs(t, err, context.Canceled)
+		dataset.AssertNotCalled(t, "FetchRepresentatives", mock.Anything, mock.Anything)
+	})
+
+	t.Run("nobody is found", func(t *testing.T) {
+		represent := newTestProvider(t, "represent")
+		represent.EXPECT().FetchRepresentatives(mock.Anything, "K1A0A6").Return([]campaign.Representative{}, nil).Once()
+
+		reps, err := newTestLookup(t, nil, represent).FetchRepresentatives(context.Background(), "K1A0A6")
+
+		require.NoError(t, err)
+		assert.Empty(t, reps)
+	})
+}
+
+func TestNewRepresentativeLookupService_UnknownProvider(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Representatives.Providers = []config.RepresentativeProvider{"carrier-pigeon"}
+
+	_, err := campaign.NewRepresentativeLookupService(campaign.RepresentativeLookupServiceParams{
+		Config:    cfg,
+		Providers: []campaign.RepresentativeProvider{newTestProvider(t, "represent")},
+	})
+
+	assert.ErrorContains(t, err, "carrier-pigeon")
+}
+
+func TestRepresentProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/postcodes/K1A0A6/":
+			_, _ = w.Write([]byte(`{"representatives_centroid": [{"name": "Jane Doe", "elected_office": "MP"}]}`))
+		case "/postcodes/X0X0X0/":
+			http.NotFound(w, r)
+		default:
+			http.Error(w, "<html>Bad gateway</html>", http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{}
+	cfg.Server.RepresentativeLookupBaseURL = server.URL
+	log := mocksLogger.NewMockInterface(t)
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	provider := campaign.NewRepresentProvider(campaign.RepresentProviderParams{
+		Config: cfg,
+		Client: httpclient.New(httpclient.Options{Timeout: time.Second}, log),
+		Logger: log,
+	})
+
+	reps, err := provider.FetchRepresentatives(context.Background(), "k1a 0a6")
+	require.NoError(t, err)
+	assert.Equal(t, []campaign.Representative{{Name: "Jane Doe", ElectedOffice: "MP"}}, reps)
+
+	reps, err = provider.FetchRepresentatives(context.Background(), "X0X0X0")
+	require.NoError(t, err, "unknown postal codes find nobody")
+	assert.Empty(t, reps)
+
+	_, err = provider.FetchRepresentatives(context.Background(), "K2P2L8")
+	assert.ErrorIs(t, err, httpclient.ErrUnavailable)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = provider.FetchRepresentatives(ctx, "K1A0A6")
+	assert.ErrorIs(t, err, context.Canceled)
+}
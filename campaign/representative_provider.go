@@ -0,0 +1,72 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/httpclient"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+)
+
+// RepresentativeProvider is a source of representatives by postal code.
+// Providers are registered in the "representative_providers" group, and
+// the lookup service asks them in the order the configuration names them.
+type RepresentativeProvider interface {
+	// Name is how the configuration refers to the provider
+	Name() string
+	FetchRepresentatives(ctx context.Context, postalCode string) ([]Representative, error)
+}
+
+// RepresentProviderParams for dependency injection
+type RepresentProviderParams struct {
+	fx.In
+
+	Config *config.Config
+	Client *httpclient.Client
+	Logger logger.Interface
+}
+
+// RepresentProvider looks representatives up with the Represent API
+type RepresentProvider struct {
+	client  *httpclient.Client
+	Logger  logger.Interface
+	baseURL string
+}
+
+// NewRepresentProvider creates a new instance of RepresentProvider
+func NewRepresentProvider(params RepresentProviderParams) *RepresentProvider {
+	return &RepresentProvider{
+		client:  params.Client,
+		Logger:  params.Logger,
+		baseURL: params.Config.Server.RepresentativeLookupBaseURL,
+	}
+}
+
+// Name implements RepresentativeProvider
+func (p *RepresentProvider) Name() string {
+	return string(config.RepresentativeProviderRepresent)
+}
+
+// FetchRepresentatives implements RepresentativeProvider. Represent answers
+// 404 for postal codes it doesn't know, which is reported as nobody found.
+func (p *RepresentProvider) FetchRepresentatives(ctx context.Context, postalCode string) ([]Representative, error) {
+	postalCode = normalizePostalCode(postalCode)
+	lookupURL := fmt.Sprintf("%s/postcodes/%s/?format=json", p.baseURL, url.PathEscape(postalCode))
+
+	var apiResp APIResponse
+	err := p.client.GetJSON(ctx, lookupURL, &apiResp)
+	var statusErr *httpclient.StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return []Representative{}, nil
+	}
+	if err != nil {
+		p.Logger.Error("Error fetching representatives", err, "postalCode", postalCode)
+		return nil, err
+	}
+	return apiResp.RepresentativesCentroid, nil
+}
@@ -12,26 +12,42 @@ func RegisterRoutes(h *Handler, e *echo.Echo, sessionManager session.Manager) {
 	// Public routes (no authentication required)
 	e.GET("/campaigns", h.GetCampaigns)
 	e.GET("/campaign/:id", h.CampaignGET)
+	// Constituents confirm letters from their inbox, possibly without a
+	// session. Opening the link only asks; the form on the page confirms.
+	e.GET("/campaign/confirm/:token", h.ConfirmSendGET)
+	e.POST("/campaign/confirm/:token", h.ConfirmSend)
 
 	// Protected routes (require authentication)
-	protected := e.Group("/campaign")
-	protected.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+	requireSession := func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			if err := sessionManager.ValidateSession(c); err != nil {
 				return c.Redirect(http.StatusSeeOther, "/user/login")
 			}
 			return next(c)
 		}
-	})
+	}
+	protected := e.Group("/campaign")
+	protected.Use(requireSession)
 
 	// Protected campaign routes
 	protected.GET("/new", h.CreateCampaignForm)
+	protected.GET("/starters", h.StartersGET)
 	protected.POST("", h.CreateCampaign)
+	protected.POST("/:id/duplicate", h.DuplicateCampaign)
 	protected.GET("/:id/edit", h.EditCampaignForm)
 	protected.PUT("/:id", h.EditCampaign)
 	protected.DELETE("/:id", h.DeleteCampaign)
+	protected.POST("/:id/status", h.UpdateCampaignStatus)
+	protected.GET("/:id/members", h.MembersGET)
+	protected.POST("/:id/members", h.InviteMember)
+	protected.DELETE("/:id/members/:memberID", h.RemoveMember)
+	protected.GET("/invite/:token", h.AcceptInvite)
 	protected.POST("/:id/compose", h.ComposeEmail)
 	protected.POST("/:id/send", h.SendCampaign)
+	protected.GET("/:id/sends.csv", h.ExportSends)
+
+	// Organization dashboard; membership management lives in the organization package
+	e.GET("/organizations/:id", h.OrganizationDashboard, requireSession)
 
 	// Debug logging
 	for _, route := range e.Routes() {
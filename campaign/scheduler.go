@@ -0,0 +1,100 @@
+package campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+)
+
+// SchedulerParams holds the dependencies for the campaign scheduler
+type SchedulerParams struct {
+	fx.In
+
+	Service ServiceInterface
+	Config  *config.Config
+	Logger  logger.Interface
+}
+
+// Scheduler periodically moves campaigns between states according to their
+// start and end times, and expires letters that were never confirmed
+type Scheduler struct {
+	service  ServiceInterface
+	interval time.Duration
+	logger   logger.Interface
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewScheduler creates a new campaign scheduler
+func NewScheduler(params SchedulerParams) *Scheduler {
+	return &Scheduler{
+		service:  params.Service,
+		interval: params.Config.Campaign.SchedulerInterval,
+		logger:   params.Logger,
+	}
+}
+
+// Start runs the schedule once and then on every interval until stopped
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	s.logger.Debug("Starting campaign scheduler", "interval", s.interval)
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.run(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				s.run(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the scheduler to stop and waits for a running pass to finish
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	now := time.Now()
+	if err := s.service.ApplySchedule(ctx, now); err != nil {
+		s.logger.Error("Campaign scheduler run failed", err)
+	}
+	if err := s.service.ExpireUnconfirmedSends(ctx, now); err != nil {
+		s.logger.Error("Expiring unconfirmed letters failed", err)
+	}
+}
+
+func registerSchedulerHooks(lc fx.Lifecycle, scheduler *Scheduler) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			// The start context expires once startup completes, so the scheduler gets its own
+			scheduler.Start(context.Background())
+			return nil
+		},
+		OnStop: scheduler.Stop,
+	})
+}
@@ -0,0 +1,160 @@
+package campaign
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// matchExpr scores a campaign against a search query using the
+// ft_campaigns_search FULLTEXT index
+const matchExpr = "MATCH(name, description, template) AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+// SnippetLength is the approximate number of characters in a search snippet
+const SnippetLength = 160
+
+// minTermLength skips terms too short to be worth highlighting
+const minTermLength = 2
+
+// plainTextPolicy strips every tag from a letter, leaving its text
+//
+//nolint:gochecknoglobals
+var plainTextPolicy = bluemonday.StrictPolicy().AddSpaceWhenStrippingTag(true)
+
+// SearchTerms splits a search query into its distinct lower-case words
+func SearchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(words))
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if utf8.RuneCountInString(word) < minTermLength || seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// termsPattern matches any of the terms, case-insensitively. Longer terms
+// are tried first so "park" never shadows "parks".
+func termsPattern(terms []string) *regexp.Regexp {
+	if len(terms) == 0 {
+		return nil
+	}
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	sort.SliceStable(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	return regexp.MustCompile("(?i)" + strings.Join(quoted, "|"))
+}
+
+// Highlight returns an HTML-escaped excerpt of about length characters
+// around the first of the query's terms in text, with every term wrapped in
+// <mark>. It returns "" if none of them appear.
+func Highlight(text, query string, length int) string {
+	return highlight(text, termsPattern(SearchTerms(query)), length)
+}
+
+// highlight does the work of Highlight with a precompiled terms pattern
+func highlight(text string, pattern *regexp.Regexp, length int) string {
+	if pattern == nil {
+		return ""
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	first := pattern.FindStringIndex(text)
+	if first == nil {
+		return ""
+	}
+
+	start, end := excerptBounds(text, first[0], length)
+	excerpt := text[start:end]
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("&hellip;")
+	}
+	last := 0
+	for _, m := range pattern.FindAllStringIndex(excerpt, -1) {
+		b.WriteString(html.EscapeString(excerpt[last:m[0]]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(excerpt[m[0]:m[1]]))
+		b.WriteString("</mark>")
+		last = m[1]
+	}
+	b.WriteString(html.EscapeString(excerpt[last:]))
+	if end < len(text) {
+		b.WriteString("&hellip;")
+	}
+	return b.String()
+}
+
+// excerptBounds picks a window of about length bytes that starts a little
+// before the match, moved to word boundaries
+func excerptBounds(text string, match, length int) (int, int) {
+	start := match - length/4
+	if start <= 0 {
+		start = 0
+	} else if i := strings.LastIndexByte(text[:start], ' '); i >= 0 {
+		start = i + 1
+	} else {
+		start = 0
+	}
+
+	end := start + length
+	if end >= len(text) {
+		return start, len(text)
+	}
+	if i := strings.LastIndexByte(text[match:end], ' '); i > 0 {
+		end = match + i
+	} else {
+		for end < len(text) && !utf8.RuneStart(text[end]) {
+			end++
+		}
+	}
+	return start, end
+}
+
+// searchSnippet returns the highlighted excerpt shown for a campaign in
+// search results. The description is preferred, then the text of the
+// letter, then the name. When MySQL matched on something the terms don't
+// show, such as a plural, the start of the description is used.
+func searchSnippet(campaign *Campaign, pattern *regexp.Regexp) string {
+	letter := html.UnescapeString(plainTextPolicy.Sanitize(campaign.Template))
+	for _, text := range []string{campaign.Description, letter, campaign.Name} {
+		if snippet := highlight(text, pattern, SnippetLength); snippet != "" {
+			return snippet
+		}
+	}
+
+	text := strings.Join(strings.Fields(campaign.Description), " ")
+	if text == "" {
+		return ""
+	}
+	_, end := excerptBounds(text, 0, SnippetLength)
+	snippet := html.EscapeString(text[:end])
+	if end < len(text) {
+		snippet += "&hellip;"
+	}
+	return snippet
+}
+
+// searchSnippets builds the snippet for every campaign on a search page
+func searchSnippets(campaigns []Campaign, query string) map[uuid.UUID]string {
+	pattern := termsPattern(SearchTerms(query))
+	snippets := make(map[uuid.UUID]string, len(campaigns))
+	for i := range campaigns {
+		snippets[campaigns[i].ID] = searchSnippet(&campaigns[i], pattern)
+	}
+	return snippets
+}
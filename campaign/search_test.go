@@ -0,0 +1,66 @@
+package campaign_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"bike", "lanes", "école"}, campaign.SearchTerms(`Bike "lanes" a bike+École`))
+	assert.Empty(t, campaign.SearchTerms(" - * "))
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		query  string
+		length int
+		want   string
+	}{
+		{
+			name:   "marks every term, ignoring case",
+			text:   "Parks and more PARKS for everyone",
+			query:  "parks",
+			length: 100,
+			want:   "<mark>Parks</mark> and more <mark>PARKS</mark> for everyone",
+		},
+		{
+			name:   "prefers the longest term",
+			text:   "Protect our parkland",
+			query:  "park parkland",
+			length: 100,
+			want:   "Protect our <mark>parkland</mark>",
+		},
+		{
+			name:   "escapes the surrounding text",
+			text:   "Write to <b>your</b> MP about transit",
+			query:  "transit",
+			length: 100,
+			want:   "Write to &lt;b&gt;your&lt;/b&gt; MP about <mark>transit</mark>",
+		},
+		{
+			name:   "trims long text to a window around the match",
+			text:   strings.Repeat("lorem ipsum ", 20) + "clean water " + strings.Repeat("dolor sit ", 20),
+			query:  "water",
+			length: 40,
+			want:   "&hellip;ipsum clean <mark>water</mark> dolor sit dolor sit&hellip;",
+		},
+		{
+			name:   "no match",
+			text:   "Nothing to see here",
+			query:  "transit",
+			length: 100,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, campaign.Highlight(tt.text, tt.query, tt.length))
+		})
+	}
+}
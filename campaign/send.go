@@ -0,0 +1,83 @@
+package campaign
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"gorm.io/gorm"
+)
+
+// SendStatus represents the delivery state of a campaign email
+type SendStatus string
+
+const (
+	SendStatusPending SendStatus = "pending"
+	SendStatusSent    SendStatus = "sent"
+	SendStatusFailed  SendStatus = "failed"
+	// SendStatusUnconfirmed letters wait for the constituent to confirm their
+	// email address before they are queued
+	SendStatusUnconfirmed SendStatus = "unconfirmed"
+	// SendStatusExpired letters were never confirmed and won't be sent
+	SendStatusExpired SendStatus = "expired"
+)
+
+// Send records a single campaign email sent to a representative. The
+// constituent's name and email are only kept when they consented to share
+// them with the campaign's organizers.
+type Send struct {
+	shared.BaseModel
+	CampaignID             uuid.UUID  `gorm:"type:char(36);not null;index" json:"campaign_id"`
+	RepresentativeName     string     `gorm:"type:varchar(255);not null" json:"representative_name"`
+	RepresentativeEmail    string     `gorm:"type:varchar(255);not null" json:"representative_email"`
+	RepresentativeOffice   string     `gorm:"type:varchar(100)" json:"representative_office"`
+	RepresentativeDistrict string     `gorm:"type:varchar(255)" json:"representative_district"`
+	PostalCode             string     `gorm:"type:varchar(10);not null" json:"postal_code"`
+	ContactConsent         bool       `gorm:"not null;default:false" json:"contact_consent"`
+	ConstituentFirstName   string     `gorm:"type:varchar(100)" json:"constituent_first_name,omitempty"`
+	ConstituentLastName    string     `gorm:"type:varchar(100)" json:"constituent_last_name,omitempty"`
+	ConstituentEmail       string     `gorm:"type:varchar(255)" json:"constituent_email,omitempty"`
+	ContentHash            string     `gorm:"type:char(64);not null" json:"content_hash"`
+	ProviderMessageID      string     `gorm:"type:varchar(255)" json:"provider_message_id"`
+	Status                 SendStatus `gorm:"type:varchar(20);not null;default:pending" json:"status"`
+	Error                  string     `gorm:"type:text" json:"error,omitempty"`
+	SentAt                 *time.Time `json:"sent_at,omitempty"`
+	// Subject, Body and ReplyTo hold an unconfirmed letter until it is queued
+	Subject string `gorm:"type:varchar(255)" json:"-"`
+	Body    string `gorm:"type:text" json:"-"`
+	ReplyTo string `gorm:"type:varchar(255)" json:"-"`
+	// DraftTokenID is the ID of the draft token the letter was sent with,
+	// which can't be used again
+	DraftTokenID *string `gorm:"type:char(36);uniqueIndex" json:"-"`
+	// ConfirmationToken is emailed to the constituent of an unconfirmed
+	// letter. The letters of one submission share it.
+	ConfirmationToken     *string    `gorm:"type:varchar(64);index" json:"-"`
+	ConfirmationExpiresAt *time.Time `json:"-"`
+	ConfirmedAt           *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// TableName overrides the default table name
+func (Send) TableName() string {
+	return "campaign_sends"
+}
+
+// BeforeCreate assigns an ID so the record can be referenced after insert
+func (s *Send) BeforeCreate(_ *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// ContentHash returns the hex-encoded SHA-256 hash of the email content
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsUnconfirmed reports whether the letter still waits for the constituent
+func (s *Send) IsUnconfirmed() bool {
+	return s.Status == SendStatusUnconfirmed
+}
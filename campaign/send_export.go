@@ -0,0 +1,81 @@
+package campaign
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sendCSVHeader names the columns of a campaign's send export
+//
+//nolint:gochecknoglobals
+var sendCSVHeader = []string{
+	"submitted_at",
+	"status",
+	"sent_at",
+	"riding",
+	"representative",
+	"representative_office",
+	"contact_consent",
+	"first_name",
+	"last_name",
+	"email",
+	"postal_code",
+}
+
+// StreamSends calls fn with each of a campaign's sends, oldest first,
+// without holding them all in memory
+func (s *Service) StreamSends(ctx context.Context, campaignID uuid.UUID, fn func(*Send) error) error {
+	if err := s.sendRepo.StreamByCampaign(ctx, campaignID, fn); err != nil {
+		s.Logger.Error("Failed to stream campaign sends", err, "campaignID", campaignID)
+		return err
+	}
+	return nil
+}
+
+// csvRecord formats the send as a row of the export. The constituent's
+// details, postal code included, are left blank unless they consented to
+// share them.
+func (s *Send) csvRecord() []string {
+	sentAt := ""
+	if s.SentAt != nil {
+		sentAt = s.SentAt.UTC().Format(time.RFC3339)
+	}
+
+	consent := "no"
+	firstName, lastName, email, postalCode := "", "", "", ""
+	if s.ContactConsent {
+		consent = "yes"
+		firstName, lastName, email, postalCode =
+			s.ConstituentFirstName, s.ConstituentLastName, s.ConstituentEmail, s.PostalCode
+	}
+
+	record := []string{
+		s.CreatedAt.UTC().Format(time.RFC3339),
+		string(s.Status),
+		sentAt,
+		s.RepresentativeDistrict,
+		s.RepresentativeName,
+		s.RepresentativeOffice,
+		consent,
+		firstName,
+		lastName,
+		email,
+		postalCode,
+	}
+	for i, value := range record {
+		record[i] = csvSafe(value)
+	}
+	return record
+}
+
+// csvSafe stops spreadsheet applications from evaluating a value as a
+// formula, since names and emails come from the public send form
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,69 @@
+package campaign
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// sendExportFlushEvery is how many rows are buffered before they are
+// flushed to the client
+const sendExportFlushEvery = 100
+
+// ExportSends handles GET requests for a CSV of a campaign's sends. Only
+// those who manage the campaign may download it. Rows are written as they
+// are read from the database.
+func (h *Handler) ExportSends(c echo.Context) error {
+	h.Logger.Debug("Handling ExportSends request")
+
+	campaign, userID, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorize(c, campaign, userID, PermissionManage); err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="campaign-%s-sends-%s.csv"`, campaign.ID, time.Now().Format("20060102")))
+	res.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(res)
+	if err := w.Write(sendCSVHeader); err != nil {
+		return nil
+	}
+
+	rows := 0
+	err = h.service.StreamSends(c.Request().Context(), campaign.ID, func(send *Send) error {
+		if err := w.Write(send.csvRecord()); err != nil {
+			return err
+		}
+		rows++
+		if rows%sendExportFlushEvery == 0 {
+			w.Flush()
+			res.Flush()
+			return w.Error()
+		}
+		return nil
+	})
+	w.Flush()
+
+	// The status line has been sent, so a failure can only cut the file short
+	if err == nil {
+		err = w.Error()
+	}
+	if err != nil {
+		h.Logger.Error("Campaign send export interrupted", err, "campaignID", campaign.ID, "rows", rows)
+		return nil
+	}
+
+	h.Logger.Info("Campaign sends exported", "campaignID", campaign.ID, "rows", rows)
+	return nil
+}
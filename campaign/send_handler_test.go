@@ -0,0 +1,159 @@
+package campaign_test
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/sessions"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	sessionmocks "github.com/jonesrussell/mp-emailer/mocks/session"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+// signedInHandler returns a handler whose session belongs to userID
+func (s *HandlerTestSuite) signedInHandler(userID uuid.UUID) *campaign.Handler {
+	sess := sessions.NewSession(nil, s.Config.Auth.SessionName)
+	manager := sessionmocks.NewMockManager(s.T())
+	manager.EXPECT().GetSession(mock.Anything, mock.Anything).Return(sess, nil).Maybe()
+	manager.EXPECT().IsAuthenticated(mock.Anything).Return(true).Maybe()
+	manager.EXPECT().GetSessionValue(sess, "user_id").Return(userID.String(), nil).Maybe()
+	s.Logger.EXPECT().Debug("Successfully retrieved user ID", "user_id", userID.String()).Maybe()
+
+	result, err := campaign.NewHandler(campaign.HandlerParams{
+		BaseHandlerParams: shared.BaseHandlerParams{
+			Logger:           s.Logger,
+			ErrorHandler:     s.ErrorHandler,
+			TemplateRenderer: s.TemplateRenderer,
+			Config:           s.Config,
+			SessionManager:   manager,
+		},
+		Service:                     s.CampaignService,
+		RepresentativeLookupService: s.RepresentativeLookupService,
+	})
+	s.Require().NoError(err)
+	return result.Handler
+}
+
+func (s *HandlerTestSuite) TestExportSends() {
+	ownerID := uuid.New()
+	cmpn := &campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}, Name: "Save the Library", OwnerID: ownerID}
+	submitted := time.Date(2024, 12, 16, 15, 4, 5, 0, time.UTC)
+
+	s.Run("streams the sends as CSV", func() {
+		h := s.signedInHandler(ownerID)
+		s.Logger.EXPECT().Debug("Handling ExportSends request").Once()
+		s.Logger.EXPECT().Info("Campaign sends exported", "campaignID", cmpn.ID, "rows", 2).Once()
+		s.CampaignService.EXPECT().FetchCampaign(mock.Anything, campaign.GetCampaignParams{ID: cmpn.ID}).
+			Return(cmpn, nil).Once()
+		s.CampaignService.EXPECT().CheckPermission(mock.Anything, cmpn, ownerID, campaign.PermissionManage).
+			Return(nil).Once()
+		s.CampaignService.EXPECT().StreamSends(mock.Anything, cmpn.ID, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ uuid.UUID, fn func(*campaign.Send) error) error {
+				for _, send := range []*campaign.Send{
+					{
+						BaseModel:              shared.BaseModel{CreatedAt: submitted},
+						RepresentativeName:     "Jane Doe",
+						RepresentativeOffice:   "MP",
+						RepresentativeDistrict: "Ottawa Centre",
+						PostalCode:             "K1A0A6",
+						ContactConsent:         true,
+						ConstituentFirstName:   "=HYPERLINK(\"x\")",
+						ConstituentLastName:    "Smith",
+						ConstituentEmail:       "pat@example.com",
+						Status:                 campaign.SendStatusSent,
+						SentAt:                 &submitted,
+					},
+					{
+						BaseModel:              shared.BaseModel{CreatedAt: submitted},
+						RepresentativeName:     "John Roe",
+						RepresentativeDistrict: "Ottawa Centre",
+						PostalCode:             "K1A0A7",
+						Status:                 campaign.SendStatusPending,
+					},
+				} {
+					if err := fn(send); err != nil {
+						return err
+					}
+				}
+				return nil
+			}).Once()
+
+		c := s.NewContext(http.MethodGet, "/campaign/"+cmpn.ID.String()+"/sends.csv", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(cmpn.ID.String())
+
+		s.NoError(h.ExportSends(c))
+
+		s.Equal(http.StatusOK, s.Recorder.Code)
+		s.Equal("text/csv; charset=utf-8", s.Recorder.Header().Get(echo.HeaderContentType))
+		records, err := csv.NewReader(s.Recorder.Body).ReadAll()
+		s.Require().NoError(err)
+		s.Require().Len(records, 3)
+		s.Equal([]string{
+			"submitted_at", "status", "sent_at", "riding", "representative", "representative_office",
+			"contact_consent", "first_name", "last_name", "email", "postal_code",
+		}, records[0])
+		s.Equal([]string{
+			"2024-12-16T15:04:05Z", "sent", "2024-12-16T15:04:05Z", "Ottawa Centre", "Jane Doe", "MP",
+			"yes", "'=HYPERLINK(\"x\")", "Smith", "pat@example.com", "K1A0A6",
+		}, records[1])
+		s.Equal([]string{
+			"2024-12-16T15:04:05Z", "pending", "", "Ottawa Centre", "John Roe", "",
+			"no", "", "", "", "",
+		}, records[2])
+	})
+
+	s.Run("forbidden for collaborators", func() {
+		editorID := uuid.New()
+		h := s.signedInHandler(editorID)
+		s.Logger.EXPECT().Debug("Handling ExportSends request").Once()
+		s.CampaignService.EXPECT().FetchCampaign(mock.Anything, campaign.GetCampaignParams{ID: cmpn.ID}).
+			Return(cmpn, nil).Once()
+		s.CampaignService.EXPECT().CheckPermission(mock.Anything, cmpn, editorID, campaign.PermissionManage).
+			Return(campaign.ErrUnauthorizedAccess).Once()
+		s.ErrorHandler.EXPECT().
+			HandleHTTPError(mock.Anything, campaign.ErrUnauthorizedAccess, mock.Anything, http.StatusUnauthorized).
+			Return(echo.NewHTTPError(http.StatusUnauthorized)).Once()
+
+		c := s.NewContext(http.MethodGet, "/campaign/"+cmpn.ID.String()+"/sends.csv", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(cmpn.ID.String())
+
+		s.Error(h.ExportSends(c))
+	})
+}
+
+func (s *HandlerTestSuite) TestConfirmSendGET() {
+	campaignID := uuid.New()
+	sends := []campaign.Send{
+		{CampaignID: campaignID, RepresentativeName: "Jane Doe", Status: campaign.SendStatusUnconfirmed},
+		{CampaignID: campaignID, RepresentativeName: "John Smith", Status: campaign.SendStatusUnconfirmed},
+	}
+
+	// Opening the link only shows the letters; nothing is confirmed until the
+	// form is posted
+	s.CampaignService.EXPECT().GetConfirmation(mock.Anything, "confirm-token").Return(sends, nil).Once()
+	s.TemplateRenderer.EXPECT().
+		Render(mock.Anything, "campaign_confirm", mock.MatchedBy(func(data shared.Data) bool {
+			content, ok := data.Content.(map[string]interface{})
+			return ok &&
+				content["Token"] == "confirm-token" &&
+				content["Representatives"] == "Jane Doe and John Smith" &&
+				content["Letters"] == 2 &&
+				content["Pending"] == true
+		}), mock.Anything).
+		Return(nil).Once()
+
+	c := s.NewContext(http.MethodGet, "/campaign/confirm/confirm-token", nil)
+	c.SetParamNames("token")
+	c.SetParamValues("confirm-token")
+
+	s.NoError(s.handler.ConfirmSendGET(c))
+	s.Equal(http.StatusOK, s.Recorder.Code)
+	s.CampaignService.AssertNotCalled(s.T(), "ConfirmSend", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,92 @@
+package campaign
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/email"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+)
+
+// sendReferencePrefix tags queued messages that belong to a campaign send
+const sendReferencePrefix = "campaign_send:"
+
+func sendReference(id uuid.UUID) string {
+	return sendReferencePrefix + id.String()
+}
+
+// parseSendReference extracts the send ID from a queued message reference
+func parseSendReference(ref string) (uuid.UUID, bool) {
+	raw, ok := strings.CutPrefix(ref, sendReferencePrefix)
+	if !ok {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// SendDeliveryListenerParams for dependency injection
+type SendDeliveryListenerParams struct {
+	fx.In
+	SendRepo SendRepositoryInterface
+	Logger   logger.Interface
+}
+
+// SendDeliveryListener updates the send log as queued campaign emails are delivered
+type SendDeliveryListener struct {
+	sendRepo SendRepositoryInterface
+	logger   logger.Interface
+}
+
+// Ensure SendDeliveryListener implements email.DeliveryListener
+var _ email.DeliveryListener = (*SendDeliveryListener)(nil)
+
+// NewSendDeliveryListener creates a new SendDeliveryListener
+func NewSendDeliveryListener(params SendDeliveryListenerParams) *SendDeliveryListener {
+	return &SendDeliveryListener{
+		sendRepo: params.SendRepo,
+		logger:   params.Logger,
+	}
+}
+
+// MessageSent marks the matching send as sent
+func (l *SendDeliveryListener) MessageSent(ctx context.Context, msg *email.QueuedMessage) {
+	l.update(ctx, msg, func(send *Send) {
+		send.Status = SendStatusSent
+		send.ProviderMessageID = msg.ProviderMessageID
+		send.SentAt = msg.SentAt
+		send.Error = ""
+	})
+}
+
+// MessageDead marks the matching send as failed
+func (l *SendDeliveryListener) MessageDead(ctx context.Context, msg *email.QueuedMessage) {
+	l.update(ctx, msg, func(send *Send) {
+		send.Status = SendStatusFailed
+		send.Error = msg.LastError
+	})
+}
+
+func (l *SendDeliveryListener) update(ctx context.Context, msg *email.QueuedMessage, apply func(*Send)) {
+	sendID, ok := parseSendReference(msg.Reference)
+	if !ok {
+		return
+	}
+
+	send, err := l.sendRepo.GetByID(ctx, sendID)
+	if err != nil {
+		l.logger.Error("Failed to load campaign send", err, "sendID", sendID)
+		return
+	}
+
+	apply(send)
+
+	if err := l.sendRepo.Update(ctx, send); err != nil {
+		l.logger.Error("Failed to update campaign send", err, "sendID", sendID)
+	}
+}
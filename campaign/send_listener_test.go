@@ -0,0 +1,85 @@
+package campaign_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/email"
+	mocksCampaign "github.com/jonesrussell/mp-emailer/mocks/campaign"
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendDeliveryListener(t *testing.T) {
+	sendID := uuid.New()
+	sentAt := time.Now()
+
+	tests := []struct {
+		name    string
+		deliver func(l *campaign.SendDeliveryListener, msg *email.QueuedMessage)
+		msg     *email.QueuedMessage
+		check   func(t *testing.T, send *campaign.Send)
+	}{
+		{
+			name: "sent message marks send as sent",
+			deliver: func(l *campaign.SendDeliveryListener, msg *email.QueuedMessage) {
+				l.MessageSent(context.Background(), msg)
+			},
+			msg: &email.QueuedMessage{
+				Reference:         "campaign_send:" + sendID.String(),
+				ProviderMessageID: "<id@example.com>",
+				SentAt:            &sentAt,
+			},
+			check: func(t *testing.T, send *campaign.Send) {
+				assert.Equal(t, campaign.SendStatusSent, send.Status)
+				assert.Equal(t, "<id@example.com>", send.ProviderMessageID)
+				assert.Equal(t, &sentAt, send.SentAt)
+			},
+		},
+		{
+			name: "dead message marks send as failed",
+			deliver: func(l *campaign.SendDeliveryListener, msg *email.QueuedMessage) {
+				l.MessageDead(context.Background(), msg)
+			},
+			msg: &email.QueuedMessage{
+				Reference: "campaign_send:" + sendID.String(),
+				LastError: "mailbox unavailable",
+			},
+			check: func(t *testing.T, send *campaign.Send) {
+				assert.Equal(t, campaign.SendStatusFailed, send.Status)
+				assert.Equal(t, "mailbox unavailable", send.Error)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocksCampaign.NewMockSendRepositoryInterface(t)
+			send := &campaign.Send{Status: campaign.SendStatusPending}
+			repo.EXPECT().GetByID(mock.Anything, sendID).Return(send, nil).Once()
+			repo.EXPECT().Update(mock.Anything, send).Return(nil).Once()
+
+			listener := campaign.NewSendDeliveryListener(campaign.SendDeliveryListenerParams{
+				SendRepo: repo,
+				Logger:   mocksLogger.NewMockInterface(t),
+			})
+			tt.deliver(listener, tt.msg)
+			tt.check(t, send)
+		})
+	}
+}
+
+func TestSendDeliveryListener_IgnoresOtherReferences(t *testing.T) {
+	repo := mocksCampaign.NewMockSendRepositoryInterface(t)
+	listener := campaign.NewSendDeliveryListener(campaign.SendDeliveryListenerParams{
+		SendRepo: repo,
+		Logger:   mocksLogger.NewMockInterface(t),
+	})
+
+	listener.MessageSent(context.Background(), &email.QueuedMessage{Reference: "password_reset"})
+	listener.MessageDead(context.Background(), &email.QueuedMessage{})
+}
@@ -0,0 +1,195 @@
+package campaign
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/database"
+)
+
+// SendRepositoryInterface defines the contract for campaign send persistence
+type SendRepositoryInterface interface {
+	Create(ctx context.Context, send *Send) error
+	Update(ctx context.Context, send *Send) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Send, error)
+	ListByCampaign(ctx context.Context, campaignID uuid.UUID) ([]Send, error)
+	StreamByCampaign(ctx context.Context, campaignID uuid.UUID, fn func(*Send) error) error
+	CountByCampaign(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]SendStats, error)
+	ListByConfirmationToken(ctx context.Context, token string) ([]Send, error)
+	Confirm(ctx context.Context, id uuid.UUID, confirmedAt time.Time) (bool, error)
+	ExpireUnconfirmed(ctx context.Context, now time.Time) (int64, error)
+}
+
+// SendRepository implements SendRepositoryInterface
+type SendRepository struct {
+	db database.Database
+}
+
+// NewSendRepository creates a new instance of SendRepository
+func NewSendRepository(params RepositoryParams) SendRepositoryInterface {
+	return &SendRepository{db: params.DB}
+}
+
+// Create records a new send in the database. A send whose draft token was
+// already used fails with ErrDraftUsed.
+func (r *SendRepository) Create(ctx context.Context, send *Send) error {
+	if err := r.db.Create(ctx, send); err != nil {
+		if send.DraftTokenID != nil && database.IsDuplicateKey(err) {
+			return fmt.Errorf("%w: %w", ErrDraftUsed, err)
+		}
+		return fmt.Errorf("error creating campaign send: %w", err)
+	}
+	return nil
+}
+
+// Update saves changes to an existing send
+func (r *SendRepository) Update(ctx context.Context, send *Send) error {
+	if err := r.db.Update(ctx, send); err != nil {
+		return fmt.Errorf("error updating campaign send: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a send by its ID
+func (r *SendRepository) GetByID(ctx context.Context, id uuid.UUID) (*Send, error) {
+	var send Send
+	if err := r.db.FindOne(ctx, &send, "id = ?", id); err != nil {
+		return nil, fmt.Errorf("error retrieving campaign send: %w", err)
+	}
+	return &send, nil
+}
+
+// ListByCampaign retrieves all sends for a campaign
+func (r *SendRepository) ListByCampaign(ctx context.Context, campaignID uuid.UUID) ([]Send, error) {
+	var sends []Send
+	err := r.db.FindAll(ctx, &sends, "campaign_id = ?", campaignID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to list campaign sends: %w", err)
+	}
+	if len(sends) == 0 {
+		return []Send{}, nil
+	}
+	return sends, nil
+}
+
+// StreamByCampaign calls fn with each of a campaign's sends, oldest first,
+// reading them from a cursor rather than loading them all. An error from fn
+// stops the iteration and is returned.
+func (r *SendRepository) StreamByCampaign(ctx context.Context, campaignID uuid.UUID, fn func(*Send) error) error {
+	db := r.db.DB().WithContext(ctx)
+	rows, err := db.Model(&Send{}).
+		Where("campaign_id = ?", campaignID).
+		Order("created_at, id").
+		Rows()
+	if err != nil {
+		return fmt.Errorf("failed to read campaign sends: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var send Send
+		if err := db.ScanRows(rows, &send); err != nil {
+			return fmt.Errorf("failed to read campaign send: %w", err)
+		}
+		if err := fn(&send); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read campaign sends: %w", err)
+	}
+	return nil
+}
+
+// CountByCampaign returns the send counts of each campaign. Campaigns without
+// sends are absent from the result, and letters that were never confirmed
+// aren't counted.
+func (r *SendRepository) CountByCampaign(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]SendStats, error) {
+	stats := make(map[uuid.UUID]SendStats, len(campaignIDs))
+	if len(campaignIDs) == 0 {
+		return stats, nil
+	}
+
+	var rows []struct {
+		CampaignID uuid.UUID
+		Status     SendStatus
+		Count      int64
+	}
+	err := r.db.DB().WithContext(ctx).
+		Model(&Send{}).
+		Select("campaign_id, status, COUNT(*) AS count").
+		Where("campaign_id IN ?", campaignIDs).
+		Group("campaign_id, status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count campaign sends: %w", err)
+	}
+
+	for _, row := range rows {
+		s := stats[row.CampaignID]
+		switch row.Status {
+		case SendStatusPending:
+			s.Pending += row.Count
+		case SendStatusSent:
+			s.Sent += row.Count
+		case SendStatusFailed:
+			s.Failed += row.Count
+		}
+		stats[row.CampaignID] = s
+	}
+	return stats, nil
+}
+
+// ListByConfirmationToken retrieves the sends a confirmation link refers to
+func (r *SendRepository) ListByConfirmationToken(ctx context.Context, token string) ([]Send, error) {
+	var sends []Send
+	if err := r.db.FindAll(ctx, &sends, "confirmation_token = ?", token); err != nil {
+		return nil, fmt.Errorf("error retrieving campaign sends: %w", err)
+	}
+	if len(sends) == 0 {
+		return nil, ErrConfirmationNotFound
+	}
+	return sends, nil
+}
+
+// Confirm moves an unconfirmed send to pending and discards the letter held
+// with it. It reports false when the send was no longer unconfirmed.
+func (r *SendRepository) Confirm(ctx context.Context, id uuid.UUID, confirmedAt time.Time) (bool, error) {
+	result := r.db.DB().WithContext(ctx).
+		Model(&Send{}).
+		Where("id = ? AND status = ?", id, SendStatusUnconfirmed).
+		Updates(map[string]interface{}{
+			"status":       SendStatusPending,
+			"confirmed_at": confirmedAt,
+			"subject":      "",
+			"body":         "",
+			"reply_to":     "",
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("error confirming campaign send: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ExpireUnconfirmed marks the unconfirmed letters whose confirmation window
+// has closed as expired and discards their content. It returns how many
+// letters expired.
+func (r *SendRepository) ExpireUnconfirmed(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.DB().WithContext(ctx).
+		Model(&Send{}).
+		Where("status = ? AND confirmation_expires_at <= ?", SendStatusUnconfirmed, now).
+		Updates(map[string]interface{}{
+			"status":   SendStatusExpired,
+			"subject":  "",
+			"body":     "",
+			"reply_to": "",
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to expire unconfirmed sends: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
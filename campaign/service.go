@@ -5,38 +5,106 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/email"
 	"github.com/jonesrussell/mp-emailer/logger"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/jonesrussell/mp-emailer/templating"
+	"github.com/jonesrussell/mp-emailer/user"
+	"go.uber.org/fx"
 )
 
+// ServiceParams for dependency injection
+type ServiceParams struct {
+	fx.In
+	Repo        RepositoryInterface
+	SendRepo    SendRepositoryInterface
+	MemberRepo  MemberRepositoryInterface
+	StarterRepo StarterTemplateRepositoryInterface
+	Orgs        organization.ServiceInterface
+	Users       user.RepositoryInterface
+	EmailQueue  email.Queue
+	Config      *config.Config
+	Validate    *validator.Validate
+	Logger      logger.Interface
+}
+
 // NewService creates a new campaign service
-func NewService(repo RepositoryInterface, validate *validator.Validate, log logger.Interface) ServiceInterface {
+func NewService(params ServiceParams) ServiceInterface {
 	return &Service{
-		repo:     repo,
-		validate: validate,
-		Logger:   log,
+		repo:        params.Repo,
+		sendRepo:    params.SendRepo,
+		memberRepo:  params.MemberRepo,
+		starterRepo: params.StarterRepo,
+		orgs:        params.Orgs,
+		users:       params.Users,
+		emailQueue:  params.EmailQueue,
+		baseURL:     params.Config.App.BaseURL,
+		validate:    params.Validate,
+		letters:     NewLetterEngine(),
+		drafts:      newDraftSigner(params.Config.Auth.JWTSecret, params.Config.Campaign.DraftTTL),
+		confirmTTL:  params.Config.Campaign.ConfirmationTTL,
+		Logger:      params.Logger,
 	}
 }
 
 // ServiceInterface defines the methods of the campaign service
 type ServiceInterface interface {
 	CreateCampaign(ctx context.Context, dto *CreateCampaignDTO) (*Campaign, error)
+	DuplicateCampaign(ctx context.Context, dto *DuplicateCampaignDTO) (*Campaign, error)
+	ListStarterTemplates(ctx context.Context) ([]StarterTemplate, error)
+	GetStarterTemplate(ctx context.Context, id uuid.UUID) (*StarterTemplate, error)
+	CreateFromStarter(ctx context.Context, dto *CreateFromStarterDTO) (*Campaign, error)
+	ImportCampaigns(ctx context.Context, dto *ImportCampaignsDTO) (*ImportResult, error)
 	UpdateCampaign(ctx context.Context, dto *UpdateCampaignDTO) error
 	GetCampaignByID(ctx context.Context, params GetCampaignParams) (*Campaign, error)
 	GetCampaigns(ctx context.Context) ([]Campaign, error)
+	ListCampaigns(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error)
+	GetActiveCampaigns(ctx context.Context) ([]Campaign, error)
+	GetCampaignsByOwner(ctx context.Context, ownerID uuid.UUID) ([]Campaign, error)
+	GetCampaignsByOrganization(ctx context.Context, orgID uuid.UUID) ([]Campaign, error)
+	GetOrganizationDashboard(ctx context.Context, orgID uuid.UUID) (*OrganizationDashboard, error)
+	ChangeStatus(ctx context.Context, dto *ChangeStatusDTO) (*Campaign, error)
+	ApplySchedule(ctx context.Context, now time.Time) error
+	ExpireUnconfirmedSends(ctx context.Context, now time.Time) error
+	CheckPermission(ctx context.Context, campaign *Campaign, userID uuid.UUID, permission Permission) error
+	GetSharedCampaigns(ctx context.Context, userID uuid.UUID) ([]Campaign, error)
+	ListMembers(ctx context.Context, campaignID uuid.UUID) ([]Member, error)
+	InviteMember(ctx context.Context, dto *InviteMemberDTO) (*Member, error)
+	AcceptInvite(ctx context.Context, dto *AcceptInviteDTO) (*Member, error)
+	RemoveMember(ctx context.Context, dto RemoveMemberDTO) error
 	DeleteCampaign(ctx context.Context, params DeleteCampaignDTO) error
 	FetchCampaign(ctx context.Context, params GetCampaignParams) (*Campaign, error)
-	ComposeEmail(ctx context.Context, params ComposeEmailParams) (string, error)
+	ComposeEmail(ctx context.Context, params ComposeEmailParams) (*ComposedEmail, error)
+	SendCampaignEmail(ctx context.Context, dto *SendCampaignEmailDTO) (*Send, error)
+	SendCampaignEmails(ctx context.Context, dtos []*SendCampaignEmailDTO) []SendResult
+	GetConfirmation(ctx context.Context, token string) ([]Send, error)
+	ConfirmSend(ctx context.Context, token string) ([]Send, error)
+	StreamSends(ctx context.Context, campaignID uuid.UUID, fn func(*Send) error) error
 }
 
 // Service implements the campaign service
 type Service struct {
-	repo     RepositoryInterface
-	validate *validator.Validate
-	Logger   logger.Interface
+	repo        RepositoryInterface
+	sendRepo    SendRepositoryInterface
+	memberRepo  MemberRepositoryInterface
+	starterRepo StarterTemplateRepositoryInterface
+	orgs        organization.ServiceInterface
+	users       user.RepositoryInterface
+	emailQueue  email.Queue
+	baseURL     string
+	validate    *validator.Validate
+	letters     *templating.Engine
+	drafts      *draftSigner
+	confirmTTL  time.Duration
+	Logger      logger.Interface
 }
 
 // Ensure Service implements ServiceInterface
@@ -48,9 +116,8 @@ func (s *Service) CreateCampaign(ctx context.Context, dto *CreateCampaignDTO) (*
 		return nil, fmt.Errorf("campaign data is required")
 	}
 
-	if err := s.validate.Struct(dto); err != nil {
-		s.Logger.Debug("Invalid campaign data", "error", err)
-		return nil, fmt.Errorf("invalid input: %w", err)
+	if err := s.prepareCreate(ctx, dto); err != nil {
+		return nil, err
 	}
 
 	campaign, err := s.repo.Create(ctx, dto)
@@ -63,15 +130,138 @@ func (s *Service) CreateCampaign(ctx context.Context, dto *CreateCampaignDTO) (*
 	return campaign, nil
 }
 
+// prepareCreate validates a new campaign, checks the creator may add it to
+// its organization and records the template's tokens
+func (s *Service) prepareCreate(ctx context.Context, dto *CreateCampaignDTO) error {
+	if err := s.validate.Struct(dto); err != nil {
+		s.Logger.Debug("Invalid campaign data", "error", err)
+		return fmt.Errorf("invalid input: %w", err)
+	}
+
+	if dto.Status == "" {
+		dto.Status = StatusDraft
+	}
+	if err := validateSchedule(dto.Status, dto.StartsAt, dto.EndsAt); err != nil {
+		s.Logger.Debug("Invalid campaign schedule", "error", err)
+		return err
+	}
+
+	if dto.OrganizationID != nil {
+		if err := s.checkOrganizationPermission(ctx, *dto.OrganizationID, dto.OwnerID, PermissionEdit); err != nil {
+			s.Logger.Debug("Creator may not add campaigns to organization",
+				"organizationID", dto.OrganizationID, "ownerID", dto.OwnerID)
+			return err
+		}
+	}
+
+	if err := ValidateCustomFields(dto.CustomFields); err != nil {
+		s.Logger.Debug("Invalid custom fields", "error", err)
+		return err
+	}
+
+	if dto.Language == "" {
+		dto.Language = DefaultLanguage
+	}
+	dto.Template = sanitizeLetters(dto.Template, dto.Translations)
+	tokens, err := s.templateTokens(dto.Language, dto.Template, dto.Translations, dto.CustomFields)
+	if err != nil {
+		s.Logger.Debug("Invalid campaign template", "error", err)
+		return err
+	}
+	dto.Tokens = tokens
+	return nil
+}
+
 // UpdateCampaign updates an existing campaign
 func (s *Service) UpdateCampaign(ctx context.Context, dto *UpdateCampaignDTO) error {
 	err := s.validate.Struct(dto)
 	if err != nil {
 		return fmt.Errorf("invalid input: %w", err)
 	}
+
+	existing, err := s.repo.GetByID(ctx, GetCampaignDTO{ID: dto.ID})
+	if err != nil {
+		return err
+	}
+	if err := validateSchedule(existing.Status, dto.StartsAt, dto.EndsAt); err != nil {
+		s.Logger.Debug("Invalid campaign schedule", "error", err)
+		return err
+	}
+
+	if err := ValidateCustomFields(dto.CustomFields); err != nil {
+		s.Logger.Debug("Invalid custom fields", "error", err)
+		return err
+	}
+
+	if dto.Language == "" {
+		dto.Language = DefaultLanguage
+	}
+	dto.Template = sanitizeLetters(dto.Template, dto.Translations)
+	tokens, err := s.templateTokens(dto.Language, dto.Template, dto.Translations, dto.CustomFields)
+	if err != nil {
+		s.Logger.Debug("Invalid campaign template", "error", err)
+		return err
+	}
+	dto.Tokens = tokens
+
 	return s.repo.Update(ctx, dto)
 }
 
+// validateSchedule checks that a campaign's start and end times make sense
+// for its status
+func validateSchedule(status Status, startsAt, endsAt *time.Time) error {
+	if status == StatusScheduled && startsAt == nil {
+		return fmt.Errorf("%w: scheduled campaigns need a start time", ErrInvalidSchedule)
+	}
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return fmt.Errorf("%w: end time must be after start time", ErrInvalidSchedule)
+	}
+	return nil
+}
+
+// sanitizeLetters strips markup outside the letter allowlist from a
+// campaign's template and, in place, from its translations
+func sanitizeLetters(template string, translations []Translation) string {
+	for i := range translations {
+		translations[i].Template = templating.SanitizeTemplate(translations[i].Template)
+	}
+	return templating.SanitizeTemplate(template)
+}
+
+// templateTokens parses a campaign's letter template and its translations
+// and returns the variables any of them use. The templates may reference the
+// campaign's custom fields.
+func (s *Service) templateTokens(
+	language, src string,
+	translations []Translation,
+	fields []CustomField,
+) ([]string, error) {
+	if err := validateTranslations(language, translations); err != nil {
+		return nil, err
+	}
+
+	engine := s.letters.WithVariables(customFieldVariables(fields)...)
+	tmpl, err := engine.Parse("campaign", src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	tokens := tmpl.Variables()
+
+	for _, t := range translations {
+		translated, err := engine.Parse("campaign."+t.Language, t.Template)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidTemplate, LanguageName(t.Language), err)
+		}
+		for _, token := range translated.Variables() {
+			if !slices.Contains(tokens, token) {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	sort.Strings(tokens)
+	return tokens, nil
+}
+
 // GetCampaignByID retrieves a campaign by ID
 func (s *Service) GetCampaignByID(ctx context.Context, params GetCampaignParams) (*Campaign, error) {
 	campaign, err := s.repo.GetByID(ctx, GetCampaignDTO{ID: params.ID})
@@ -84,7 +274,22 @@ func (s *Service) GetCampaignByID(ctx context.Context, params GetCampaignParams)
 		return nil, fmt.Errorf("failed to get campaign: %w", err)
 	}
 
-	return campaign, nil
+	return s.withTokens(campaign), nil
+}
+
+// withTokens fills in the tokens of a campaign saved before they were
+// recorded, so RequiredFields still knows which fields its letter uses
+func (s *Service) withTokens(campaign *Campaign) *Campaign {
+	if campaign.Tokens != nil {
+		return campaign
+	}
+	tokens, err := s.templateTokens(campaign.Language, campaign.Template, campaign.Translations, campaign.CustomFields)
+	if err != nil {
+		s.Logger.Warn("Failed to read campaign tokens", "id", campaign.ID, "error", err)
+		return campaign
+	}
+	campaign.Tokens = tokens
+	return campaign
 }
 
 // GetCampaigns retrieves all campaigns
@@ -96,6 +301,110 @@ func (s *Service) GetCampaigns(ctx context.Context) ([]Campaign, error) {
 	return campaigns, nil
 }
 
+// ListCampaigns retrieves one page of campaigns matching the filters. When
+// params.Query is set it runs a full-text search and fills in the page's
+// snippets.
+func (s *Service) ListCampaigns(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error) {
+	if err := s.validate.Struct(params); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidListParams, err)
+	}
+
+	if strings.TrimSpace(params.Query) != "" {
+		page, err := s.repo.Search(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search campaigns: %w", err)
+		}
+		page.Snippets = searchSnippets(page.Campaigns, params.Query)
+		return page, nil
+	}
+
+	page, err := s.repo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list campaigns: %w", err)
+	}
+	return page, nil
+}
+
+// GetActiveCampaigns retrieves the campaigns currently accepting letters
+func (s *Service) GetActiveCampaigns(ctx context.Context) ([]Campaign, error) {
+	campaigns, err := s.repo.GetActive(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active campaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+// GetCampaignsByOwner retrieves every campaign owned by a user
+func (s *Service) GetCampaignsByOwner(ctx context.Context, ownerID uuid.UUID) ([]Campaign, error) {
+	campaigns, err := s.repo.GetByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaigns for owner: %w", err)
+	}
+	return campaigns, nil
+}
+
+// ChangeStatus moves a campaign to a new lifecycle state
+func (s *Service) ChangeStatus(ctx context.Context, dto *ChangeStatusDTO) (*Campaign, error) {
+	if dto == nil {
+		return nil, fmt.Errorf("status data is required")
+	}
+
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	campaign, err := s.repo.GetByID(ctx, GetCampaignDTO{ID: dto.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.Status.CanTransitionTo(dto.Status) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, campaign.Status, dto.Status)
+	}
+	if err := validateSchedule(dto.Status, campaign.StartsAt, campaign.EndsAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStatus(ctx, campaign.ID, dto.Status); err != nil {
+		s.Logger.Error("Failed to change campaign status", err, "id", campaign.ID)
+		return nil, fmt.Errorf("failed to change campaign status: %w", err)
+	}
+
+	s.Logger.Info("Campaign status changed", "id", campaign.ID, "from", campaign.Status, "to", dto.Status)
+	campaign.Status = dto.Status
+	return campaign, nil
+}
+
+// ApplySchedule activates scheduled campaigns whose start time has passed and
+// closes active campaigns whose end time has passed
+func (s *Service) ApplySchedule(ctx context.Context, now time.Time) error {
+	due, err := s.repo.GetDueForActivation(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get campaigns due for activation: %w", err)
+	}
+	for _, c := range due {
+		if err := s.repo.UpdateStatus(ctx, c.ID, StatusActive); err != nil {
+			s.Logger.Error("Failed to activate scheduled campaign", err, "id", c.ID)
+			continue
+		}
+		s.Logger.Info("Scheduled campaign activated", "id", c.ID)
+	}
+
+	expired, err := s.repo.GetDueForClosing(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get campaigns due for closing: %w", err)
+	}
+	for _, c := range expired {
+		if err := s.repo.UpdateStatus(ctx, c.ID, StatusClosed); err != nil {
+			s.Logger.Error("Failed to close expired campaign", err, "id", c.ID)
+			continue
+		}
+		s.Logger.Info("Expired campaign closed", "id", c.ID)
+	}
+
+	return nil
+}
+
 // DeleteCampaign deletes a campaign by ID
 func (s *Service) DeleteCampaign(ctx context.Context, params DeleteCampaignDTO) error {
 	_, err := s.repo.GetByID(ctx, GetCampaignDTO(params))
@@ -126,43 +435,283 @@ func (s *Service) FetchCampaign(ctx context.Context, params GetCampaignParams) (
 		}
 		return nil, fmt.Errorf("failed to fetch campaign: %w", err)
 	}
-	return campaign, nil
+	return s.withTokens(campaign), nil
 }
 
 // ComposeEmailParams defines parameters for composing an email
 type ComposeEmailParams struct {
 	MP       Representative
 	Campaign *Campaign
-	UserData map[string]string
+	// PostalCode is the validated postal code MP was looked up for
+	PostalCode string
+	UserData   map[string]string
+	// FieldValues are the checked answers to the campaign's custom fields,
+	// as returned by CustomFieldValues
+	FieldValues templating.Data
+}
+
+// ComposedEmail is a campaign letter rendered for one representative
+type ComposedEmail struct {
+	// Language is the language of the letter that was rendered
+	Language string
+	Subject  string
+	Content  string
+	// DraftToken must accompany the content when it is sent
+	DraftToken string
 }
 
-// ComposeEmail composes an email using campaign data and user data
-func (s *Service) ComposeEmail(_ context.Context, params ComposeEmailParams) (string, error) {
+// ComposeEmail renders the campaign's letter for a representative and
+// constituent, in the first of the representative's preferred languages the
+// campaign is written in
+func (s *Service) ComposeEmail(_ context.Context, params ComposeEmailParams) (*ComposedEmail, error) {
 	if params.Campaign == nil {
-		return "", fmt.Errorf("campaign is required")
+		return nil, fmt.Errorf("campaign is required")
 	}
 	if params.Campaign.Template == "" {
-		return "", fmt.Errorf("campaign template is required")
+		return nil, fmt.Errorf("campaign template is required")
+	}
+	if !params.Campaign.IsOpen(time.Now()) {
+		return nil, ErrCampaignNotOpen
+	}
+	if params.Campaign.RequireConfirmation && strings.TrimSpace(params.UserData[FieldEmail]) == "" {
+		return nil, ErrConfirmationRequired
+	}
+
+	letter := params.Campaign.LetterFor(params.MP)
+	tmpl, err := s.letters.WithVariables(customFieldVariables(params.Campaign.CustomFields)...).
+		Parse(params.Campaign.ID.String()+"."+letter.Language, letter.Template)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	content, err := tmpl.Execute(letterData(params, time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	// Templates saved before sanitization was introduced may still hold
+	// disallowed markup
+	composed := &ComposedEmail{
+		Language: letter.Language,
+		Subject:  letter.Subject,
+		Content:  templating.SanitizeHTML(content),
+	}
+
+	composed.DraftToken, err = s.drafts.Issue(draft{
+		CampaignID:             params.Campaign.ID.String(),
+		RepresentativeName:     params.MP.Name,
+		RepresentativeEmail:    params.MP.Email,
+		RepresentativeOffice:   params.MP.ElectedOffice,
+		RepresentativeDistrict: params.MP.DistrictName,
+		PostalCode:             params.PostalCode,
+		Language:               composed.Language,
+		Content:                composed.Content,
+		ConstituentFirstName:   params.UserData[FieldFirstName],
+		ConstituentLastName:    params.UserData[FieldLastName],
+		ConstituentEmail:       params.UserData[FieldEmail],
+	}, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign draft: %w", err)
+	}
+	return composed, nil
+}
+
+// SendCampaignEmail sends a submission of a single draft; see
+// SendCampaignEmails
+func (s *Service) SendCampaignEmail(ctx context.Context, dto *SendCampaignEmailDTO) (*Send, error) {
+	result := s.SendCampaignEmails(ctx, []*SendCampaignEmailDTO{dto})[0]
+	return result.Send, result.Err
+}
+
+// SendCampaignEmails sends the drafts submitted together from the preview
+// page, each succeeding or failing on its own. For each draft it records a
+// pending send for the representative and queues the composed content for
+// delivery; the send is completed asynchronously by SendDeliveryListener once
+// the queue reports the outcome. On campaigns that require confirmation the
+// letters are held instead, and the constituent is emailed one link to
+// ConfirmSend that releases them all.
+func (s *Service) SendCampaignEmails(ctx context.Context, dtos []*SendCampaignEmailDTO) []SendResult {
+	results := make([]SendResult, len(dtos))
+
+	// One confirmation releases every letter, so they must all be signed
+	// with the address it goes to
+	if err := sameConstituent(dtos); err != nil {
+		s.Logger.Warn("Rejected campaign submission", "drafts", len(dtos), "error", err)
+		for i := range results {
+			results[i].Err = err
+		}
+		return results
+	}
+
+	req := &confirmationRequest{}
+	for i, dto := range dtos {
+		send, err := s.sendCampaignEmail(ctx, dto, req)
+		results[i] = SendResult{Send: send, Err: err}
+	}
+
+	if err := s.requestConfirmation(ctx, req); err != nil {
+		for i, result := range results {
+			if result.Err == nil && result.Send.ConfirmationToken != nil {
+				results[i].Err = err
+			}
+		}
+	}
+	return results
+}
+
+// sameConstituent checks that the drafts of a submission share the
+// constituent's email address
+func sameConstituent(dtos []*SendCampaignEmailDTO) error {
+	var first *SendCampaignEmailDTO
+	for _, dto := range dtos {
+		switch {
+		case dto == nil:
+		case first == nil:
+			first = dto
+		case !strings.EqualFold(strings.TrimSpace(dto.ConstituentEmail), strings.TrimSpace(first.ConstituentEmail)):
+			return ErrMixedSubmission
+		}
+	}
+	return nil
+}
+
+// sendCampaignEmail sends one draft of a submission, holding it in req if
+// the campaign requires confirmation
+func (s *Service) sendCampaignEmail(
+	ctx context.Context,
+	dto *SendCampaignEmailDTO,
+	req *confirmationRequest,
+) (*Send, error) {
+	if dto == nil {
+		return nil, fmt.Errorf("send data is required")
+	}
+
+	// Everything but the consent checkbox must be exactly as it was composed,
+	// for a representative found for the postal code
+	dto.Content = normalizeNewlines(dto.Content)
+	draftTokenID, err := s.drafts.Verify(dto.DraftToken, draft{
+		CampaignID:             dto.CampaignID.String(),
+		RepresentativeName:     dto.RepresentativeName,
+		RepresentativeEmail:    dto.RepresentativeEmail,
+		RepresentativeOffice:   dto.RepresentativeOffice,
+		RepresentativeDistrict: dto.RepresentativeDistrict,
+		PostalCode:             dto.PostalCode,
+		Language:               dto.Language,
+		Content:                dto.Content,
+		ConstituentFirstName:   dto.ConstituentFirstName,
+		ConstituentLastName:    dto.ConstituentLastName,
+		ConstituentEmail:       dto.ConstituentEmail,
+	})
+	if err != nil {
+		s.Logger.Warn("Rejected campaign draft", "campaignID", dto.CampaignID,
+			"recipient", dto.RepresentativeEmail, "error", err)
+		return nil, err
+	}
+
+	// Replies and the confirmation link go to the constituent whether or
+	// not they agreed to share their address with the organizers
+	constituentEmail := strings.TrimSpace(dto.ConstituentEmail)
+
+	// Without consent the constituent's details are neither checked nor kept
+	if !dto.ContactConsent {
+		dto.ConstituentFirstName, dto.ConstituentLastName, dto.ConstituentEmail = "", "", ""
+	}
+
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	campaign, err := s.FetchCampaign(ctx, GetCampaignParams{ID: dto.CampaignID})
+	if err != nil {
+		return nil, err
+	}
+	if !campaign.IsOpen(time.Now()) {
+		return nil, ErrCampaignNotOpen
+	}
+	if s.validate.Var(constituentEmail, "required,email") != nil {
+		if campaign.RequireConfirmation {
+			return nil, ErrConfirmationRequired
+		}
+		constituentEmail = ""
+	}
+
+	send := &Send{
+		CampaignID:             campaign.ID,
+		RepresentativeName:     dto.RepresentativeName,
+		RepresentativeEmail:    dto.RepresentativeEmail,
+		RepresentativeOffice:   dto.RepresentativeOffice,
+		RepresentativeDistrict: dto.RepresentativeDistrict,
+		PostalCode:             dto.PostalCode,
+		ContactConsent:         dto.ContactConsent,
+		ConstituentFirstName:   strings.TrimSpace(dto.ConstituentFirstName),
+		ConstituentLastName:    strings.TrimSpace(dto.ConstituentLastName),
+		ConstituentEmail:       strings.TrimSpace(dto.ConstituentEmail),
+		ContentHash:            ContentHash(dto.Content),
+		DraftTokenID:           &draftTokenID,
+		Status:                 SendStatusPending,
 	}
 
-	emailTemplate := params.Campaign.Template
+	subject := campaign.LetterIn(dto.Language).Subject
+	if campaign.RequireConfirmation {
+		return s.holdForConfirmation(ctx, req, campaign, send, subject, dto.Content, constituentEmail)
+	}
 
-	// Replace user data placeholders
-	for key, value := range params.UserData {
-		placeholder := fmt.Sprintf("{{%s}}", key)
-		emailTemplate = strings.ReplaceAll(emailTemplate, placeholder, value)
+	if err := s.recordSend(ctx, send); err != nil {
+		return nil, err
 	}
 
-	// Replace standard placeholders
-	replacements := map[string]string{
-		"{{MP's Name}}": params.MP.Name,
-		"{{MPEmail}}":   params.MP.Email,
-		"{{Date}}":      time.Now().Format("2006-01-02"),
+	if err := s.queueSend(ctx, campaign, send, subject, dto.Content, constituentEmail); err != nil {
+		return send, err
 	}
+	return send, nil
+}
 
-	for placeholder, value := range replacements {
-		emailTemplate = strings.ReplaceAll(emailTemplate, placeholder, value)
+// recordSend saves a new send. A draft token that already sent a letter is
+// rejected with ErrDraftUsed.
+func (s *Service) recordSend(ctx context.Context, send *Send) error {
+	err := s.sendRepo.Create(ctx, send)
+	switch {
+	case errors.Is(err, ErrDraftUsed):
+		s.Logger.Warn("Rejected campaign draft", "campaignID", send.CampaignID,
+			"recipient", send.RepresentativeEmail, "error", err)
+		return ErrDraftUsed
+	case err != nil:
+		s.Logger.Error("Failed to record campaign send", err, "campaignID", send.CampaignID)
+		return fmt.Errorf("failed to record send: %w", err)
 	}
+	return nil
+}
 
-	return emailTemplate, nil
+// queueSend queues a recorded send's letter for delivery to the
+// representative, marking the send failed if it can't be queued. Replies go
+// to the constituent, who is copied if the campaign says so.
+func (s *Service) queueSend(
+	ctx context.Context,
+	campaign *Campaign,
+	send *Send,
+	subject, body, constituentEmail string,
+) error {
+	msg := &email.QueuedMessage{
+		To:        send.RepresentativeEmail,
+		ReplyTo:   constituentEmail,
+		Subject:   subject,
+		Body:      body,
+		IsHTML:    true,
+		Reference: sendReference(send.ID),
+	}
+	if campaign.CopyConstituent && constituentEmail != "" {
+		msg.CC = []string{constituentEmail}
+	}
+
+	if err := s.emailQueue.Enqueue(ctx, msg); err != nil {
+		send.Status = SendStatusFailed
+		send.Error = err.Error()
+		if updateErr := s.sendRepo.Update(ctx, send); updateErr != nil {
+			s.Logger.Error("Failed to update campaign send", updateErr, "sendID", send.ID)
+		}
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+
+	s.Logger.Info("Campaign email queued", "campaignID", send.CampaignID, "sendID", send.ID)
+	return nil
 }
@@ -2,6 +2,10 @@ package campaign
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/jonesrussell/mp-emailer/logger"
 )
@@ -19,7 +23,7 @@ func NewLoggingDecorator(service ServiceInterface, log logger.Interface) Service
 }
 
 // Info logs an info message with the given parameters
-func (d *LoggingDecorator) ComposeEmail(ctx context.Context, params ComposeEmailParams) (string, error) {
+func (d *LoggingDecorator) ComposeEmail(ctx context.Context, params ComposeEmailParams) (*ComposedEmail, error) {
 	d.Logger.Info("Composing email", "params", params)
 	email, err := d.service.ComposeEmail(ctx, params)
 	if err != nil {
@@ -53,6 +57,54 @@ func (d *LoggingDecorator) CreateCampaign(ctx context.Context, dto *CreateCampai
 	return campaign, err
 }
 
+// DuplicateCampaign copies a campaign into a new draft
+func (d *LoggingDecorator) DuplicateCampaign(ctx context.Context, dto *DuplicateCampaignDTO) (*Campaign, error) {
+	d.Logger.Info("Duplicating campaign", "id", dto.ID, "ownerID", dto.OwnerID)
+	campaign, err := d.service.DuplicateCampaign(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to duplicate campaign", err, "id", dto.ID, "ownerID", dto.OwnerID)
+	}
+	return campaign, err
+}
+
+// ListStarterTemplates lists the starter template library
+func (d *LoggingDecorator) ListStarterTemplates(ctx context.Context) ([]StarterTemplate, error) {
+	starters, err := d.service.ListStarterTemplates(ctx)
+	if err != nil {
+		d.Logger.Error("Failed to list starter templates", err)
+	}
+	return starters, err
+}
+
+// GetStarterTemplate gets a starter template by ID
+func (d *LoggingDecorator) GetStarterTemplate(ctx context.Context, id uuid.UUID) (*StarterTemplate, error) {
+	starter, err := d.service.GetStarterTemplate(ctx, id)
+	if err != nil && !errors.Is(err, ErrStarterTemplateNotFound) {
+		d.Logger.Error("Failed to get starter template", err, "id", id)
+	}
+	return starter, err
+}
+
+// CreateFromStarter creates a draft campaign from a starter template
+func (d *LoggingDecorator) CreateFromStarter(ctx context.Context, dto *CreateFromStarterDTO) (*Campaign, error) {
+	d.Logger.Info("Creating campaign from starter template", "starterID", dto.StarterID, "ownerID", dto.OwnerID)
+	campaign, err := d.service.CreateFromStarter(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to create campaign from starter template", err, "starterID", dto.StarterID)
+	}
+	return campaign, err
+}
+
+// ImportCampaigns creates campaigns from a bundle
+func (d *LoggingDecorator) ImportCampaigns(ctx context.Context, dto *ImportCampaignsDTO) (*ImportResult, error) {
+	d.Logger.Info("Importing campaigns", "ownerID", dto.OwnerID)
+	result, err := d.service.ImportCampaigns(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to import campaigns", err, "ownerID", dto.OwnerID)
+	}
+	return result, err
+}
+
 // UpdateCampaign updates an existing campaign
 func (d *LoggingDecorator) UpdateCampaign(ctx context.Context, dto *UpdateCampaignDTO) error {
 	d.Logger.Info("Updating campaign", "dto", dto)
@@ -102,3 +154,198 @@ func (d *LoggingDecorator) FetchCampaign(ctx context.Context, params GetCampaign
 	}
 	return campaign, err
 }
+
+// SendCampaignEmail sends a campaign email
+func (d *LoggingDecorator) SendCampaignEmail(ctx context.Context, dto *SendCampaignEmailDTO) (*Send, error) {
+	d.Logger.Info("Sending campaign email", "campaignID", dto.CampaignID, "recipient", dto.RepresentativeEmail)
+	send, err := d.service.SendCampaignEmail(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to send campaign email", err, "campaignID", dto.CampaignID)
+	}
+	return send, err
+}
+
+// SendCampaignEmails sends the drafts of a submission
+func (d *LoggingDecorator) SendCampaignEmails(ctx context.Context, dtos []*SendCampaignEmailDTO) []SendResult {
+	d.Logger.Info("Sending campaign emails", "drafts", len(dtos))
+	results := d.service.SendCampaignEmails(ctx, dtos)
+	for i, result := range results {
+		if result.Err != nil {
+			d.Logger.Error("Failed to send campaign email", result.Err, "campaignID", dtos[i].CampaignID)
+		}
+	}
+	return results
+}
+
+// GetConfirmation gets the letters held for confirmation
+func (d *LoggingDecorator) GetConfirmation(ctx context.Context, token string) ([]Send, error) {
+	sends, err := d.service.GetConfirmation(ctx, token)
+	if err != nil {
+		d.Logger.Error("Failed to get campaign letter confirmation", err)
+	}
+	return sends, err
+}
+
+// ConfirmSend releases the letters held for confirmation
+func (d *LoggingDecorator) ConfirmSend(ctx context.Context, token string) ([]Send, error) {
+	sends, err := d.service.ConfirmSend(ctx, token)
+	if err != nil {
+		d.Logger.Error("Failed to confirm campaign letters", err)
+	}
+	return sends, err
+}
+
+// StreamSends streams a campaign's sends
+func (d *LoggingDecorator) StreamSends(ctx context.Context, campaignID uuid.UUID, fn func(*Send) error) error {
+	d.Logger.Info("Streaming campaign sends", "campaignID", campaignID)
+	err := d.service.StreamSends(ctx, campaignID, fn)
+	if err != nil {
+		d.Logger.Error("Failed to stream campaign sends", err, "campaignID", campaignID)
+	}
+	return err
+}
+
+// GetActiveCampaigns gets the campaigns currently accepting letters
+func (d *LoggingDecorator) GetActiveCampaigns(ctx context.Context) ([]Campaign, error) {
+	d.Logger.Info("Fetching active campaigns")
+	campaigns, err := d.service.GetActiveCampaigns(ctx)
+	if err != nil {
+		d.Logger.Error("Failed to fetch active campaigns", err)
+	}
+	return campaigns, err
+}
+
+// ListCampaigns gets one page of campaigns
+func (d *LoggingDecorator) ListCampaigns(ctx context.Context, params ListCampaignsParams) (*CampaignPage, error) {
+	d.Logger.Info("Listing campaigns", "params", params)
+	page, err := d.service.ListCampaigns(ctx, params)
+	if err != nil {
+		d.Logger.Error("Failed to list campaigns", err, "params", params)
+	}
+	return page, err
+}
+
+// GetCampaignsByOwner gets the campaigns owned by a user
+func (d *LoggingDecorator) GetCampaignsByOwner(ctx context.Context, ownerID uuid.UUID) ([]Campaign, error) {
+	d.Logger.Info("Fetching campaigns by owner", "ownerID", ownerID)
+	campaigns, err := d.service.GetCampaignsByOwner(ctx, ownerID)
+	if err != nil {
+		d.Logger.Error("Failed to fetch campaigns by owner", err, "ownerID", ownerID)
+	}
+	return campaigns, err
+}
+
+// GetCampaignsByOrganization gets the campaigns run by an organization
+func (d *LoggingDecorator) GetCampaignsByOrganization(ctx context.Context, orgID uuid.UUID) ([]Campaign, error) {
+	d.Logger.Info("Fetching campaigns by organization", "organizationID", orgID)
+	campaigns, err := d.service.GetCampaignsByOrganization(ctx, orgID)
+	if err != nil {
+		d.Logger.Error("Failed to fetch campaigns by organization", err, "organizationID", orgID)
+	}
+	return campaigns, err
+}
+
+// GetOrganizationDashboard gets an organization's campaigns with send counts
+func (d *LoggingDecorator) GetOrganizationDashboard(
+	ctx context.Context,
+	orgID uuid.UUID,
+) (*OrganizationDashboard, error) {
+	d.Logger.Info("Fetching organization dashboard", "organizationID", orgID)
+	dashboard, err := d.service.GetOrganizationDashboard(ctx, orgID)
+	if err != nil {
+		d.Logger.Error("Failed to fetch organization dashboard", err, "organizationID", orgID)
+	}
+	return dashboard, err
+}
+
+// ChangeStatus changes a campaign's lifecycle state
+func (d *LoggingDecorator) ChangeStatus(ctx context.Context, dto *ChangeStatusDTO) (*Campaign, error) {
+	d.Logger.Info("Changing campaign status", "dto", dto)
+	campaign, err := d.service.ChangeStatus(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to change campaign status", err, "dto", dto)
+	}
+	return campaign, err
+}
+
+// ApplySchedule opens and closes campaigns according to their schedule
+func (d *LoggingDecorator) ApplySchedule(ctx context.Context, now time.Time) error {
+	err := d.service.ApplySchedule(ctx, now)
+	if err != nil {
+		d.Logger.Error("Failed to apply campaign schedule", err)
+	}
+	return err
+}
+
+// ExpireUnconfirmedSends expires letters that were never confirmed
+func (d *LoggingDecorator) ExpireUnconfirmedSends(ctx context.Context, now time.Time) error {
+	err := d.service.ExpireUnconfirmedSends(ctx, now)
+	if err != nil {
+		d.Logger.Error("Failed to expire unconfirmed campaign letters", err)
+	}
+	return err
+}
+
+// CheckPermission checks a user's access to a campaign
+func (d *LoggingDecorator) CheckPermission(
+	ctx context.Context,
+	campaign *Campaign,
+	userID uuid.UUID,
+	permission Permission,
+) error {
+	err := d.service.CheckPermission(ctx, campaign, userID, permission)
+	if err != nil && !errors.Is(err, ErrUnauthorizedAccess) {
+		d.Logger.Error("Failed to check campaign permission", err, "userID", userID)
+	}
+	return err
+}
+
+// GetSharedCampaigns gets the campaigns shared with a user
+func (d *LoggingDecorator) GetSharedCampaigns(ctx context.Context, userID uuid.UUID) ([]Campaign, error) {
+	d.Logger.Info("Fetching shared campaigns", "userID", userID)
+	campaigns, err := d.service.GetSharedCampaigns(ctx, userID)
+	if err != nil {
+		d.Logger.Error("Failed to fetch shared campaigns", err, "userID", userID)
+	}
+	return campaigns, err
+}
+
+// ListMembers lists a campaign's collaborators
+func (d *LoggingDecorator) ListMembers(ctx context.Context, campaignID uuid.UUID) ([]Member, error) {
+	d.Logger.Info("Listing campaign members", "campaignID", campaignID)
+	members, err := d.service.ListMembers(ctx, campaignID)
+	if err != nil {
+		d.Logger.Error("Failed to list campaign members", err, "campaignID", campaignID)
+	}
+	return members, err
+}
+
+// InviteMember invites a collaborator to a campaign
+func (d *LoggingDecorator) InviteMember(ctx context.Context, dto *InviteMemberDTO) (*Member, error) {
+	d.Logger.Info("Inviting campaign member", "campaignID", dto.CampaignID, "role", dto.Role)
+	member, err := d.service.InviteMember(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to invite campaign member", err, "campaignID", dto.CampaignID)
+	}
+	return member, err
+}
+
+// AcceptInvite accepts an invitation to a campaign
+func (d *LoggingDecorator) AcceptInvite(ctx context.Context, dto *AcceptInviteDTO) (*Member, error) {
+	d.Logger.Info("Accepting campaign invitation", "userID", dto.UserID)
+	member, err := d.service.AcceptInvite(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to accept campaign invitation", err, "userID", dto.UserID)
+	}
+	return member, err
+}
+
+// RemoveMember removes a collaborator from a campaign
+func (d *LoggingDecorator) RemoveMember(ctx context.Context, dto RemoveMemberDTO) error {
+	d.Logger.Info("Removing campaign member", "dto", dto)
+	err := d.service.RemoveMember(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to remove campaign member", err, "dto", dto)
+	}
+	return err
+}
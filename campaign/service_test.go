@@ -3,28 +3,49 @@ package campaign_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/email"
 	mocksCampaign "github.com/jonesrussell/mp-emailer/mocks/campaign"
+	mocksEmail "github.com/jonesrussell/mp-emailer/mocks/email"
 	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	mocksOrganization "github.com/jonesrussell/mp-emailer/mocks/organization"
+	mocksUser "github.com/jonesrussell/mp-emailer/mocks/user"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/jonesrussell/mp-emailer/templating"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type CampaignServiceTestSuite struct {
 	suite.Suite
-	service    *campaign.Service
-	mockRepo   *mocksCampaign.MockRepositoryInterface
-	validate   *validator.Validate
-	mockLogger *mocksLogger.MockInterface
+	service      *campaign.Service
+	mockRepo     *mocksCampaign.MockRepositoryInterface
+	mockSendRepo *mocksCampaign.MockSendRepositoryInterface
+	mockMembers  *mocksCampaign.MockMemberRepositoryInterface
+	mockStarters *mocksCampaign.MockStarterTemplateRepositoryInterface
+	mockOrgs     *mocksOrganization.MockServiceInterface
+	mockUsers    *mocksUser.MockRepositoryInterface
+	mockQueue    *mocksEmail.MockQueue
+	validate     *validator.Validate
+	mockLogger   *mocksLogger.MockInterface
 }
 
 func (s *CampaignServiceTestSuite) SetupTest() {
 	s.mockRepo = new(mocksCampaign.MockRepositoryInterface)
+	s.mockSendRepo = mocksCampaign.NewMockSendRepositoryInterface(s.T())
+	s.mockMembers = mocksCampaign.NewMockMemberRepositoryInterface(s.T())
+	s.mockStarters = mocksCampaign.NewMockStarterTemplateRepositoryInterface(s.T())
+	s.mockOrgs = mocksOrganization.NewMockServiceInterface(s.T())
+	s.mockUsers = mocksUser.NewMockRepositoryInterface(s.T())
+	s.mockQueue = mocksEmail.NewMockQueue(s.T())
 	s.validate = validator.New()
 	s.mockLogger = mocksLogger.NewMockInterface(s.T())
 
@@ -39,7 +60,18 @@ func (s *CampaignServiceTestSuite) SetupTest() {
 		s.T().Fatalf("failed to register uuid4 validator: %v", err)
 	}
 
-	s.service = campaign.NewService(s.mockRepo, s.validate, s.mockLogger).(*campaign.Service)
+	s.service = campaign.NewService(campaign.ServiceParams{
+		Repo:        s.mockRepo,
+		SendRepo:    s.mockSendRepo,
+		MemberRepo:  s.mockMembers,
+		StarterRepo: s.mockStarters,
+		Orgs:        s.mockOrgs,
+		Users:       s.mockUsers,
+		EmailQueue:  s.mockQueue,
+		Config:      &config.Config{App: config.AppConfig{BaseURL: "https://example.com/"}},
+		Validate:    s.validate,
+		Logger:      s.mockLogger,
+	}).(*campaign.Service)
 
 	s.mockRepo.On("GetByID",
 		mock.Anything,
@@ -59,6 +91,7 @@ func (s *CampaignServiceTestSuite) TestCreateCampaign() {
 		dto     *campaign.CreateCampaignDTO
 		setup   func()
 		wantErr bool
+		errMsg  string
 	}{
 		{
 			name: "successful creation",
@@ -87,6 +120,191 @@ func (s *CampaignServiceTestSuite) TestCreateCampaign() {
 			},
 			wantErr: false,
 		},
+		{
+			name: "records template tokens",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "Dear {{MP's Name}}, from {{.first_name}} in {{City}}",
+				OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockRepo.EXPECT().Create(
+					mock.Anything,
+					mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+						return s.Equal([]string{"city", "first_name", "representative.name"}, dto.Tokens)
+					}),
+				).Return(&campaign.Campaign{
+					Name:        "Test Campaign",
+					Description: "Test Description",
+					Template:    "Dear {{MP's Name}}, from {{.first_name}} in {{City}}",
+					OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				}, nil)
+
+				s.mockLogger.EXPECT().Info(
+					"Campaign created successfully",
+					"id",
+					mock.AnythingOfType("uuid.UUID"),
+				).Return()
+			},
+		},
+		{
+			name: "misspelled placeholder",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "Dear {{.representative.nmae}}",
+				OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockLogger.EXPECT().Debug("Invalid campaign template", "error", mock.Anything).Return()
+			},
+			wantErr: true,
+			errMsg:  `invalid campaign template: unknown variable "representative.nmae" (did you mean "representative.name"?)`,
+		},
+		{
+			name: "template uses a custom field",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "{{if .fields.nurse}}As a nurse, {{end}}I care about this.",
+				CustomFields: []campaign.CustomField{
+					{Key: "nurse", Label: "Are you a nurse?", Type: campaign.FieldTypeCheckbox},
+				},
+				OwnerID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockRepo.EXPECT().Create(
+					mock.Anything,
+					mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+						return s.Equal([]string{"fields.nurse"}, dto.Tokens) && s.Len(dto.CustomFields, 1)
+					}),
+				).Return(&campaign.Campaign{
+					Name:        "Test Campaign",
+					Description: "Test Description",
+					Template:    "{{if .fields.nurse}}As a nurse, {{end}}I care about this.",
+					OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				}, nil)
+
+				s.mockLogger.EXPECT().Info(
+					"Campaign created successfully",
+					"id",
+					mock.AnythingOfType("uuid.UUID"),
+				).Return()
+			},
+		},
+		{
+			name: "translations add their tokens",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "Dear {{.representative.name}}",
+				Translations: []campaign.Translation{
+					{Language: campaign.LanguageFrench, Template: "Cher {{.representative.name}}, de {{.city}}"},
+				},
+				OwnerID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockRepo.EXPECT().Create(
+					mock.Anything,
+					mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+						return s.Equal([]string{"city", "representative.name"}, dto.Tokens) &&
+							s.Equal(campaign.LanguageEnglish, dto.Language)
+					}),
+				).Return(&campaign.Campaign{
+					Name:        "Test Campaign",
+					Description: "Test Description",
+					Template:    "Dear {{.representative.name}}",
+					OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				}, nil)
+
+				s.mockLogger.EXPECT().Info(
+					"Campaign created successfully",
+					"id",
+					mock.AnythingOfType("uuid.UUID"),
+				).Return()
+			},
+		},
+		{
+			name: "translation in the campaign's own language",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "Dear {{.representative.name}}",
+				Translations: []campaign.Translation{
+					{Language: campaign.LanguageEnglish, Template: "Hello {{.representative.name}}"},
+				},
+				OwnerID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockLogger.EXPECT().Debug("Invalid campaign template", "error", mock.Anything).Return()
+			},
+			wantErr: true,
+			errMsg:  "invalid campaign template: English is the campaign's own language, so it uses the main template",
+		},
+		{
+			name: "misspelled placeholder in a translation",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "Dear {{.representative.name}}",
+				Translations: []campaign.Translation{
+					{Language: campaign.LanguageFrench, Template: "Cher {{.representative.nom}}"},
+				},
+				OwnerID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockLogger.EXPECT().Debug("Invalid campaign template", "error", mock.Anything).Return()
+			},
+			wantErr: true,
+			errMsg:  `invalid campaign template: Français: unknown variable "representative.nom" (did you mean "representative.name"?)`,
+		},
+		{
+			name: "script is removed before saving",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    `<p onclick="steal()">Dear {{.representative.name}}</p><script>steal()</script>`,
+				Translations: []campaign.Translation{
+					{Language: campaign.LanguageFrench, Template: `<img src=x onerror=steal()>Cher {{.representative.name}}`},
+				},
+				OwnerID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockRepo.EXPECT().Create(
+					mock.Anything,
+					mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+						return s.Equal(`<p>Dear {{.representative.name}}</p>`, dto.Template) &&
+							s.Equal(`<img src="x">Cher {{.representative.name}}`, dto.Translations[0].Template)
+					}),
+				).Return(&campaign.Campaign{
+					Name:        "Test Campaign",
+					Description: "Test Description",
+					Template:    `<p>Dear {{.representative.name}}</p>`,
+					OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				}, nil)
+
+				s.mockLogger.EXPECT().Info(
+					"Campaign created successfully",
+					"id",
+					mock.AnythingOfType("uuid.UUID"),
+				).Return()
+			},
+		},
+		{
+			name: "template uses an undefined custom field",
+			dto: &campaign.CreateCampaignDTO{
+				Name:        "Test Campaign",
+				Description: "Test Description",
+				Template:    "I am a nurse: {{.fields.nurse}}",
+				OwnerID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			setup: func() {
+				s.mockLogger.EXPECT().Debug("Invalid campaign template", "error", mock.Anything).Return()
+			},
+			wantErr: true,
+			errMsg:  `invalid campaign template: unknown variable "fields.nurse"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,7 +317,8 @@ func (s *CampaignServiceTestSuite) TestCreateCampaign() {
 			tt.setup()
 			got, err := s.service.CreateCampaign(context.Background(), tt.dto)
 			if tt.wantErr {
-				s.Error(err)
+				s.ErrorIs(err, campaign.ErrInvalidTemplate)
+				s.EqualError(err, tt.errMsg)
 				return
 			}
 			s.NoError(err)
@@ -128,6 +347,7 @@ func (s *CampaignServiceTestSuite) TestComposeEmail() {
 					Email: "john@example.com",
 				},
 				Campaign: &campaign.Campaign{
+					Status:   campaign.StatusActive,
 					Template: "Dear {{MP's Name}}, This is a test email. Your email is {{MPEmail}}. Date: {{Date}}",
 				},
 				UserData: map[string]string{
@@ -137,17 +357,173 @@ func (s *CampaignServiceTestSuite) TestComposeEmail() {
 			want:    "Dear John Doe, This is a test email. Your email is john@example.com. Date: " + time.Now().Format("2006-01-02"),
 			wantErr: false,
 		},
+		{
+			name: "conditionals, defaults and filters",
+			params: campaign.ComposeEmailParams{
+				MP: campaign.Representative{
+					Name:  "John Doe",
+					Party: "Green",
+				},
+				Campaign: &campaign.Campaign{
+					Status: campaign.StatusActive,
+					Template: `Dear {{.representative.name | upper}},` +
+						`{{if eq .representative.party "Green"}} Thank you.{{else}} Please reconsider.{{end}}` +
+						` From {{.first_name | default "a constituent"}} in {{.city | title}}`,
+				},
+				UserData: map[string]string{
+					"city": "THUNDER BAY",
+				},
+			},
+			want: "Dear JOHN DOE, Thank you. From a constituent in Thunder Bay",
+		},
+		{
+			name: "constituent values are escaped",
+			params: campaign.ComposeEmailParams{
+				Campaign: &campaign.Campaign{
+					Status:   campaign.StatusActive,
+					Template: "<p>{{First Name}}</p>",
+				},
+				UserData: map[string]string{
+					"first_name": "<script>",
+				},
+			},
+			want: "<p>&lt;script&gt;</p>",
+		},
+		{
+			name: "custom field answers",
+			params: campaign.ComposeEmailParams{
+				Campaign: &campaign.Campaign{
+					Status: campaign.StatusActive,
+					Template: `{{if .fields.nurse}}As a nurse, {{end}}I live in the {{.fields.region}}.` +
+						`{{if .fields.story}} {{.fields.story}}{{end}}`,
+					CustomFields: []campaign.CustomField{
+						{Key: "nurse", Label: "Are you a nurse?", Type: campaign.FieldTypeCheckbox},
+						{Key: "region", Label: "Region", Type: campaign.FieldTypeSelect, Options: []string{"North"}},
+						{Key: "story", Label: "Your story", Type: campaign.FieldTypeTextarea},
+					},
+				},
+				FieldValues: templating.Data{"nurse": true, "region": "North", "story": "<b>Nights</b>"},
+			},
+			want: "As a nurse, I live in the North. &lt;b&gt;Nights&lt;/b&gt;",
+		},
+		{
+			name: "markup saved before sanitization is removed",
+			params: campaign.ComposeEmailParams{
+				MP: campaign.Representative{Name: "John Doe"},
+				Campaign: &campaign.Campaign{
+					Status:   campaign.StatusActive,
+					Template: `<p>Dear {{.representative.name}}</p><script>alert(1)</script><a href="javascript:alert(1)">x</a>`,
+				},
+			},
+			want: "<p>Dear John Doe</p>x",
+		},
+		{
+			name: "undefined custom field",
+			params: campaign.ComposeEmailParams{
+				Campaign: &campaign.Campaign{
+					Status:   campaign.StatusActive,
+					Template: "I am {{.fields.nurse}}",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown variable",
+			params: campaign.ComposeEmailParams{
+				Campaign: &campaign.Campaign{
+					Status:   campaign.StatusActive,
+					Template: "Dear {{.representative.nmae}}",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown legacy placeholder",
+			params: campaign.ComposeEmailParams{
+				Campaign: &campaign.Campaign{
+					Status:   campaign.StatusActive,
+					Template: "Dear {{Riding Name}}",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			got, err := s.service.ComposeEmail(context.Background(), tt.params)
 			if tt.wantErr {
-				s.Error(err)
+				s.ErrorIs(err, campaign.ErrInvalidTemplate)
 				return
 			}
 			s.NoError(err)
-			s.Equal(tt.want, got)
+			s.Equal(tt.want, got.Content)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestComposeEmailLanguage() {
+	bilingual := &campaign.Campaign{
+		Name:     "Save the Library",
+		Status:   campaign.StatusActive,
+		Subject:  "Save the library",
+		Template: "Dear {{.representative.name}}, please save the library.",
+		Translations: []campaign.Translation{{
+			Language: campaign.LanguageFrench,
+			Subject:  "Sauvez la bibliothèque",
+			Template: "Cher {{.representative.name}}, sauvez la bibliothèque.",
+		}},
+	}
+
+	tests := []struct {
+		name        string
+		preferred   []string
+		want        string
+		wantSubject string
+		wantLang    string
+	}{
+		{
+			name:        "French preferred",
+			preferred:   []string{"French", "English"},
+			want:        "Cher Marie Tremblay, sauvez la bibliothèque.",
+			wantSubject: "Sauvez la bibliothèque",
+			wantLang:    campaign.LanguageFrench,
+		},
+		{
+			name:        "English preferred",
+			preferred:   []string{"English", "French"},
+			want:        "Dear Marie Tremblay, please save the library.",
+			wantSubject: "Save the library",
+			wantLang:    campaign.LanguageEnglish,
+		},
+		{
+			name:        "no translation in the preferred language",
+			preferred:   []string{"Inuktitut"},
+			want:        "Dear Marie Tremblay, please save the library.",
+			wantSubject: "Save the library",
+			wantLang:    campaign.LanguageEnglish,
+		},
+		{
+			name:        "no preference",
+			want:        "Dear Marie Tremblay, please save the library.",
+			wantSubject: "Save the library",
+			wantLang:    campaign.LanguageEnglish,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			got, err := s.service.ComposeEmail(context.Background(), campaign.ComposeEmailParams{
+				MP: campaign.Representative{
+					Name:  "Marie Tremblay",
+					Extra: campaign.Extra{PreferredLanguages: tt.preferred},
+				},
+				Campaign: bilingual,
+			})
+			s.Require().NoError(err)
+			s.Equal(tt.want, got.Content)
+			s.Equal(tt.wantSubject, got.Subject)
+			s.Equal(tt.wantLang, got.Language)
 		})
 	}
 }
@@ -184,6 +560,22 @@ func (s *CampaignServiceTestSuite) TestGetCampaignByID() {
 			},
 			wantErr: false,
 		},
+		{
+			name: "campaign saved before its tokens were recorded",
+			id:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			setup: func() {
+				s.mockRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(&campaign.Campaign{
+					Name:     "Legacy Campaign",
+					Template: "Dear {{.representative.name}}, I live in {{.city}}. {{.first_name}}",
+				}, nil)
+			},
+			want: &campaign.Campaign{
+				Name:     "Legacy Campaign",
+				Template: "Dear {{.representative.name}}, I live in {{.city}}. {{.first_name}}",
+				Tokens:   []string{"city", "first_name", "representative.name"},
+			},
+			wantErr: false,
+		},
 		{
 			name: "campaign not found",
 			id:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
@@ -303,3 +695,798 @@ func (s *CampaignServiceTestSuite) TestDeleteCampaign() {
 		})
 	}
 }
+
+// composeDraft composes a campaign's letter for a representative and returns
+// what the preview page submits to send it
+func (s *CampaignServiceTestSuite) composeDraft(
+	c *campaign.Campaign,
+	representative campaign.Representative,
+	userData map[string]string,
+) *campaign.SendCampaignEmailDTO {
+	composed, err := s.service.ComposeEmail(context.Background(), campaign.ComposeEmailParams{
+		MP:         representative,
+		Campaign:   c,
+		PostalCode: "K1A0A6",
+		UserData:   userData,
+	})
+	s.Require().NoError(err)
+
+	return &campaign.SendCampaignEmailDTO{
+		CampaignID:             c.ID,
+		RepresentativeName:     representative.Name,
+		RepresentativeEmail:    representative.Email,
+		RepresentativeOffice:   representative.ElectedOffice,
+		RepresentativeDistrict: representative.DistrictName,
+		PostalCode:             "K1A0A6",
+		Content:                composed.Content,
+		Language:               composed.Language,
+		DraftToken:             composed.DraftToken,
+		ConstituentFirstName:   userData[campaign.FieldFirstName],
+		ConstituentLastName:    userData[campaign.FieldLastName],
+		ConstituentEmail:       userData[campaign.FieldEmail],
+	}
+}
+
+// openCampaign returns an active campaign whose letter is "<p>Hello</p>"
+func openCampaign() *campaign.Campaign {
+	return &campaign.Campaign{
+		BaseModel: shared.BaseModel{ID: uuid.New()},
+		Name:      "Test Campaign",
+		Status:    campaign.StatusActive,
+		Template:  "<p>Hello</p>",
+	}
+}
+
+//nolint:gochecknoglobals
+var janeDoe = campaign.Representative{
+	Name:          "Jane Doe",
+	Email:         "jane.doe@parl.gc.ca",
+	ElectedOffice: "MP",
+	DistrictName:  "Ottawa Centre",
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmail() {
+	open := openCampaign()
+	campaignID := open.ID
+	dto := s.composeDraft(open, janeDoe, nil)
+
+	tests := []struct {
+		name       string
+		setup      func()
+		wantStatus campaign.SendStatus
+		wantErr    bool
+	}{
+		{
+			name: "queued for delivery",
+			setup: func() {
+				s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+					return msg.To == "jane.doe@parl.gc.ca" &&
+						msg.Subject == "Test Campaign" &&
+						msg.Body == "<p>Hello</p>" &&
+						msg.IsHTML &&
+						strings.HasPrefix(msg.Reference, "campaign_send:")
+				})).Return(nil).Once()
+				s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", mock.Anything, "sendID", mock.Anything).
+					Return().Once()
+			},
+			wantStatus: campaign.SendStatusPending,
+		},
+		{
+			name: "queue unavailable",
+			setup: func() {
+				s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.Anything).
+					Return(fmt.Errorf("database unavailable")).Once()
+				s.mockSendRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*campaign.Send")).
+					Return(nil).Once()
+			},
+			wantStatus: campaign.SendStatusFailed,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockRepo.ExpectedCalls = nil
+			s.mockRepo.Calls = nil
+
+			s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: campaignID}).
+				Return(open, nil)
+
+			var created *campaign.Send
+			s.mockSendRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*campaign.Send")).
+				Run(func(_ context.Context, send *campaign.Send) {
+					s.Equal(campaign.SendStatusPending, send.Status)
+					s.Equal(campaign.ContentHash(dto.Content), send.ContentHash)
+					created = send
+				}).
+				Return(nil).Once()
+
+			tt.setup()
+
+			send, err := s.service.SendCampaignEmail(context.Background(), dto)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.Require().NotNil(send)
+			s.Same(created, send)
+			s.Equal(tt.wantStatus, send.Status)
+			s.Nil(send.SentAt)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailRequiresOpenCampaign() {
+	open := openCampaign()
+	dto := s.composeDraft(open, janeDoe, nil)
+
+	paused := *open
+	paused.Status = campaign.StatusPaused
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(&paused, nil)
+
+	send, err := s.service.SendCampaignEmail(context.Background(), dto)
+
+	s.ErrorIs(err, campaign.ErrCampaignNotOpen)
+	s.Nil(send)
+}
+
+func (s *CampaignServiceTestSuite) TestComposeEmailRequiresOpenCampaign() {
+	ended := time.Now().Add(-time.Minute)
+
+	_, err := s.service.ComposeEmail(context.Background(), campaign.ComposeEmailParams{
+		Campaign: &campaign.Campaign{
+			Status:   campaign.StatusActive,
+			EndsAt:   &ended,
+			Template: "Dear {{MP's Name}}",
+		},
+	})
+
+	s.ErrorIs(err, campaign.ErrCampaignNotOpen)
+}
+
+func (s *CampaignServiceTestSuite) TestChangeStatus() {
+	campaignID := uuid.New()
+
+	tests := []struct {
+		name     string
+		current  *campaign.Campaign
+		next     campaign.Status
+		setup    func()
+		wantErr  error
+		wantNext campaign.Status
+	}{
+		{
+			name:    "pause an active campaign",
+			current: &campaign.Campaign{Status: campaign.StatusActive},
+			next:    campaign.StatusPaused,
+			setup: func() {
+				s.mockRepo.EXPECT().UpdateStatus(mock.Anything, campaignID, campaign.StatusPaused).Return(nil).Once()
+				s.mockLogger.EXPECT().Info("Campaign status changed", "id", campaignID,
+					"from", campaign.StatusActive, "to", campaign.StatusPaused).Return().Once()
+			},
+			wantNext: campaign.StatusPaused,
+		},
+		{
+			name:    "reopen a closed campaign",
+			current: &campaign.Campaign{Status: campaign.StatusClosed},
+			next:    campaign.StatusActive,
+			wantErr: campaign.ErrInvalidStatusTransition,
+		},
+		{
+			name:    "schedule without a start time",
+			current: &campaign.Campaign{Status: campaign.StatusDraft},
+			next:    campaign.StatusScheduled,
+			wantErr: campaign.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockRepo.ExpectedCalls = nil
+			s.mockRepo.Calls = nil
+
+			tt.current.ID = campaignID
+			s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: campaignID}).
+				Return(tt.current, nil).Once()
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := s.service.ChangeStatus(context.Background(), &campaign.ChangeStatusDTO{
+				ID:     campaignID,
+				Status: tt.next,
+			})
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				s.mockRepo.AssertNotCalled(s.T(), "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tt.wantNext, got.Status)
+			s.mockRepo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestApplySchedule() {
+	now := time.Now()
+	scheduled := campaign.Campaign{Status: campaign.StatusScheduled}
+	scheduled.ID = uuid.New()
+	expired := campaign.Campaign{Status: campaign.StatusActive}
+	expired.ID = uuid.New()
+
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetDueForActivation(mock.Anything, now).Return([]campaign.Campaign{scheduled}, nil).Once()
+	s.mockRepo.EXPECT().UpdateStatus(mock.Anything, scheduled.ID, campaign.StatusActive).Return(nil).Once()
+	s.mockRepo.EXPECT().GetDueForClosing(mock.Anything, now).Return([]campaign.Campaign{expired}, nil).Once()
+	s.mockRepo.EXPECT().UpdateStatus(mock.Anything, expired.ID, campaign.StatusClosed).Return(nil).Once()
+	s.mockLogger.EXPECT().Info("Scheduled campaign activated", "id", scheduled.ID).Return().Once()
+	s.mockLogger.EXPECT().Info("Expired campaign closed", "id", expired.ID).Return().Once()
+
+	s.NoError(s.service.ApplySchedule(context.Background(), now))
+	s.mockRepo.AssertExpectations(s.T())
+}
+
+func (s *CampaignServiceTestSuite) TestListCampaigns() {
+	s.Run("passes valid params to the repository", func() {
+		params := campaign.ListCampaignsParams{Status: campaign.StatusActive, Sort: campaign.SortName}
+		s.mockRepo.EXPECT().List(mock.Anything, params).
+			Return(&campaign.CampaignPage{Page: 1, PageSize: campaign.DefaultPageSize}, nil).Once()
+
+		page, err := s.service.ListCampaigns(context.Background(), params)
+		s.NoError(err)
+		s.Equal(1, page.Page)
+	})
+
+	s.Run("searches when given a query", func() {
+		found := campaign.Campaign{
+			BaseModel:   shared.BaseModel{ID: uuid.New()},
+			Name:        "Safe Streets",
+			Description: "Ask council to build protected bike lanes downtown.",
+		}
+		params := campaign.ListCampaignsParams{Query: "bike"}
+		s.mockRepo.EXPECT().Search(mock.Anything, params).
+			Return(&campaign.CampaignPage{Campaigns: []campaign.Campaign{found}, Total: 1, Page: 1}, nil).Once()
+
+		page, err := s.service.ListCampaigns(context.Background(), params)
+		s.Require().NoError(err)
+		s.Equal("Ask council to build protected <mark>bike</mark> lanes downtown.", page.Snippets[found.ID])
+	})
+
+	s.Run("highlights the text of the letter, not its markup", func() {
+		found := campaign.Campaign{
+			BaseModel: shared.BaseModel{ID: uuid.New()},
+			Name:      "Safe Streets",
+			Template:  `<p>Dear {{.representative.name}},</p><p>Please fund <strong>bike</strong> lanes &amp; paths.</p>`,
+		}
+		params := campaign.ListCampaignsParams{Query: "bike"}
+		s.mockRepo.EXPECT().Search(mock.Anything, params).
+			Return(&campaign.CampaignPage{Campaigns: []campaign.Campaign{found}, Total: 1, Page: 1}, nil).Once()
+
+		page, err := s.service.ListCampaigns(context.Background(), params)
+		s.Require().NoError(err)
+		s.Equal("Dear {{.representative.name}}, Please fund <mark>bike</mark> lanes &amp; paths.",
+			page.Snippets[found.ID])
+	})
+
+	s.Run("rejects an oversized page", func() {
+		_, err := s.service.ListCampaigns(context.Background(), campaign.ListCampaignsParams{PageSize: 1000})
+		s.ErrorIs(err, campaign.ErrInvalidListParams)
+	})
+
+	s.Run("rejects an unknown sort", func() {
+		_, err := s.service.ListCampaigns(context.Background(), campaign.ListCampaignsParams{Sort: "popularity"})
+		s.ErrorIs(err, campaign.ErrInvalidListParams)
+	})
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailContactConsent() {
+	open := openCampaign()
+	constituent := map[string]string{
+		campaign.FieldFirstName: " Pat ",
+		campaign.FieldLastName:  "Smith",
+		campaign.FieldEmail:     "pat@example.com",
+	}
+
+	for _, consent := range []bool{true, false} {
+		s.Run(fmt.Sprintf("consent=%t", consent), func() {
+			s.mockRepo.ExpectedCalls = nil
+			s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(open, nil)
+			s.mockSendRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(send *campaign.Send) bool {
+				if !consent {
+					return !send.ContactConsent && send.ConstituentFirstName == "" && send.ConstituentEmail == ""
+				}
+				return send.ContactConsent &&
+					send.ConstituentFirstName == "Pat" &&
+					send.ConstituentLastName == "Smith" &&
+					send.ConstituentEmail == "pat@example.com"
+			})).Return(nil).Once()
+			s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.Anything).Return(nil).Once()
+			s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", mock.Anything, "sendID", mock.Anything).
+				Return().Once()
+
+			dto := s.composeDraft(open, janeDoe, constituent)
+			dto.ContactConsent = consent
+			_, err := s.service.SendCampaignEmail(context.Background(), dto)
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailTranslatedSubject() {
+	bilingual := &campaign.Campaign{
+		BaseModel: shared.BaseModel{ID: uuid.New()},
+		Name:      "Save the Library",
+		Subject:   "Save the library",
+		Status:    campaign.StatusActive,
+		Template:  "<p>Hello</p>",
+		Translations: []campaign.Translation{
+			{Language: campaign.LanguageFrench, Subject: "Sauvez la bibliothèque", Template: "<p>Bonjour</p>"},
+		},
+	}
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: bilingual.ID}).Return(bilingual, nil)
+	s.mockSendRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+		return msg.Subject == "Sauvez la bibliothèque" && msg.Body == "<p>Bonjour</p>"
+	})).Return(nil).Once()
+	s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", mock.Anything, "sendID", mock.Anything).
+		Return().Once()
+
+	dto := s.composeDraft(bilingual, campaign.Representative{
+		Name:  "Marie Tremblay",
+		Email: "marie.tremblay@parl.gc.ca",
+		Extra: campaign.Extra{PreferredLanguages: []string{"French"}},
+	}, nil)
+	s.Equal(campaign.LanguageFrench, dto.Language)
+
+	_, err := s.service.SendCampaignEmail(context.Background(), dto)
+	s.NoError(err)
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailRejectsTamperedDrafts() {
+	open := openCampaign()
+	other := openCampaign()
+
+	tests := []struct {
+		name   string
+		tamper func(dto *campaign.SendCampaignEmailDTO)
+	}{
+		{
+			name: "content changed",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.Content = `<p>Hello</p><p><a href="https://evil.example">Win a prize</a></p>`
+			},
+		},
+		{
+			name: "recipient not from the lookup",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.RepresentativeEmail = "anyone@example.com"
+			},
+		},
+		{
+			name: "postal code changed",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.PostalCode = "M5V2T6"
+			},
+		},
+		{
+			name: "sent to another campaign",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.CampaignID = other.ID
+			},
+		},
+		{
+			name: "constituent details changed",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.ConstituentEmail = "someone.else@example.com"
+			},
+		},
+		{
+			name: "missing token",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.DraftToken = ""
+			},
+		},
+		{
+			name: "forged token",
+			tamper: func(dto *campaign.SendCampaignEmailDTO) {
+				dto.DraftToken += "x"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			dto := s.composeDraft(open, janeDoe, map[string]string{campaign.FieldEmail: "pat@example.com"})
+			tt.tamper(dto)
+			s.mockLogger.EXPECT().Warn("Rejected campaign draft", "campaignID", dto.CampaignID,
+				"recipient", dto.RepresentativeEmail, "error", mock.Anything).Return().Once()
+
+			send, err := s.service.SendCampaignEmail(context.Background(), dto)
+
+			s.ErrorIs(err, campaign.ErrInvalidDraft)
+			s.Nil(send)
+			s.mockSendRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+			s.mockQueue.AssertNotCalled(s.T(), "Enqueue", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailAcceptsBrowserLineEndings() {
+	open := openCampaign()
+	open.Template = "<p>Dear {{.representative.name}},</p>\n<p>Please act.</p>"
+	dto := s.composeDraft(open, janeDoe, nil)
+	dto.Content = strings.ReplaceAll(dto.Content, "\n", "\r\n")
+
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(open, nil)
+	s.mockSendRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+		return msg.Body == "<p>Dear Jane Doe,</p>\n<p>Please act.</p>"
+	})).Return(nil).Once()
+	s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", mock.Anything, "sendID", mock.Anything).
+		Return().Once()
+
+	_, err := s.service.SendCampaignEmail(context.Background(), dto)
+	s.NoError(err)
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailOncePerDraft() {
+	open := openCampaign()
+	dto := s.composeDraft(open, janeDoe, nil)
+	resubmitted := *dto
+
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(open, nil)
+	// The unique index on the draft token ID lets only the first send in
+	used := map[string]bool{}
+	s.mockSendRepo.EXPECT().Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, send *campaign.Send) error {
+			s.Require().NotNil(send.DraftTokenID)
+			if used[*send.DraftTokenID] {
+				return fmt.Errorf("%w: duplicate entry", campaign.ErrDraftUsed)
+			}
+			used[*send.DraftTokenID] = true
+			return nil
+		}).Twice()
+	s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", mock.Anything, "sendID", mock.Anything).
+		Return().Once()
+	s.mockLogger.EXPECT().Warn("Rejected campaign draft", "campaignID", open.ID,
+		"recipient", janeDoe.Email, "error", mock.Anything).Return().Once()
+
+	_, err := s.service.SendCampaignEmail(context.Background(), dto)
+	s.Require().NoError(err)
+
+	send, err := s.service.SendCampaignEmail(context.Background(), &resubmitted)
+	s.ErrorIs(err, campaign.ErrDraftUsed)
+	s.Nil(send)
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailRepliesToConstituent() {
+	tests := []struct {
+		name            string
+		copyConstituent bool
+		userData        map[string]string
+		wantReplyTo     string
+		wantCC          []string
+	}{
+		{
+			name:        "replies go to the constituent",
+			userData:    map[string]string{campaign.FieldEmail: "pat@example.com"},
+			wantReplyTo: "pat@example.com",
+		},
+		{
+			name:            "constituent copied",
+			copyConstituent: true,
+			userData:        map[string]string{campaign.FieldEmail: "pat@example.com"},
+			wantReplyTo:     "pat@example.com",
+			wantCC:          []string{"pat@example.com"},
+		},
+		{
+			name:            "no address to reply to",
+			copyConstituent: true,
+			userData:        map[string]string{campaign.FieldFirstName: "Pat"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			open := openCampaign()
+			open.CopyConstituent = tt.copyConstituent
+			s.mockRepo.ExpectedCalls = nil
+			s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(open, nil)
+			s.mockSendRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+
+			var queued *email.QueuedMessage
+			s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.AnythingOfType("*email.QueuedMessage")).
+				Run(func(_ context.Context, msg *email.QueuedMessage) {
+					queued = msg
+				}).
+				Return(nil).Once()
+			s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", open.ID, "sendID", mock.Anything).
+				Return().Once()
+
+			// Replies reach the constituent whether or not they share their
+			// address with the organizers
+			dto := s.composeDraft(open, janeDoe, tt.userData)
+			_, err := s.service.SendCampaignEmail(context.Background(), dto)
+
+			s.Require().NoError(err)
+			s.Require().NotNil(queued)
+			s.Equal("jane.doe@parl.gc.ca", queued.To)
+			s.Equal(tt.wantReplyTo, queued.ReplyTo)
+			s.Equal(tt.wantCC, queued.CC)
+			s.Empty(queued.BCC)
+		})
+	}
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailRequiresConfirmation() {
+	confirmed := openCampaign()
+	confirmed.RequireConfirmation = true
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: confirmed.ID}).Return(confirmed, nil)
+
+	var held *campaign.Send
+	s.mockSendRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*campaign.Send")).
+		Run(func(_ context.Context, send *campaign.Send) {
+			held = send
+		}).
+		Return(nil).Once()
+	s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+		return msg.To == "pat@example.com" &&
+			msg.Subject == "Please confirm your letter to Jane Doe" &&
+			!msg.IsHTML &&
+			held != nil && held.ConfirmationToken != nil &&
+			strings.Contains(msg.Body, "https://example.com/campaign/confirm/"+*held.ConfirmationToken)
+	})).Return(nil).Once()
+	s.mockLogger.EXPECT().Info("Campaign letters awaiting confirmation", "campaignID", confirmed.ID, "letters", 1).
+		Return().Once()
+
+	// The link goes out even though Pat keeps their address from the organizers
+	dto := s.composeDraft(confirmed, janeDoe, map[string]string{campaign.FieldEmail: "pat@example.com"})
+	send, err := s.service.SendCampaignEmail(context.Background(), dto)
+
+	s.Require().NoError(err)
+	s.Same(held, send)
+	s.Equal(campaign.SendStatusUnconfirmed, send.Status)
+	s.Equal("Test Campaign", send.Subject)
+	s.Equal("<p>Hello</p>", send.Body)
+	s.Equal("pat@example.com", send.ReplyTo)
+	s.Empty(send.ConstituentEmail)
+	s.Require().NotNil(send.ConfirmationExpiresAt)
+	s.WithinDuration(time.Now().Add(48*time.Hour), *send.ConfirmationExpiresAt, time.Minute)
+}
+
+func (s *CampaignServiceTestSuite) TestSendCampaignEmailsConfirmsSubmissionOnce() {
+	confirmed := openCampaign()
+	confirmed.RequireConfirmation = true
+	johnSmith := campaign.Representative{Name: "John Smith", Email: "john.smith@ola.org", ElectedOffice: "MPP"}
+	userData := map[string]string{campaign.FieldEmail: "pat@example.com"}
+	submission := func() []*campaign.SendCampaignEmailDTO {
+		return []*campaign.SendCampaignEmailDTO{
+			s.composeDraft(confirmed, janeDoe, userData),
+			s.composeDraft(confirmed, johnSmith, userData),
+		}
+	}
+
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: confirmed.ID}).Return(confirmed, nil)
+
+	s.Run("letters signed with different addresses", func() {
+		dtos := []*campaign.SendCampaignEmailDTO{
+			s.composeDraft(confirmed, janeDoe, userData),
+			s.composeDraft(confirmed, johnSmith, map[string]string{campaign.FieldEmail: "someone.else@example.com"}),
+		}
+		s.mockLogger.EXPECT().Warn("Rejected campaign submission", "drafts", 2, "error", campaign.ErrMixedSubmission).
+			Return().Once()
+
+		results := s.service.SendCampaignEmails(context.Background(), dtos)
+
+		s.Require().Len(results, 2)
+		for _, result := range results {
+			s.ErrorIs(result.Err, campaign.ErrMixedSubmission)
+			s.Nil(result.Send)
+		}
+		s.mockSendRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.mockQueue.AssertNotCalled(s.T(), "Enqueue", mock.Anything, mock.Anything)
+	})
+
+	s.Run("one link for every letter", func() {
+		var held []*campaign.Send
+		s.mockSendRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*campaign.Send")).
+			Run(func(_ context.Context, send *campaign.Send) {
+				held = append(held, send)
+			}).
+			Return(nil).Twice()
+		s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+			return msg.To == "pat@example.com" &&
+				msg.Subject == "Please confirm your letters to Jane Doe and John Smith" &&
+				strings.Contains(msg.Body, "/campaign/confirm/"+*held[0].ConfirmationToken)
+		})).Return(nil).Once()
+		s.mockLogger.EXPECT().Info("Campaign letters awaiting confirmation", "campaignID", confirmed.ID, "letters", 2).
+			Return().Once()
+
+		results := s.service.SendCampaignEmails(context.Background(), submission())
+
+		s.Require().Len(results, 2)
+		for _, result := range results {
+			s.Require().NoError(result.Err)
+			s.Equal(campaign.SendStatusUnconfirmed, result.Send.Status)
+		}
+		s.Equal(*results[0].Send.ConfirmationToken, *results[1].Send.ConfirmationToken)
+	})
+
+	s.Run("letters fail when the confirmation can't be queued", func() {
+		s.mockSendRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*campaign.Send")).Return(nil).Twice()
+		s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+		s.mockSendRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(send *campaign.Send) bool {
+			return send.Status == campaign.SendStatusFailed && send.Body == ""
+		})).Return(nil).Twice()
+
+		results := s.service.SendCampaignEmails(context.Background(), submission())
+
+		for _, result := range results {
+			s.ErrorIs(result.Err, assert.AnError)
+			s.Equal(campaign.SendStatusFailed, result.Send.Status)
+		}
+	})
+}
+
+func (s *CampaignServiceTestSuite) TestComposeEmailRequiresEmailForConfirmation() {
+	confirmed := openCampaign()
+	confirmed.RequireConfirmation = true
+
+	_, err := s.service.ComposeEmail(context.Background(), campaign.ComposeEmailParams{
+		MP:       janeDoe,
+		Campaign: confirmed,
+		UserData: map[string]string{campaign.FieldFirstName: "Pat"},
+	})
+
+	s.ErrorIs(err, campaign.ErrConfirmationRequired)
+}
+
+func (s *CampaignServiceTestSuite) TestConfirmSend() {
+	open := openCampaign()
+	token := "confirm-token"
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Minute)
+
+	held := func(status campaign.SendStatus, expiresAt time.Time) *campaign.Send {
+		return &campaign.Send{
+			BaseModel:             shared.BaseModel{ID: uuid.New()},
+			CampaignID:            open.ID,
+			RepresentativeName:    "Jane Doe",
+			RepresentativeEmail:   "jane.doe@parl.gc.ca",
+			Status:                status,
+			Subject:               "Test Campaign",
+			Body:                  "<p>Hello</p>",
+			ReplyTo:               "pat@example.com",
+			ConfirmationToken:     &token,
+			ConfirmationExpiresAt: &expiresAt,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		send       *campaign.Send
+		setup      func(send *campaign.Send)
+		wantErr    error
+		wantStatus campaign.SendStatus
+	}{
+		{
+			name: "confirmed and queued",
+			send: held(campaign.SendStatusUnconfirmed, future),
+			setup: func(send *campaign.Send) {
+				s.mockSendRepo.EXPECT().Confirm(mock.Anything, send.ID, mock.AnythingOfType("time.Time")).
+					Return(true, nil).Once()
+				s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+					return msg.To == "jane.doe@parl.gc.ca" &&
+						msg.Subject == "Test Campaign" &&
+						msg.Body == "<p>Hello</p>" &&
+						msg.ReplyTo == "pat@example.com" &&
+						msg.Reference == "campaign_send:"+send.ID.String()
+				})).Return(nil).Once()
+				s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", open.ID, "sendID", send.ID).
+					Return().Once()
+				s.mockLogger.EXPECT().Info("Campaign letter confirmed", "campaignID", open.ID, "sendID", send.ID).
+					Return().Once()
+			},
+			wantStatus: campaign.SendStatusPending,
+		},
+		{
+			name: "link followed twice at once",
+			send: held(campaign.SendStatusUnconfirmed, future),
+			setup: func(send *campaign.Send) {
+				s.mockSendRepo.EXPECT().Confirm(mock.Anything, send.ID, mock.AnythingOfType("time.Time")).
+					Return(false, nil).Once()
+			},
+			wantStatus: campaign.SendStatusPending,
+		},
+		{
+			name:       "already confirmed",
+			send:       held(campaign.SendStatusSent, future),
+			wantStatus: campaign.SendStatusSent,
+		},
+		{
+			name:    "expired by the background job",
+			send:    held(campaign.SendStatusExpired, past),
+			wantErr: campaign.ErrConfirmationExpired,
+		},
+		{
+			name:    "past its deadline",
+			send:    held(campaign.SendStatusUnconfirmed, past),
+			wantErr: campaign.ErrConfirmationExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockRepo.ExpectedCalls = nil
+			s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(open, nil).Maybe()
+			s.mockSendRepo.EXPECT().ListByConfirmationToken(mock.Anything, token).
+				Return([]campaign.Send{*tt.send}, nil).Once()
+			if tt.setup != nil {
+				tt.setup(tt.send)
+			}
+
+			sends, err := s.service.ConfirmSend(context.Background(), token)
+
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				s.Nil(sends)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(sends, 1)
+			s.Equal(tt.wantStatus, sends[0].Status)
+		})
+	}
+
+	s.Run("every letter of the submission", func() {
+		first, second := held(campaign.SendStatusUnconfirmed, future), held(campaign.SendStatusUnconfirmed, future)
+		second.RepresentativeEmail = "john.smith@ola.org"
+		s.mockRepo.ExpectedCalls = nil
+		s.mockRepo.EXPECT().GetByID(mock.Anything, campaign.GetCampaignDTO{ID: open.ID}).Return(open, nil).Once()
+		s.mockSendRepo.EXPECT().ListByConfirmationToken(mock.Anything, token).
+			Return([]campaign.Send{*first, *second}, nil).Once()
+		for _, send := range []*campaign.Send{first, second} {
+			s.mockSendRepo.EXPECT().Confirm(mock.Anything, send.ID, mock.AnythingOfType("time.Time")).
+				Return(true, nil).Once()
+			s.mockQueue.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(msg *email.QueuedMessage) bool {
+				return msg.Reference == "campaign_send:"+send.ID.String()
+			})).Return(nil).Once()
+			s.mockLogger.EXPECT().Info("Campaign email queued", "campaignID", open.ID, "sendID", send.ID).
+				Return().Once()
+			s.mockLogger.EXPECT().Info("Campaign letter confirmed", "campaignID", open.ID, "sendID", send.ID).
+				Return().Once()
+		}
+
+		sends, err := s.service.ConfirmSend(context.Background(), token)
+
+		s.Require().NoError(err)
+		s.Require().Len(sends, 2)
+		for _, send := range sends {
+			s.Equal(campaign.SendStatusPending, send.Status)
+		}
+	})
+
+	s.Run("unknown token", func() {
+		s.mockSendRepo.EXPECT().ListByConfirmationToken(mock.Anything, "nope").
+			Return(nil, campaign.ErrConfirmationNotFound).Once()
+
+		_, err := s.service.ConfirmSend(context.Background(), "nope")
+		s.ErrorIs(err, campaign.ErrConfirmationNotFound)
+	})
+}
+
+func (s *CampaignServiceTestSuite) TestExpireUnconfirmedSends() {
+	now := time.Now()
+	s.mockSendRepo.EXPECT().ExpireUnconfirmed(mock.Anything, now).Return(int64(3), nil).Once()
+	s.mockLogger.EXPECT().Info("Unconfirmed campaign letters expired", "count", int64(3)).Return().Once()
+
+	s.NoError(s.service.ExpireUnconfirmedSends(context.Background(), now))
+}
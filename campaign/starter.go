@@ -0,0 +1,79 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"gorm.io/gorm"
+)
+
+// StarterTemplate is a curated campaign that users can start a new campaign
+// from. The library is seeded by migrations.
+type StarterTemplate struct {
+	shared.BaseModel
+	Slug        string   `gorm:"type:varchar(100);not null;uniqueIndex" json:"slug"`
+	Name        string   `gorm:"type:varchar(255);not null" json:"name"`
+	Description string   `gorm:"type:text;not null" json:"description"`
+	Template    string   `gorm:"type:text;not null" json:"template"`
+	Targets     []Target `gorm:"type:json;serializer:json" json:"targets"`
+	// Position orders the library; lower comes first
+	Position int `gorm:"not null;default:0" json:"position"`
+}
+
+// BeforeCreate assigns an ID so the record can be referenced after insert
+func (t *StarterTemplate) BeforeCreate(_ *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ListStarterTemplates retrieves the starter template library in display order
+func (s *Service) ListStarterTemplates(ctx context.Context) ([]StarterTemplate, error) {
+	starters, err := s.starterRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list starter templates: %w", err)
+	}
+	sort.SliceStable(starters, func(i, j int) bool {
+		if starters[i].Position != starters[j].Position {
+			return starters[i].Position < starters[j].Position
+		}
+		return starters[i].Name < starters[j].Name
+	})
+	return starters, nil
+}
+
+// GetStarterTemplate retrieves a starter template by ID
+func (s *Service) GetStarterTemplate(ctx context.Context, id uuid.UUID) (*StarterTemplate, error) {
+	return s.starterRepo.GetByID(ctx, id)
+}
+
+// CreateFromStarter creates a draft campaign from a starter template
+func (s *Service) CreateFromStarter(ctx context.Context, dto *CreateFromStarterDTO) (*Campaign, error) {
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	starter, err := s.starterRepo.GetByID(ctx, dto.StarterID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := dto.Name
+	if name == "" {
+		name = starter.Name
+	}
+
+	return s.CreateCampaign(ctx, &CreateCampaignDTO{
+		Name:           name,
+		Description:    starter.Description,
+		Template:       starter.Template,
+		Targets:        append([]Target(nil), starter.Targets...),
+		OwnerID:        dto.OwnerID,
+		OrganizationID: dto.OrganizationID,
+		Status:         StatusDraft,
+	})
+}
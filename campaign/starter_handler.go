@@ -0,0 +1,32 @@
+package campaign
+
+import (
+	"net/http"
+
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/labstack/echo/v4"
+)
+
+// StartersGET handles GET requests for the starter template library. Each
+// template links to the creation form, prefilled with its content.
+func (h *Handler) StartersGET(c echo.Context) error {
+	h.Logger.Debug("Handling StartersGET request")
+
+	starters, err := h.service.ListStarterTemplates(c.Request().Context())
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return c.Render(http.StatusOK, "campaign_starters", shared.Data{
+		Title:           "Starter Templates",
+		PageName:        "campaign_starters",
+		IsAuthenticated: true,
+		Content: map[string]interface{}{
+			"Starters": starters,
+			// Carried through so a starter opened from an organization
+			// dashboard lands in that organization
+			"OrganizationID": c.QueryParam("organization_id"),
+		},
+	})
+}
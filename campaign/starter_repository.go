@@ -0,0 +1,54 @@
+package campaign
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/database"
+	"gorm.io/gorm"
+)
+
+// StarterTemplateRepositoryInterface defines the contract for reading the
+// starter template library
+type StarterTemplateRepositoryInterface interface {
+	List(ctx context.Context) ([]StarterTemplate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*StarterTemplate, error)
+}
+
+// StarterTemplateRepository implements StarterTemplateRepositoryInterface
+type StarterTemplateRepository struct {
+	db database.Database
+}
+
+// NewStarterTemplateRepository creates a new instance of StarterTemplateRepository
+func NewStarterTemplateRepository(params RepositoryParams) StarterTemplateRepositoryInterface {
+	return &StarterTemplateRepository{db: params.DB}
+}
+
+// List retrieves every starter template
+func (r *StarterTemplateRepository) List(ctx context.Context) ([]StarterTemplate, error) {
+	var starters []StarterTemplate
+	err := r.db.FindAll(ctx, &starters, "1=1")
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error listing starter templates: %w", err)
+	}
+	if len(starters) == 0 {
+		return []StarterTemplate{}, nil
+	}
+	return starters, nil
+}
+
+// GetByID retrieves a starter template by its ID
+func (r *StarterTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*StarterTemplate, error) {
+	var starter StarterTemplate
+	if err := r.db.FindOne(ctx, &starter, "id = ?", id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStarterTemplateNotFound
+		}
+		return nil, fmt.Errorf("error retrieving starter template: %w", err)
+	}
+	return &starter, nil
+}
@@ -0,0 +1,70 @@
+package campaign_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *CampaignServiceTestSuite) TestListStarterTemplates() {
+	s.mockStarters.EXPECT().List(mock.Anything).Return([]campaign.StarterTemplate{
+		{Name: "Transit", Position: 30},
+		{Name: "Parks", Position: 40},
+		{Name: "Housing", Position: 20},
+		{Name: "Arts", Position: 20},
+	}, nil).Once()
+
+	starters, err := s.service.ListStarterTemplates(context.Background())
+
+	s.Require().NoError(err)
+	names := make([]string, len(starters))
+	for i, starter := range starters {
+		names[i] = starter.Name
+	}
+	s.Equal([]string{"Arts", "Housing", "Transit", "Parks"}, names)
+}
+
+func (s *CampaignServiceTestSuite) TestCreateFromStarter() {
+	userID := uuid.New()
+	starter := &campaign.StarterTemplate{
+		BaseModel:   shared.BaseModel{ID: uuid.New()},
+		Name:        "Fund public transit",
+		Description: "Ask for frequent transit",
+		Template:    "Dear {{.representative.name}}, I ride transit in {{.city}}.",
+		Targets:     []campaign.Target{campaign.TargetProvincial, campaign.TargetMayor},
+	}
+
+	s.Run("creates a draft with the starter's content", func() {
+		s.mockStarters.EXPECT().GetByID(mock.Anything, starter.ID).Return(starter, nil).Once()
+		s.mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto *campaign.CreateCampaignDTO) bool {
+			return dto.Name == starter.Name &&
+				dto.Description == starter.Description &&
+				dto.Template == starter.Template &&
+				s.Equal(starter.Targets, dto.Targets) &&
+				s.Equal([]string{"city", "representative.name"}, dto.Tokens) &&
+				dto.OwnerID == userID &&
+				dto.Status == campaign.StatusDraft
+		})).Return(&campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}}, nil).Once()
+		s.mockLogger.EXPECT().Info("Campaign created successfully", "id", mock.AnythingOfType("uuid.UUID")).Once()
+
+		_, err := s.service.CreateFromStarter(context.Background(), &campaign.CreateFromStarterDTO{
+			StarterID: starter.ID,
+			OwnerID:   userID,
+		})
+		s.NoError(err)
+	})
+
+	s.Run("unknown starter", func() {
+		missing := uuid.New()
+		s.mockStarters.EXPECT().GetByID(mock.Anything, missing).Return(nil, campaign.ErrStarterTemplateNotFound).Once()
+
+		_, err := s.service.CreateFromStarter(context.Background(), &campaign.CreateFromStarterDTO{
+			StarterID: missing,
+			OwnerID:   userID,
+		})
+		s.ErrorIs(err, campaign.ErrStarterTemplateNotFound)
+	})
+}
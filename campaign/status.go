@@ -0,0 +1,68 @@
+package campaign
+
+import "time"
+
+// Status is the lifecycle state of a campaign
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusScheduled Status = "scheduled"
+	StatusActive    Status = "active"
+	StatusPaused    Status = "paused"
+	StatusClosed    Status = "closed"
+	StatusArchived  Status = "archived"
+)
+
+// statusTransitions lists the states each state may move to
+//
+//nolint:gochecknoglobals
+var statusTransitions = map[Status][]Status{
+	StatusDraft:     {StatusScheduled, StatusActive, StatusArchived},
+	StatusScheduled: {StatusDraft, StatusActive, StatusPaused, StatusArchived},
+	StatusActive:    {StatusPaused, StatusClosed},
+	StatusPaused:    {StatusActive, StatusClosed, StatusArchived},
+	StatusClosed:    {StatusArchived},
+	StatusArchived:  {},
+}
+
+// allStatuses lists every state in lifecycle order
+//
+//nolint:gochecknoglobals
+var allStatuses = []Status{
+	StatusDraft, StatusScheduled, StatusActive, StatusPaused, StatusClosed, StatusArchived,
+}
+
+// creatableStatuses are the states a campaign may be created in
+//
+//nolint:gochecknoglobals
+var creatableStatuses = []Status{StatusDraft, StatusScheduled, StatusActive}
+
+// Transitions returns the states a campaign in this state may move to
+func (s Status) Transitions() []Status {
+	return statusTransitions[s]
+}
+
+// CanTransitionTo reports whether moving from s to next is allowed
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpen reports whether the campaign is accepting letters at the given time
+func (c *Campaign) IsOpen(now time.Time) bool {
+	if c.Status != StatusActive {
+		return false
+	}
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return false
+	}
+	if c.EndsAt != nil && !now.Before(*c.EndsAt) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package campaign_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCampaignIsOpen(t *testing.T) {
+	now := time.Date(2024, 12, 9, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		campaign campaign.Campaign
+		want     bool
+	}{
+		{"active without a window", campaign.Campaign{Status: campaign.StatusActive}, true},
+		{"active inside the window", campaign.Campaign{Status: campaign.StatusActive, StartsAt: &before, EndsAt: &after}, true},
+		{"active before it opens", campaign.Campaign{Status: campaign.StatusActive, StartsAt: &after}, false},
+		{"active after it closes", campaign.Campaign{Status: campaign.StatusActive, EndsAt: &before}, false},
+		{"closes exactly now", campaign.Campaign{Status: campaign.StatusActive, EndsAt: &now}, false},
+		{"draft", campaign.Campaign{Status: campaign.StatusDraft}, false},
+		{"scheduled", campaign.Campaign{Status: campaign.StatusScheduled, StartsAt: &before}, false},
+		{"paused", campaign.Campaign{Status: campaign.StatusPaused}, false},
+		{"closed", campaign.Campaign{Status: campaign.StatusClosed}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.campaign.IsOpen(now))
+		})
+	}
+}
+
+func TestStatusCanTransitionTo(t *testing.T) {
+	assert.True(t, campaign.StatusDraft.CanTransitionTo(campaign.StatusScheduled))
+	assert.True(t, campaign.StatusActive.CanTransitionTo(campaign.StatusPaused))
+	assert.True(t, campaign.StatusPaused.CanTransitionTo(campaign.StatusActive))
+	assert.True(t, campaign.StatusClosed.CanTransitionTo(campaign.StatusArchived))
+
+	assert.False(t, campaign.StatusActive.CanTransitionTo(campaign.StatusDraft))
+	assert.False(t, campaign.StatusClosed.CanTransitionTo(campaign.StatusActive))
+	assert.False(t, campaign.StatusArchived.CanTransitionTo(campaign.StatusDraft))
+	assert.Empty(t, campaign.StatusArchived.Transitions())
+}
@@ -0,0 +1,135 @@
+package campaign
+
+import "strings"
+
+// Target identifies a group of representatives a campaign writes to
+type Target string
+
+const (
+	TargetMP         Target = "MP"
+	TargetProvincial Target = "provincial"
+	TargetMayor      Target = "Mayor"
+	TargetCouncillor Target = "Councillor"
+)
+
+// AllTargets lists the supported targets in display order
+//
+//nolint:gochecknoglobals
+var AllTargets = []Target{TargetMP, TargetProvincial, TargetMayor, TargetCouncillor}
+
+// DefaultTargets is used for campaigns that don't choose their targets
+//
+//nolint:gochecknoglobals
+var DefaultTargets = []Target{TargetMP}
+
+// provincialOffices are the elected office names used by provincial legislatures
+//
+//nolint:gochecknoglobals
+var provincialOffices = []string{"MLA", "MPP", "MNA", "MHA"}
+
+// Label returns a human-readable name for the target
+func (t Target) Label() string {
+	switch t {
+	case TargetMP:
+		return "Member of Parliament"
+	case TargetProvincial:
+		return "Provincial representative (MLA/MPP/MNA/MHA)"
+	case TargetMayor:
+		return "Mayor"
+	case TargetCouncillor:
+		return "City councillor"
+	default:
+		return string(t)
+	}
+}
+
+// Matches reports whether the representative holds an office covered by the target
+func (t Target) Matches(rep Representative) bool {
+	switch t {
+	case TargetProvincial:
+		for _, office := range provincialOffices {
+			if strings.EqualFold(rep.ElectedOffice, office) {
+				return true
+			}
+		}
+		return false
+	default:
+		return strings.EqualFold(rep.ElectedOffice, string(t))
+	}
+}
+
+// NormalizeTargets removes duplicates and falls back to DefaultTargets when empty
+func NormalizeTargets(targets []Target) []Target {
+	seen := make(map[Target]bool, len(targets))
+	normalized := make([]Target, 0, len(targets))
+	for _, t := range targets {
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		normalized = append(normalized, t)
+	}
+	if len(normalized) == 0 {
+		return append([]Target(nil), DefaultTargets...)
+	}
+	return normalized
+}
+
+// ParseTargets converts raw form values into targets
+func ParseTargets(values []string) []Target {
+	targets := make([]Target, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			targets = append(targets, Target(v))
+		}
+	}
+	return targets
+}
+
+// SelectRecipients returns the representatives a campaign should write to:
+// those matching one of its targets and reachable by email, each at most once
+func SelectRecipients(campaign *Campaign, representatives []Representative) []Representative {
+	targets := NormalizeTargets(campaign.Targets)
+	seen := make(map[string]bool)
+	recipients := make([]Representative, 0)
+
+	for _, rep := range representatives {
+		if rep.Email == "" {
+			continue
+		}
+		key := strings.ToLower(rep.Email)
+		if seen[key] {
+			continue
+		}
+		for _, t := range targets {
+			if t.Matches(rep) {
+				seen[key] = true
+				recipients = append(recipients, rep)
+				break
+			}
+		}
+	}
+
+	return recipients
+}
+
+// HasTarget reports whether the campaign writes to the given target
+func (c *Campaign) HasTarget(t Target) bool {
+	for _, target := range NormalizeTargets(c.Targets) {
+		if target == t {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTarget reports whether the submitted or prefilled form writes to the
+// given target
+func (p *CreateCampaignParams) HasTarget(t Target) bool {
+	for _, target := range NormalizeTargets(p.Targets) {
+		if target == t {
+			return true
+		}
+	}
+	return false
+}
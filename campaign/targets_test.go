@@ -0,0 +1,62 @@
+package campaign_test
+
+import (
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectRecipients(t *testing.T) {
+	reps := []campaign.Representative{
+		{Name: "Federal", ElectedOffice: "MP", Email: "mp@parl.gc.ca"},
+		{Name: "Ontario", ElectedOffice: "MPP", Email: "mpp@ola.org"},
+		{Name: "Quebec", ElectedOffice: "MNA", Email: "mna@assnat.qc.ca"},
+		{Name: "Mayor", ElectedOffice: "Mayor", Email: "mayor@city.ca"},
+		{Name: "Councillor", ElectedOffice: "Councillor", Email: "ward1@city.ca"},
+		{Name: "No Email", ElectedOffice: "Councillor"},
+		{Name: "Duplicate", ElectedOffice: "Councillor", Email: "WARD1@city.ca"},
+	}
+
+	tests := []struct {
+		name    string
+		targets []campaign.Target
+		want    []string
+	}{
+		{
+			name:    "defaults to the MP",
+			targets: nil,
+			want:    []string{"Federal"},
+		},
+		{
+			name:    "provincial matches every provincial office name",
+			targets: []campaign.Target{campaign.TargetProvincial},
+			want:    []string{"Ontario", "Quebec"},
+		},
+		{
+			name:    "multiple targets keep lookup order and skip unreachable or duplicate reps",
+			targets: []campaign.Target{campaign.TargetCouncillor, campaign.TargetMP, campaign.TargetMayor},
+			want:    []string{"Federal", "Mayor", "Councillor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := campaign.SelectRecipients(&campaign.Campaign{Targets: tt.targets}, reps)
+
+			names := make([]string, len(got))
+			for i, rep := range got {
+				names[i] = rep.Name
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestNormalizeTargets(t *testing.T) {
+	assert.Equal(t, campaign.DefaultTargets, campaign.NormalizeTargets(nil))
+	assert.Equal(t,
+		[]campaign.Target{campaign.TargetMayor, campaign.TargetMP},
+		campaign.NormalizeTargets([]campaign.Target{campaign.TargetMayor, "", campaign.TargetMP, campaign.TargetMayor}),
+	)
+}
@@ -2,6 +2,7 @@ package campaign
 
 import (
 	"html/template"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -18,18 +19,44 @@ type TemplateData struct {
 
 // CreateCampaignParams defines the parameters for creating a campaign
 type CreateCampaignParams struct {
-	Name        string    `form:"name"`
-	Description string    `form:"description"`
-	Template    string    `form:"template"`
-	OwnerID     uuid.UUID `param:"owner_id"`
+	Name           string `form:"name"`
+	Description    string `form:"description"`
+	Subject        string `form:"subject"`
+	Language       string `form:"language"`
+	Template       string `form:"template"`
+	Translations   []Translation
+	Targets        []Target  `form:"targets"`
+	Tags           []string  `form:"tags"`
+	OwnerID        uuid.UUID `param:"owner_id"`
+	OrganizationID *uuid.UUID
+	CustomFields   []CustomField
+	// RequireConfirmation and CopyConstituent are the "require_confirmation"
+	// and "copy_constituent" checkboxes
+	RequireConfirmation bool
+	CopyConstituent     bool
+	Status              Status `form:"status"`
+	StartsAt            *time.Time
+	EndsAt              *time.Time
 }
 
 // EditParams defines the parameters for editing a campaign
 type EditParams struct {
-	ID          uuid.UUID `param:"id"`
-	Name        string    `param:"name"`
-	Description string    `param:"description"`
-	Template    string    `param:"template"`
+	ID           uuid.UUID `param:"id"`
+	Name         string    `param:"name"`
+	Description  string    `param:"description"`
+	Subject      string    `param:"subject"`
+	Language     string    `param:"language"`
+	Template     string    `param:"template"`
+	Translations []Translation
+	Targets      []Target `param:"targets"`
+	Tags         []string `param:"tags"`
+	CustomFields []CustomField
+	// RequireConfirmation and CopyConstituent are the "require_confirmation"
+	// and "copy_constituent" checkboxes
+	RequireConfirmation bool
+	CopyConstituent     bool
+	StartsAt            *time.Time
+	EndsAt              *time.Time
 }
 
 // SendCampaignParams defines the parameters for sending a campaign
@@ -42,3 +69,61 @@ type SendCampaignParams struct {
 type GetCampaignParams struct {
 	ID uuid.UUID `param:"id"`
 }
+
+// EmailDraft is a composed campaign email for a single representative
+type EmailDraft struct {
+	Representative Representative
+	// Language is the language the campaign's letter was written in for them
+	Language string
+	Subject  string
+	Content  template.HTML
+	// DraftToken vouches for the draft when the preview form is submitted
+	DraftToken string
+}
+
+// LanguageName returns the display name of the draft's language
+func (d EmailDraft) LanguageName() string {
+	return LanguageName(d.Language)
+}
+
+// SendResult is the outcome of sending one of a submission's drafts. Send
+// may be set alongside Err when the letter was recorded but couldn't be
+// queued.
+type SendResult struct {
+	Send *Send
+	Err  error
+}
+
+// SendStats counts a campaign's sends by delivery status
+type SendStats struct {
+	Pending int64
+	Sent    int64
+	Failed  int64
+}
+
+// Total returns the number of sends across every status
+func (s SendStats) Total() int64 {
+	return s.Pending + s.Sent + s.Failed
+}
+
+// Add returns the sum of two sets of counts
+func (s SendStats) Add(other SendStats) SendStats {
+	return SendStats{
+		Pending: s.Pending + other.Pending,
+		Sent:    s.Sent + other.Sent,
+		Failed:  s.Failed + other.Failed,
+	}
+}
+
+// CampaignSummary is a campaign with its send counts
+type CampaignSummary struct {
+	Campaign Campaign
+	Stats    SendStats
+}
+
+// OrganizationDashboard lists an organization's campaigns with their send counts
+type OrganizationDashboard struct {
+	OrganizationID uuid.UUID
+	Campaigns      []CampaignSummary
+	Totals         SendStats
+}
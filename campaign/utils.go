@@ -3,22 +3,20 @@ package campaign
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
 // extractUserData extracts user data from the context
 func extractUserData(c echo.Context) map[string]string {
-	return map[string]string{
-		"First Name":    c.FormValue("first_name"),
-		"Last Name":     c.FormValue("last_name"),
-		"Address 1":     c.FormValue("address_1"),
-		"City":          c.FormValue("city"),
-		"Province":      c.FormValue("province"),
-		"Postal Code":   c.FormValue("postal_code"),
-		"Email Address": c.FormValue("email"),
+	data := make(map[string]string, len(ConstituentFields))
+	for _, field := range ConstituentFields {
+		data[field] = c.FormValue(field)
 	}
+	return data
 }
 
 // validatePostalCode validates the postal code
@@ -43,3 +41,177 @@ func extractAndValidatePostalCode(c echo.Context) (string, error) {
 	}
 	return validatedPostalCode, nil
 }
+
+// formTargets reads the repeated "targets" form field
+func formTargets(c echo.Context) []Target {
+	form, err := c.FormParams()
+	if err != nil {
+		return nil
+	}
+	return ParseTargets(form["targets"])
+}
+
+// formTags reads the comma-separated "tags" form field
+func formTags(c echo.Context) []string {
+	return NormalizeTags(strings.Split(c.FormValue("tags"), ","))
+}
+
+// blankCustomFieldRows is how many empty rows the custom field editor offers
+const blankCustomFieldRows = 3
+
+// formCustomFields reads the custom field editor's index-aligned repeated
+// fields. Rows without a key or label are skipped, and a missing key is
+// derived from the label.
+func formCustomFields(c echo.Context) ([]CustomField, error) {
+	form, err := c.FormParams()
+	if err != nil {
+		return nil, nil
+	}
+
+	labels := form["custom_label"]
+	var fields []CustomField
+	for i := range labels {
+		f := CustomField{
+			Key:      strings.TrimSpace(valueAt(form["custom_key"], i)),
+			Label:    strings.TrimSpace(labels[i]),
+			Type:     FieldType(valueAt(form["custom_type"], i)),
+			Required: valueAt(form["custom_required"], i) == "required",
+			Pattern:  strings.TrimSpace(valueAt(form["custom_pattern"], i)),
+		}
+		if f.Key == "" && f.Label == "" {
+			continue
+		}
+		if f.Key == "" {
+			f.Key = fieldKey(f.Label)
+		}
+		if f.Type == FieldTypeSelect {
+			for _, option := range strings.Split(valueAt(form["custom_options"], i), "\n") {
+				if option = strings.TrimSpace(option); option != "" {
+					f.Options = append(f.Options, option)
+				}
+			}
+		}
+		if f.MinLength, err = formInt(valueAt(form["custom_min_length"], i)); err != nil {
+			return fields, fmt.Errorf("%w: %s: minimum length must be a number", ErrInvalidCustomFields, f.Label)
+		}
+		if f.MaxLength, err = formInt(valueAt(form["custom_max_length"], i)); err != nil {
+			return fields, fmt.Errorf("%w: %s: maximum length must be a number", ErrInvalidCustomFields, f.Label)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// formInt parses an optional whole number
+func formInt(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// nonKeyChars are the runs of characters replaced when deriving a field key
+var nonKeyChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// fieldKey derives a template-friendly key from a field label, e.g.
+// "Are you a nurse?" becomes "are_you_a_nurse"
+func fieldKey(label string) string {
+	key := strings.Trim(nonKeyChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if key == "" || key[0] < 'a' || key[0] > 'z' {
+		key = strings.TrimRight("field_"+key, "_")
+	}
+	if len(key) > 40 {
+		key = strings.TrimRight(key[:40], "_")
+	}
+	return key
+}
+
+// customFieldRows returns the rows of the custom field editor: the existing
+// fields followed by blank rows for new ones
+func customFieldRows(fields []CustomField) []CustomField {
+	rows := append([]CustomField(nil), fields...)
+	return append(rows, make([]CustomField, blankCustomFieldRows)...)
+}
+
+// extractFieldInput reads the send form's answers to the custom fields,
+// keyed by input name
+func extractFieldInput(c echo.Context, fields []CustomField) map[string]string {
+	input := make(map[string]string, len(fields))
+	for _, f := range fields {
+		input[f.InputName()] = c.FormValue(f.InputName())
+	}
+	return input
+}
+
+// formTranslations reads the translation editor. Languages left blank are
+// skipped; a subject without a template is kept so validation reports it.
+func formTranslations(c echo.Context) []Translation {
+	var translations []Translation
+	for _, language := range Languages {
+		t := Translation{
+			Language: language,
+			Subject:  strings.TrimSpace(c.FormValue("translation_subject_" + language)),
+			Template: strings.TrimSpace(c.FormValue("translation_template_" + language)),
+		}
+		if t.Subject != "" || t.Template != "" {
+			translations = append(translations, t)
+		}
+	}
+	return translations
+}
+
+// translationRows returns a row of the translation editor for every
+// language other than the campaign's own, filled in from its translations
+func translationRows(language string, translations []Translation) []Translation {
+	if language == "" {
+		language = DefaultLanguage
+	}
+	rows := make([]Translation, 0, len(Languages))
+	for _, code := range Languages {
+		row := Translation{Language: code}
+		for _, t := range translations {
+			if t.Language == code {
+				row = t
+			}
+		}
+		if code != language || row.Template != "" {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// scheduleLayout is the format submitted by datetime-local inputs
+const scheduleLayout = "2006-01-02T15:04"
+
+// formTime reads an optional datetime-local form field in server local time
+func formTime(c echo.Context, name string) (*time.Time, error) {
+	value := strings.TrimSpace(c.FormValue(name))
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.ParseInLocation(scheduleLayout, value, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// valueAt returns values[i], or an empty string when the field was not submitted
+func valueAt(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
+
+// requiredFieldLabels returns the form labels of the fields a campaign's letter uses
+func requiredFieldLabels(campaign *Campaign) []string {
+	fields := campaign.RequiredFields()
+	labels := make([]string, len(fields))
+	for i, field := range fields {
+		labels[i] = FieldLabel(field)
+	}
+	return labels
+}
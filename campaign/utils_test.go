@@ -27,13 +27,13 @@ func TestExtractUserData(t *testing.T) {
 	}
 
 	expected := map[string]string{
-		"First Name":    "John",
-		"Last Name":     "Doe",
-		"Address 1":     "123 Main St",
-		"City":          "Anytown",
-		"Province":      "ON",
-		"Postal Code":   "A1A1A1",
-		"Email Address": "john.doe@example.com",
+		"first_name":  "John",
+		"last_name":   "Doe",
+		"address_1":   "123 Main St",
+		"city":        "Anytown",
+		"province":    "ON",
+		"postal_code": "A1A1A1",
+		"email":       "john.doe@example.com",
 	}
 
 	result := extractUserData(c)
@@ -82,3 +82,34 @@ func TestExtractAndValidatePostalCode(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "", result)
 }
+
+func TestFormCustomFields(t *testing.T) {
+	e := echo.New()
+	form := url.Values{
+		"custom_key":        {"", "", ""},
+		"custom_label":      {"Are you a nurse?", "Region", ""},
+		"custom_type":       {"checkbox", "select", "text"},
+		"custom_required":   {"required", "optional", "optional"},
+		"custom_options":    {"", "North\r\n\r\n South \n", ""},
+		"custom_min_length": {"", "", ""},
+		"custom_max_length": {"", "", ""},
+		"custom_pattern":    {"", "", ""},
+	}
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(form.Encode()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	fields, err := formCustomFields(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []CustomField{
+		{Key: "are_you_a_nurse", Label: "Are you a nurse?", Type: FieldTypeCheckbox, Required: true},
+		{Key: "region", Label: "Region", Type: FieldTypeSelect, Options: []string{"North", "South"}},
+	}, fields)
+}
+
+func TestFieldKey(t *testing.T) {
+	assert.Equal(t, "are_you_a_nurse", fieldKey("Are you a nurse?"))
+	assert.Equal(t, "field_2nd_choice", fieldKey("2nd choice"))
+	assert.Equal(t, "field", fieldKey("???"))
+}
@@ -0,0 +1,254 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/user"
+)
+
+const campaignsUsage = `Usage:
+  mp-emailer campaigns export [-owner username | -organization id | -id id ...] [-format json|yaml] [-o file]
+  mp-emailer campaigns import -owner username [-organization id] [-format json|yaml] [file]
+`
+
+// exportOptions selects the campaigns to export and how to write them
+type exportOptions struct {
+	Owner          string
+	OrganizationID string
+	IDs            []string
+	Format         string
+}
+
+// importOptions says who owns the imported campaigns and how to read them
+type importOptions struct {
+	Owner          string
+	OrganizationID string
+	Format         string
+}
+
+// stringList is a flag that may be repeated
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func runCampaigns(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, campaignsUsage)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "export":
+		return runExport(args[1:], stdout, stderr)
+	case "import":
+		return runImport(args[1:], stdin, stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "unknown campaigns command %q\n\n%s", args[0], campaignsUsage)
+		return exitUsage
+	}
+}
+
+func runExport(args []string, stdout, stderr io.Writer) int {
+	var opts exportOptions
+	var output string
+	fs := flag.NewFlagSet("campaigns export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.Owner, "owner", "", "export the campaigns owned by this username")
+	fs.StringVar(&opts.OrganizationID, "organization", "", "export the campaigns of this organization")
+	fs.Var((*stringList)(&opts.IDs), "id", "export this campaign (repeatable)")
+	fs.StringVar(&opts.Format, "format", "", "bundle format, json or yaml (default: from -o, else json)")
+	fs.StringVar(&output, "o", "-", "write the bundle to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "unexpected argument %q\n", fs.Arg(0))
+		return exitUsage
+	}
+	if opts.Format == "" {
+		opts.Format = campaign.BundleFormatForPath(output)
+	}
+
+	svc, err := loadServices()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	w := stdout
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := exportCampaigns(context.Background(), svc, opts, w); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	return exitOK
+}
+
+func runImport(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var opts importOptions
+	fs := flag.NewFlagSet("campaigns import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.Owner, "owner", "", "username that will own the imported campaigns (required)")
+	fs.StringVar(&opts.OrganizationID, "organization", "", "add the imported campaigns to this organization")
+	fs.StringVar(&opts.Format, "format", "", "bundle format, json or yaml (default: from the file name, else json)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if opts.Owner == "" {
+		fmt.Fprintln(stderr, "-owner is required")
+		return exitUsage
+	}
+	if fs.NArg() > 1 {
+		fmt.Fprintf(stderr, "unexpected argument %q\n", fs.Arg(1))
+		return exitUsage
+	}
+
+	input := fs.Arg(0)
+	if opts.Format == "" {
+		opts.Format = campaign.BundleFormatForPath(input)
+	}
+
+	r := stdin
+	if input != "" && input != "-" {
+		f, err := os.Open(input)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+		defer f.Close()
+		r = f
+	}
+
+	svc, err := loadServices()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	if err := importCampaigns(context.Background(), svc, opts, r, stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	return exitOK
+}
+
+// exportCampaigns writes the selected campaigns to w as a bundle. With no
+// selection every campaign is exported.
+func exportCampaigns(ctx context.Context, svc *services, opts exportOptions, w io.Writer) error {
+	selections := 0
+	for _, set := range []bool{opts.Owner != "", opts.OrganizationID != "", len(opts.IDs) > 0} {
+		if set {
+			selections++
+		}
+	}
+	if selections > 1 {
+		return errors.New("use only one of -owner, -organization and -id")
+	}
+
+	var campaigns []campaign.Campaign
+	switch {
+	case len(opts.IDs) > 0:
+		for _, raw := range opts.IDs {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return fmt.Errorf("invalid campaign ID %q", raw)
+			}
+			c, err := svc.Campaigns.GetCampaignByID(ctx, campaign.GetCampaignParams{ID: id})
+			if err != nil {
+				return fmt.Errorf("campaign %s: %w", id, err)
+			}
+			campaigns = append(campaigns, *c)
+		}
+	case opts.OrganizationID != "":
+		orgID, err := uuid.Parse(opts.OrganizationID)
+		if err != nil {
+			return fmt.Errorf("invalid organization ID %q", opts.OrganizationID)
+		}
+		if campaigns, err = svc.Campaigns.GetCampaignsByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	case opts.Owner != "":
+		owner, err := lookupUser(ctx, svc, opts.Owner)
+		if err != nil {
+			return err
+		}
+		if campaigns, err = svc.Campaigns.GetCampaignsByOwner(ctx, owner.ID); err != nil {
+			return err
+		}
+	default:
+		var err error
+		if campaigns, err = svc.Campaigns.GetCampaigns(ctx); err != nil {
+			return err
+		}
+	}
+
+	return campaign.EncodeBundle(w, campaign.NewBundle(campaigns, time.Now()), opts.Format)
+}
+
+// importCampaigns reads a bundle from r, creates its campaigns and reports
+// each new ID to w
+func importCampaigns(ctx context.Context, svc *services, opts importOptions, r io.Reader, w io.Writer) error {
+	bundle, err := campaign.DecodeBundle(r, opts.Format)
+	if err != nil {
+		return err
+	}
+
+	owner, err := lookupUser(ctx, svc, opts.Owner)
+	if err != nil {
+		return err
+	}
+
+	dto := &campaign.ImportCampaignsDTO{
+		Bundle:  bundle,
+		OwnerID: owner.ID,
+	}
+	if opts.OrganizationID != "" {
+		orgID, err := uuid.Parse(opts.OrganizationID)
+		if err != nil {
+			return fmt.Errorf("invalid organization ID %q", opts.OrganizationID)
+		}
+		dto.OrganizationID = &orgID
+	}
+
+	result, err := svc.Campaigns.ImportCampaigns(ctx, dto)
+	if err != nil {
+		return err
+	}
+
+	for _, source := range bundle.Campaigns {
+		fmt.Fprintf(w, "%s -> %s  %s\n", source.ID, result.IDMap[source.ID], source.Name)
+	}
+	fmt.Fprintf(w, "Imported %d campaign(s) for %s\n", len(result.Campaigns), owner.Username)
+	return nil
+}
+
+func lookupUser(ctx context.Context, svc *services, username string) (*user.DTO, error) {
+	owner, err := svc.Users.GetUser(ctx, &user.GetDTO{Username: username})
+	if err != nil {
+		return nil, fmt.Errorf("user %q: %w", username, err)
+	}
+	return owner, nil
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/campaign"
+	mocksCampaign "github.com/jonesrussell/mp-emailer/mocks/campaign"
+	mocksUser "github.com/jonesrussell/mp-emailer/mocks/user"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/jonesrussell/mp-emailer/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Usage(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "no command", args: nil, want: exitUsage},
+		{name: "help", args: []string{"help"}, want: exitOK},
+		{name: "unknown command", args: []string{"serve"}, want: exitUsage},
+		{name: "unknown campaigns command", args: []string{"campaigns", "delete"}, want: exitUsage},
+		{name: "import without owner", args: []string{"campaigns", "import", "bundle.json"}, want: exitUsage},
+		{name: "bad flag", args: []string{"campaigns", "export", "-bogus"}, want: exitUsage},
+		{name: "unknown representatives command", args: []string{"representatives", "export"}, want: exitUsage},
+		{name: "dataset import without files", args: []string{"representatives", "import"}, want: exitUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			assert.Equal(t, tt.want, Run(tt.args, strings.NewReader(""), &stdout, &stderr))
+		})
+	}
+}
+
+func TestExportImportCampaigns(t *testing.T) {
+	campaigns := mocksCampaign.NewMockServiceInterface(t)
+	users := mocksUser.NewMockServiceInterface(t)
+	svc := &services{Campaigns: campaigns, Users: users}
+	ctx := context.Background()
+
+	alice := &user.DTO{ID: uuid.New(), Username: "alice"}
+	bob := &user.DTO{ID: uuid.New(), Username: "bob"}
+	source := campaign.Campaign{
+		BaseModel: shared.BaseModel{ID: uuid.New()},
+		Name:      "Save the Library",
+		Template:  "Dear {{.representative.name}},",
+		OwnerID:   alice.ID,
+	}
+	copied := campaign.Campaign{BaseModel: shared.BaseModel{ID: uuid.New()}, Name: source.Name, OwnerID: bob.ID}
+
+	users.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "alice"}).Return(alice, nil).Once()
+	campaigns.EXPECT().GetCampaignsByOwner(mock.Anything, alice.ID).Return([]campaign.Campaign{source}, nil).Once()
+
+	var bundle bytes.Buffer
+	err := exportCampaigns(ctx, svc, exportOptions{Owner: "alice", Format: campaign.BundleFormatYAML}, &bundle)
+	require.NoError(t, err)
+
+	users.EXPECT().GetUser(mock.Anything, &user.GetDTO{Username: "bob"}).Return(bob, nil).Once()
+	campaigns.EXPECT().ImportCampaigns(mock.Anything, mock.MatchedBy(func(dto *campaign.ImportCampaignsDTO) bool {
+		return dto.OwnerID == bob.ID && len(dto.Bundle.Campaigns) == 1 && dto.Bundle.Campaigns[0].ID == source.ID
+	})).Return(&campaign.ImportResult{
+		Campaigns: []campaign.Campaign{copied},
+		IDMap:     map[uuid.UUID]uuid.UUID{source.ID: copied.ID},
+	}, nil).Once()
+
+	var out bytes.Buffer
+	err = importCampaigns(ctx, svc, importOptions{Owner: "bob", Format: campaign.BundleFormatYAML}, &bundle, &out)
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), source.ID.String()+" -> "+copied.ID.String())
+	assert.Contains(t, out.String(), "Imported 1 campaign(s) for bob")
+}
+
+func TestExportCampaigns_OneSelection(t *testing.T) {
+	svc := &services{
+		Campaigns: mocksCampaign.NewMockServiceInterface(t),
+		Users:     mocksUser.NewMockServiceInterface(t),
+	}
+
+	err := exportCampaigns(context.Background(), svc, exportOptions{
+		Owner:  "alice",
+		IDs:    []string{uuid.NewString()},
+		Format: campaign.BundleFormatJSON,
+	}, &bytes.Buffer{})
+
+	assert.ErrorContains(t, err, "only one of")
+}
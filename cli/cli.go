@@ -0,0 +1,83 @@
+// Package cli implements mp-emailer's command-line subcommands. Running the
+// binary without arguments starts the web server instead.
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/email"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/jonesrussell/mp-emailer/session"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/jonesrussell/mp-emailer/user"
+	"go.uber.org/fx"
+)
+
+// Exit codes
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const usageText = `Usage: mp-emailer <command> [arguments]
+
+Commands:
+  campaigns export         Write campaigns to a JSON or YAML bundle
+  campaigns import         Create draft campaigns from a bundle
+  representatives import   Load the offline representative dataset
+
+Run "mp-emailer <group> <command> -h" for a command's options.
+Without a command, mp-emailer starts the web server.
+`
+
+// Run executes the subcommand named by args[0] and returns the process
+// exit code
+func Run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usageText)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "campaigns":
+		return runCampaigns(args[1:], stdin, stdout, stderr)
+	case "representatives":
+		return runRepresentatives(args[1:], stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usageText)
+		return exitOK
+	default:
+		fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], usageText)
+		return exitUsage
+	}
+}
+
+// services are the application services the subcommands use
+type services struct {
+	Campaigns campaign.ServiceInterface
+	Users     user.ServiceInterface
+	Dataset   campaign.DatasetRepositoryInterface
+}
+
+// loadServices builds the services from the same modules as the server,
+// without starting it, its scheduler or its email workers
+func loadServices() (*services, error) {
+	var svc services
+	app := fx.New(
+		shared.App,
+		session.Module,
+		email.QueueModule,
+		campaign.Module,
+		organization.Module,
+		user.Module,
+		fx.NopLogger,
+		fx.Populate(&svc.Campaigns, &svc.Users, &svc.Dataset),
+	)
+	if err := app.Err(); err != nil {
+		return nil, fmt.Errorf("failed to start application: %w", err)
+	}
+	return &svc, nil
+}
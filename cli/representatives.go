@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+)
+
+const representativesUsage = `Usage:
+  mp-emailer representatives import -postal-codes file.csv -representatives file [-format json|csv] [-set name]
+`
+
+// datasetOptions says how to read the files of an offline dataset
+type datasetOptions struct {
+	// Format is the format of the representatives export
+	Format string
+	// Set is the legislature of representatives the export doesn't name one for
+	Set string
+}
+
+func runRepresentatives(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, representativesUsage)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "import":
+		return runDatasetImport(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "unknown representatives command %q\n\n%s", args[0], representativesUsage)
+		return exitUsage
+	}
+}
+
+func runDatasetImport(args []string, stdout, stderr io.Writer) int {
+	var opts datasetOptions
+	var postalCodesPath, representativesPath string
+	fs := flag.NewFlagSet("representatives import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&postalCodesPath, "postal-codes", "", "CSV file mapping postal codes to ridings (required)")
+	fs.StringVar(&representativesPath, "representatives", "", "representatives exported from Represent (required)")
+	fs.StringVar(&opts.Format, "format", "", "representatives format, json or csv (default: from the file name)")
+	fs.StringVar(&opts.Set, "set", "", `legislature of representatives the export doesn't name one for, e.g. "House of Commons"`)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if postalCodesPath == "" || representativesPath == "" {
+		fmt.Fprintln(stderr, "-postal-codes and -representatives are required")
+		return exitUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "unexpected argument %q\n", fs.Arg(0))
+		return exitUsage
+	}
+	if opts.Format == "" {
+		opts.Format = campaign.DatasetFormatForPath(representativesPath)
+	}
+
+	postalCodes, err := os.Open(postalCodesPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	defer postalCodes.Close()
+	representatives, err := os.Open(representativesPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	defer representatives.Close()
+
+	svc, err := loadServices()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	if err := importDataset(context.Background(), svc, opts, postalCodes, representatives, stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	return exitOK
+}
+
+// importDataset replaces the offline dataset with the postal codes and
+// representatives read from the given files, and reports what it imported
+// to w
+func importDataset(
+	ctx context.Context,
+	svc *services,
+	opts datasetOptions,
+	postalCodes, representatives io.Reader,
+	w io.Writer,
+) error {
+	ridings, err := campaign.ParsePostalCodeRidings(postalCodes)
+	if err != nil {
+		return fmt.Errorf("postal codes: %w", err)
+	}
+	reps, err := campaign.ParseDatasetRepresentatives(representatives, opts.Format, opts.Set)
+	if err != nil {
+		return fmt.Errorf("representatives: %w", err)
+	}
+
+	if err := svc.Dataset.Replace(ctx, ridings, reps); err != nil {
+		return err
+	}
+
+	codes := make(map[string]bool, len(ridings))
+	districts := make(map[string]bool, len(reps))
+	for _, rep := range reps {
+		districts[rep.DistrictName] = true
+	}
+	unrepresented := make(map[string]bool)
+	for _, riding := range ridings {
+		codes[riding.PostalCode] = true
+		if !districts[riding.DistrictName] {
+			unrepresented[riding.DistrictName] = true
+		}
+	}
+
+	fmt.Fprintf(w, "Imported %d postal code(s) and %d representative(s)\n", len(codes), len(reps))
+	if len(unrepresented) > 0 {
+		fmt.Fprintf(w, "Warning: %d riding(s) have no representative in the dataset\n", len(unrepresented))
+	}
+	return nil
+}
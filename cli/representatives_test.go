@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/campaign"
+	mocksCampaign "github.com/jonesrussell/mp-emailer/mocks/campaign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportDataset(t *testing.T) {
+	dataset := mocksCampaign.NewMockDatasetRepositoryInterface(t)
+	svc := &services{Dataset: dataset}
+
+	postalCodes := "postal_code,district_name\nK1A0A6,Ottawa Centre\nK1P1J1,Ottawa Centre\nK2P2L8,Ottawa South\n"
+	representatives := "district_name,name,email\nOttawa Centre,Jane Doe,jane.doe@parl.gc.ca\n"
+
+	dataset.EXPECT().Replace(mock.Anything,
+		mock.MatchedBy(func(ridings []campaign.PostalCodeRiding) bool { return len(ridings) == 3 }),
+		mock.MatchedBy(func(reps []campaign.DatasetRepresentative) bool {
+			return len(reps) == 1 && reps[0].RepresentativeSet == "House of Commons"
+		}),
+	).Return(nil).Once()
+
+	var out bytes.Buffer
+	err := importDataset(context.Background(), svc,
+		datasetOptions{Format: campaign.DatasetFormatCSV, Set: "House of Commons"},
+		strings.NewReader(postalCodes), strings.NewReader(representatives), &out)
+
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "Imported 3 postal code(s) and 1 representative(s)")
+	assert.Contains(t, out.String(), "1 riding(s) have no representative")
+}
+
+func TestImportDataset_InvalidFiles(t *testing.T) {
+	dataset := mocksCampaign.NewMockDatasetRepositoryInterface(t)
+	svc := &services{Dataset: dataset}
+
+	err := importDataset(context.Background(), svc, datasetOptions{Format: campaign.DatasetFormatJSON},
+		strings.NewReader("postal_code\nK1A0A6\n"), strings.NewReader("[]"), &bytes.Buffer{})
+
+	assert.True(t, errors.Is(err, campaign.ErrInvalidDataset))
+	dataset.AssertNotCalled(t, "Replace", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -7,14 +7,17 @@ import (
 )
 
 type Config struct {
-	App          AppConfig      `yaml:"app"`
-	Database     DatabaseConfig `yaml:"database" env:"sensitive"`
-	Email        EmailConfig    `yaml:"email" env:"sensitive"`
-	Auth         AuthConfig     `yaml:"auth" env:"sensitive"`
-	Log          LogConfig      `yaml:"log"`
-	Server       ServerConfig   `yaml:"server"`
-	FeatureFlags FeatureFlags   `yaml:"feature_flags"`
-	Version      VersionConfig  `yaml:"version"`
+	App             AppConfig             `yaml:"app"`
+	Database        DatabaseConfig        `yaml:"database" env:"sensitive"`
+	Email           EmailConfig           `yaml:"email" env:"sensitive"`
+	Auth            AuthConfig            `yaml:"auth" env:"sensitive"`
+	Log             LogConfig             `yaml:"log"`
+	Server          ServerConfig          `yaml:"server"`
+	Campaign        CampaignConfig        `yaml:"campaign"`
+	FeatureFlags    FeatureFlags          `yaml:"feature_flags"`
+	Version         VersionConfig         `yaml:"version"`
+	Representatives RepresentativesConfig `yaml:"representatives"`
+	HTTPClient      HTTPClientConfig      `yaml:"http_client"`
 }
 
 type AppConfig struct {
@@ -39,6 +42,65 @@ type EmailConfig struct {
 	MailgunAPIKey string        `env:"EMAIL_MAILGUN_API_KEY"`
 	MailgunDomain string        `env:"EMAIL_MAILGUN_DOMAIN"`
 	SMTP          SMTPConfig
+	Queue         EmailQueueConfig
+}
+
+type EmailQueueConfig struct {
+	Workers        int           `env:"EMAIL_QUEUE_WORKERS" envDefault:"4"`
+	BatchSize      int           `env:"EMAIL_QUEUE_BATCH_SIZE" envDefault:"20"`
+	PollInterval   time.Duration `env:"EMAIL_QUEUE_POLL_INTERVAL" envDefault:"5s"`
+	MaxAttempts    int           `env:"EMAIL_QUEUE_MAX_ATTEMPTS" envDefault:"5"`
+	InitialBackoff time.Duration `env:"EMAIL_QUEUE_INITIAL_BACKOFF" envDefault:"30s"`
+	MaxBackoff     time.Duration `env:"EMAIL_QUEUE_MAX_BACKOFF" envDefault:"30m"`
+	LockTimeout    time.Duration `env:"EMAIL_QUEUE_LOCK_TIMEOUT" envDefault:"5m"`
+}
+
+type CampaignConfig struct {
+	SchedulerInterval time.Duration `yaml:"scheduler_interval" env:"CAMPAIGN_SCHEDULER_INTERVAL" envDefault:"1m"`
+	// DraftTTL is how long a composed letter can still be sent
+	DraftTTL time.Duration `yaml:"draft_ttl" env:"CAMPAIGN_DRAFT_TTL" envDefault:"1h"`
+	// ConfirmationTTL is how long constituents have to confirm a letter on
+	// campaigns that require it
+	ConfirmationTTL time.Duration `yaml:"confirmation_ttl" env:"CAMPAIGN_CONFIRMATION_TTL" envDefault:"48h"`
+}
+
+// RepresentativesConfig controls how representatives are looked up
+type RepresentativesConfig struct {
+	// Providers are asked in order until one finds somebody
+	Providers []RepresentativeProvider  `yaml:"providers" env:"REPRESENTATIVE_PROVIDERS" envSeparator:"," envDefault:"represent"`
+	Cache     RepresentativeCacheConfig `yaml:"cache"`
+}
+
+// RepresentativeCacheConfig controls the cache of representative lookups
+// by postal code
+type RepresentativeCacheConfig struct {
+	Enabled bool `yaml:"enabled" env:"REPRESENTATIVE_CACHE_ENABLED" envDefault:"true"`
+	// Size is how many postal codes are kept in memory
+	Size int `yaml:"size" env:"REPRESENTATIVE_CACHE_SIZE" envDefault:"10000"`
+	// TTL is how long a lookup is served without asking the API again
+	TTL time.Duration `yaml:"ttl" env:"REPRESENTATIVE_CACHE_TTL" envDefault:"24h"`
+	// StaleTTL is how long after TTL a lookup is still served while it is
+	// refreshed in the background
+	StaleTTL time.Duration `yaml:"stale_ttl" env:"REPRESENTATIVE_CACHE_STALE_TTL" envDefault:"168h"`
+	// Database also keeps lookups in the database, so that they survive
+	// restarts and are shared between instances
+	Database bool `yaml:"database" env:"REPRESENTATIVE_CACHE_DATABASE" envDefault:"false"`
+}
+
+// HTTPClientConfig controls requests to external services, such as the
+// Represent API
+type HTTPClientConfig struct {
+	// Timeout bounds each attempt of a request
+	Timeout time.Duration `yaml:"timeout" env:"HTTP_CLIENT_TIMEOUT" envDefault:"5s"`
+	// MaxRetries is how many times a request is retried after a server
+	// error, a timeout or rate limiting
+	MaxRetries     int           `yaml:"max_retries" env:"HTTP_CLIENT_MAX_RETRIES" envDefault:"2"`
+	InitialBackoff time.Duration `yaml:"initial_backoff" env:"HTTP_CLIENT_INITIAL_BACKOFF" envDefault:"200ms"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env:"HTTP_CLIENT_MAX_BACKOFF" envDefault:"5s"`
+	// BreakerThreshold is how many failed requests in a row stop requests
+	// to a host for BreakerCooldown; zero turns the circuit breaker off
+	BreakerThreshold int           `yaml:"breaker_threshold" env:"HTTP_CLIENT_BREAKER_THRESHOLD" envDefault:"5"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown" env:"HTTP_CLIENT_BREAKER_COOLDOWN" envDefault:"30s"`
 }
 
 type SMTPConfig struct {
@@ -68,7 +130,7 @@ type LogConfig struct {
 
 type ServerConfig struct {
 	MigrationsPath              string `yaml:"migrations_path" env:"MIGRATIONS_PATH" envDefault:"database/migrations"`
-	RepresentativeLookupBaseURL string `yaml:"representative_lookup_base_url" env:"REPRESENTATIVE_LOOKUP_BASE_URL" envDefault:"https://represent.opennorth.ca/api"`
+	RepresentativeLookupBaseURL string `yaml:"representative_lookup_base_url" env:"REPRESENTATIVE_LOOKUP_BASE_URL" envDefault:"https://represent.opennorth.ca"`
 	RateLimiting                struct {
 		RequestsPerSecond float64 `yaml:"requests_per_second" env:"RATE_LIMIT_RPS" envDefault:"20"`
 		BurstSize         int     `yaml:"burst_size" env:"RATE_LIMIT_BURST" envDefault:"50"`
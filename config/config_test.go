@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jonesrussell/mp-emailer/config"
 	"github.com/stretchr/testify/assert"
@@ -198,6 +199,12 @@ log:
 	assert.Equal(t, expectedLogFilePath, cfg.Log.File, "unexpected value for Log.File")
 	assert.Equal(t, "json", cfg.Log.Format, "unexpected value for Log.Format")
 	assert.Equal(t, "info", cfg.Log.Level, "unexpected value for Log.Level")
+	assert.Equal(t, []config.RepresentativeProvider{config.RepresentativeProviderRepresent},
+		cfg.Representatives.Providers, "unexpected value for Representatives.Providers")
+	assert.Equal(t, 5*time.Second, cfg.HTTPClient.Timeout, "unexpected value for HTTPClient.Timeout")
+	assert.Equal(t, 5, cfg.HTTPClient.BreakerThreshold, "unexpected value for HTTPClient.BreakerThreshold")
+	assert.True(t, cfg.Representatives.Cache.Enabled, "unexpected value for Representatives.Cache.Enabled")
+	assert.Equal(t, 24*time.Hour, cfg.Representatives.Cache.TTL, "unexpected value for Representatives.Cache.TTL")
 }
 
 func TestFeatureFlagConfiguration(t *testing.T) {
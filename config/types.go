@@ -16,6 +16,17 @@ const (
 	EmailProviderMailgun EmailProvider = "mailgun"
 )
 
+// RepresentativeProvider names a source of representatives
+type RepresentativeProvider string
+
+const (
+	// RepresentativeProviderRepresent asks the Represent API
+	RepresentativeProviderRepresent RepresentativeProvider = "represent"
+	// RepresentativeProviderDataset answers from a dataset imported with
+	// "mp-emailer representatives import", without network access
+	RepresentativeProviderDataset RepresentativeProvider = "dataset"
+)
+
 type VersionConfig struct {
 	Version   string `yaml:"version" env:"APP_VERSION"`
 	BuildDate string `yaml:"build_date" env:"BUILD_DATE"`
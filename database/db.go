@@ -5,6 +5,7 @@ import (
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GormDB struct {
@@ -60,3 +61,33 @@ func (g *GormDB) FindAll(ctx context.Context, dest interface{}, query string, ar
 	}
 	return nil
 }
+
+func (g *GormDB) FindPage(ctx context.Context, dest interface{}, opts QueryOptions) (int64, error) {
+	query := g.db.WithContext(ctx).Model(dest)
+	for _, cond := range opts.Conditions {
+		query = query.Where(cond.Query, cond.Args...)
+	}
+	// A new session lets the conditions be shared by the count and the select
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	switch {
+	case len(opts.OrderArgs) > 0:
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL: opts.Order, Vars: opts.OrderArgs, WithoutParentheses: true,
+		}})
+	case opts.Order != "":
+		query = query.Order(opts.Order)
+	}
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit).Offset(opts.Offset)
+	}
+	if err := query.Find(dest).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
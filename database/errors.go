@@ -0,0 +1,16 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlDuplicateEntry is MySQL's error number for a unique key violation
+const mysqlDuplicateEntry = 1062
+
+// IsDuplicateKey reports whether err is a unique key violation
+func IsDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
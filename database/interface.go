@@ -11,6 +11,9 @@ type Database interface {
 	Create(ctx context.Context, value interface{}) error
 	FindOne(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 	FindAll(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	// FindPage loads one page of matching rows into dest and returns the
+	// number of rows matching the conditions across all pages
+	FindPage(ctx context.Context, dest interface{}, opts QueryOptions) (int64, error)
 	Update(ctx context.Context, value interface{}) error
 	Delete(ctx context.Context, value interface{}) error
 
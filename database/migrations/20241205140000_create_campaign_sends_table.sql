@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS campaign_sends (
+    id CHAR(36) PRIMARY KEY,
+    campaign_id CHAR(36) NOT NULL,
+    representative_name VARCHAR(255) NOT NULL,
+    representative_email VARCHAR(255) NOT NULL,
+    representative_office VARCHAR(100) NULL,
+    representative_district VARCHAR(255) NULL,
+    postal_code VARCHAR(10) NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    provider_message_id VARCHAR(255) NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    error TEXT NULL,
+    sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_sends_campaign_id ON campaign_sends(campaign_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_sends_status ON campaign_sends(status);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_sends_deleted_at ON campaign_sends(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS campaign_sends;
+-- +goose StatementEnd
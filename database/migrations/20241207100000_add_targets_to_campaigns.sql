@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaigns ADD COLUMN targets JSON NULL AFTER template;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE campaigns SET targets = JSON_ARRAY('MP') WHERE targets IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE campaigns DROP COLUMN targets;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaigns ADD COLUMN tokens JSON NULL AFTER targets;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE campaigns DROP COLUMN tokens;
+-- +goose StatementEnd
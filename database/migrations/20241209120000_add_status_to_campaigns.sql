@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft' AFTER tokens,
+    ADD COLUMN starts_at TIMESTAMP NULL AFTER status,
+    ADD COLUMN ends_at TIMESTAMP NULL AFTER starts_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Campaigns created before lifecycle states existed were already live
+UPDATE campaigns SET status = 'active';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaigns_status ON campaigns(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE campaigns DROP INDEX idx_campaigns_status, DROP COLUMN ends_at, DROP COLUMN starts_at, DROP COLUMN status;
+-- +goose StatementEnd
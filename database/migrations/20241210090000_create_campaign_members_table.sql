@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS campaign_members (
+    id CHAR(36) PRIMARY KEY,
+    campaign_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NULL,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    invite_token VARCHAR(64) NOT NULL,
+    invite_expires_at TIMESTAMP NOT NULL,
+    invited_by_id CHAR(36) NOT NULL,
+    accepted_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_campaign_members_invite_token ON campaign_members(invite_token);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_members_campaign_user ON campaign_members(campaign_id, user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_members_user_id ON campaign_members(user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_members_deleted_at ON campaign_members(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS campaign_members;
+-- +goose StatementEnd
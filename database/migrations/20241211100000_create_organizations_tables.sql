@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS organizations (
+    id CHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_organizations_slug ON organizations(slug);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_organizations_deleted_at ON organizations(deleted_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS organization_members (
+    id CHAR(36) PRIMARY KEY,
+    organization_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_organization_members_org_user ON organization_members(organization_id, user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_organization_members_deleted_at ON organization_members(deleted_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    ADD COLUMN organization_id CHAR(36) NULL AFTER owner_id,
+    ADD CONSTRAINT fk_campaigns_organization FOREIGN KEY (organization_id) REFERENCES organizations(id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaigns_organization_id ON campaigns(organization_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE campaigns DROP FOREIGN KEY fk_campaigns_organization;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX idx_campaigns_organization_id ON campaigns;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaigns DROP COLUMN organization_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS organization_members;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS organizations;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaigns ADD COLUMN tags JSON NULL AFTER tokens;
+-- +goose StatementEnd
+
+-- Indexes for the sortable columns of the campaign listing
+-- +goose StatementBegin
+CREATE INDEX idx_campaigns_created_at ON campaigns(created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaigns_updated_at ON campaigns(updated_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaigns_name ON campaigns(name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_campaigns_name ON campaigns;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX idx_campaigns_updated_at ON campaigns;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX idx_campaigns_created_at ON campaigns;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaigns DROP COLUMN tags;
+-- +goose StatementEnd
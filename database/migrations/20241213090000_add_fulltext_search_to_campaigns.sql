@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE FULLTEXT INDEX ft_campaigns_search ON campaigns(name, description, template);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX ft_campaigns_search ON campaigns;
+-- +goose StatementEnd
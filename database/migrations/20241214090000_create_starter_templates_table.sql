@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS starter_templates (
+    id CHAR(36) PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    template TEXT NOT NULL,
+    targets JSON NULL,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_starter_templates_slug ON starter_templates(slug);
+-- +goose StatementEnd
+
+-- The curated library. IDs are fixed so links to a starter stay valid.
+-- +goose StatementBegin
+INSERT INTO starter_templates (id, slug, name, description, template, targets, position) VALUES
+(
+    '0b6f9a52-6c1e-4f43-9a57-3f1c2d8e4a01',
+    'write-to-your-mp',
+    'Write to your MP',
+    'A general letter asking your Member of Parliament to act on an issue that matters to you.',
+    '<p>{{.date | date "January 2, 2006"}}</p><p>Dear {{.representative.name}},</p><p>As a constituent in {{.representative.district}}, I am writing to ask you to [describe the action you want your MP to take].</p><p>[Explain why this matters to you and to our community.]</p><p>I would appreciate a reply letting me know where you stand.</p><p>Sincerely,</p><p>{{.first_name}} {{.last_name}}<br>{{.address_1}}<br>{{.city}}, {{.province}} {{.postal_code}}</p>',
+    '["MP"]',
+    10
+),
+(
+    '0b6f9a52-6c1e-4f43-9a57-3f1c2d8e4a02',
+    'affordable-housing',
+    'Make housing affordable',
+    'Ask federal and provincial representatives to fund affordable and non-market housing.',
+    '<p>Dear {{.representative.name}},</p><p>Rents and home prices in {{.city}} have climbed far faster than wages. Families, seniors and young people are being pushed out of the communities they grew up in.</p><p>I am asking you to support direct public investment in affordable and non-market housing, and to press for the permits and land needed to build it quickly.</p><p>Please let me know what you will do to make housing affordable in {{.representative.district}}.</p><p>Sincerely,</p><p>{{.first_name}} {{.last_name}}<br>{{.postal_code}}</p>',
+    '["MP","provincial"]',
+    20
+),
+(
+    '0b6f9a52-6c1e-4f43-9a57-3f1c2d8e4a03',
+    'public-transit',
+    'Fund public transit',
+    'Ask provincial and municipal representatives for frequent, reliable and affordable transit.',
+    '<p>Dear {{.representative.name}},</p><p>I rely on public transit in {{.city}}, and too often the service is infrequent, crowded or unreliable.</p><p>I am asking you to support stable operating funding for transit so that routes run frequently all day, and to keep fares affordable.</p><p>Good transit cuts traffic and emissions and connects people to work and school. Thank you for your attention.</p><p>Sincerely,</p><p>{{.first_name}} {{.last_name}}</p>',
+    '["provincial","Mayor","Councillor"]',
+    30
+),
+(
+    '0b6f9a52-6c1e-4f43-9a57-3f1c2d8e4a04',
+    'protect-local-parks',
+    'Protect local parks',
+    'Ask your mayor and councillor to protect and maintain green space in your neighbourhood.',
+    '<p>Dear {{.representative.name}},</p><p>Our parks and green spaces are among the things I value most about living in {{.city}}.</p><p>I am asking you to protect existing parkland from development, to fund its upkeep, and to plan new green space as our neighbourhoods grow.</p><p>I look forward to hearing how you will keep our parks safe.</p><p>Sincerely,</p><p>{{.first_name}} {{.last_name}}<br>{{.address_1}}</p>',
+    '["Mayor","Councillor"]',
+    40
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS starter_templates;
+-- +goose StatementEnd
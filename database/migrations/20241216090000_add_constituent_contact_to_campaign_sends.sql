@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    ADD COLUMN contact_consent BOOLEAN NOT NULL DEFAULT FALSE AFTER postal_code,
+    ADD COLUMN constituent_first_name VARCHAR(100) NULL AFTER contact_consent,
+    ADD COLUMN constituent_last_name VARCHAR(100) NULL AFTER constituent_first_name,
+    ADD COLUMN constituent_email VARCHAR(255) NULL AFTER constituent_last_name;
+-- +goose StatementEnd
+
+-- Exports read a campaign's sends in submission order
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_sends_campaign_created ON campaign_sends(campaign_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_campaign_sends_campaign_created ON campaign_sends;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    DROP COLUMN constituent_email,
+    DROP COLUMN constituent_last_name,
+    DROP COLUMN constituent_first_name,
+    DROP COLUMN contact_consent;
+-- +goose StatementEnd
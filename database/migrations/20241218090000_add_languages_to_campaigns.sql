@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    ADD COLUMN language VARCHAR(5) NOT NULL DEFAULT 'en' AFTER subject,
+    ADD COLUMN translations JSON NULL AFTER template;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    DROP COLUMN translations,
+    DROP COLUMN language;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    ADD COLUMN require_confirmation BOOLEAN NOT NULL DEFAULT FALSE AFTER custom_fields;
+-- +goose StatementEnd
+
+-- Letters awaiting the constituent's confirmation are kept until it arrives
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    ADD COLUMN subject VARCHAR(255) NULL AFTER content_hash,
+    ADD COLUMN body TEXT NULL AFTER subject,
+    ADD COLUMN confirmation_token VARCHAR(64) NULL AFTER body,
+    ADD COLUMN confirmation_expires_at TIMESTAMP NULL AFTER confirmation_token,
+    ADD COLUMN confirmed_at TIMESTAMP NULL AFTER confirmation_expires_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_campaign_sends_confirmation_token ON campaign_sends(confirmation_token);
+-- +goose StatementEnd
+
+-- The expiry job looks for unconfirmed letters past their deadline
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_sends_status_confirmation_expires ON campaign_sends(status, confirmation_expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_campaign_sends_status_confirmation_expires ON campaign_sends;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX idx_campaign_sends_confirmation_token ON campaign_sends;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    DROP COLUMN confirmed_at,
+    DROP COLUMN confirmation_expires_at,
+    DROP COLUMN confirmation_token,
+    DROP COLUMN body,
+    DROP COLUMN subject;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    DROP COLUMN require_confirmation;
+-- +goose StatementEnd
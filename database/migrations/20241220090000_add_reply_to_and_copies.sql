@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE email_queue
+    ADD COLUMN reply_to VARCHAR(255) NULL AFTER recipient,
+    ADD COLUMN cc JSON NULL AFTER reply_to,
+    ADD COLUMN bcc JSON NULL AFTER cc;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    ADD COLUMN copy_constituent BOOLEAN NOT NULL DEFAULT FALSE AFTER require_confirmation;
+-- +goose StatementEnd
+
+-- Unconfirmed letters keep the constituent's address for Reply-To even when
+-- they didn't agree to share it with the organizers
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    ADD COLUMN reply_to VARCHAR(255) NULL AFTER body;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    DROP COLUMN reply_to;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaigns
+    DROP COLUMN copy_constituent;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE email_queue
+    DROP COLUMN bcc,
+    DROP COLUMN cc,
+    DROP COLUMN reply_to;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- The offline representative dataset, imported with "mp-emailer representatives import"
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dataset_postal_codes (
+    postal_code VARCHAR(6) NOT NULL,
+    district_name VARCHAR(255) NOT NULL,
+    representative_set_name VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (postal_code, district_name, representative_set_name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dataset_representatives (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    district_name VARCHAR(255) NOT NULL,
+    representative_set_name VARCHAR(255) NOT NULL DEFAULT '',
+    representative JSON NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_dataset_representatives_district_name ON dataset_representatives(district_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dataset_representatives;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dataset_postal_codes;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- A draft token can only send one letter
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    ADD COLUMN draft_token_id CHAR(36) NULL AFTER content_hash;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_campaign_sends_draft_token_id ON campaign_sends(draft_token_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_campaign_sends_draft_token_id ON campaign_sends;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE campaign_sends
+    DROP COLUMN draft_token_id;
+-- +goose StatementEnd
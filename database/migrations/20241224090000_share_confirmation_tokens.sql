@@ -0,0 +1,18 @@
+-- +goose Up
+-- The letters of one submission share a confirmation link
+-- +goose StatementBegin
+DROP INDEX idx_campaign_sends_confirmation_token ON campaign_sends;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_campaign_sends_confirmation_token ON campaign_sends(confirmation_token);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_campaign_sends_confirmation_token ON campaign_sends;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_campaign_sends_confirmation_token ON campaign_sends(confirmation_token);
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+package database
+
+// Condition is a single WHERE clause with its arguments
+type Condition struct {
+	Query string
+	Args  []interface{}
+}
+
+// Where creates a Condition
+func Where(query string, args ...interface{}) Condition {
+	return Condition{Query: query, Args: args}
+}
+
+// QueryOptions describes a filtered, sorted and paginated query. Conditions
+// are combined with AND. OrderArgs fill placeholders in Order, such as a
+// relevance score. A Limit of zero returns every matching row.
+type QueryOptions struct {
+	Conditions []Condition
+	Order      string
+	OrderArgs  []interface{}
+	Limit      int
+	Offset     int
+}
@@ -30,17 +30,34 @@ func NewMailgunEmailService(domain, apiKey string, client MailgunClient, log log
 	}
 }
 
-func (s *MailgunEmailService) SendEmail(to, subject, body string, isHTML bool) error {
+// SendEmail sends a message to a single recipient
+func (s *MailgunEmailService) SendEmail(to, subject, body string, isHTML bool) (string, error) {
+	return s.Send(&Message{To: to, Subject: subject, Body: body, IsHTML: isHTML})
+}
+
+// Send sends a message with both a plain-text and an HTML body, which
+// Mailgun delivers as multipart/alternative
+func (s *MailgunEmailService) Send(msg *Message) (string, error) {
+	text, html := alternatives(msg.Body, msg.IsHTML)
 	message := s.client.NewMessage(
 		fmt.Sprintf("no-reply@%s", s.domain),
-		subject,
-		body,
-		to,
+		msg.Subject,
+		text,
+		msg.To,
 	)
 
-	if isHTML {
-		s.Logger.Debug("HTML Body content", "body", body)
-		message.SetHTML(body)
+	if msg.IsHTML {
+		s.Logger.Debug("HTML Body content", "body", msg.Body)
+	}
+	message.SetHTML(html)
+	if msg.ReplyTo != "" {
+		message.SetReplyTo(msg.ReplyTo)
+	}
+	for _, cc := range msg.CC {
+		message.AddCC(cc)
+	}
+	for _, bcc := range msg.BCC {
+		message.AddBCC(bcc)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
@@ -48,9 +65,9 @@ func (s *MailgunEmailService) SendEmail(to, subject, body string, isHTML bool) e
 
 	_, id, err := s.client.Send(ctx, message)
 	if err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
+		return "", fmt.Errorf("failed to send email: %w", err)
 	}
 
 	s.Logger.Debug("Email sent successfully", "messageId", id)
-	return nil
+	return id, nil
 }
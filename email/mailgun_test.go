@@ -1,8 +1,9 @@
-package email
+package email_test
 
 import (
 	"testing"
 
+	"github.com/jonesrussell/mp-emailer/email"
 	mocksEmail "github.com/jonesrussell/mp-emailer/mocks/email"
 	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
 	"github.com/mailgun/mailgun-go/v4"
@@ -15,23 +16,70 @@ func TestMailgunEmailService_SendEmail(t *testing.T) {
 	mockLogger := mocksLogger.NewMockInterface(t)
 
 	// Set up logger expectations
-	mockLogger.On("Debug", "Email sent successfully", "messageId", "").Return()
+	mockLogger.On("Debug", "Email sent successfully", "messageId", "<id@example.com>").Return()
 
-	service := &MailgunEmailService{
-		domain: "example.com",
-		apiKey: "key",
-		client: mockMailgun,
-		Logger: mockLogger,
-	}
+	service := email.NewMailgunEmailService("example.com", "key", mockMailgun, mockLogger)
 
-	message := &mailgun.Message{}
+	message := mailgun.NewMessage("no-reply@example.com", "Subject", "Body", "test@example.com")
 
 	mockMailgun.On("NewMessage", "no-reply@example.com", "Subject", "Body", "test@example.com").Return(message)
-	mockMailgun.On("Send", mock.Anything, message).Return("", "", nil)
+	mockMailgun.On("Send", mock.Anything, message).Return("", "<id@example.com>", nil)
 
-	err := service.SendEmail("test@example.com", "Subject", "Body", false)
+	messageID, err := service.SendEmail("test@example.com", "Subject", "Body", false)
 
 	assert.NoError(t, err)
+	assert.Equal(t, "<id@example.com>", messageID)
 	mockMailgun.AssertExpectations(t)
 	mockLogger.AssertExpectations(t)
 }
+
+func TestMailgunEmailService_SendEmailHTML(t *testing.T) {
+	mockMailgun := new(mocksEmail.MockMailgunClient)
+	mockLogger := mocksLogger.NewMockInterface(t)
+
+	body := `<p>Read the <a href="https://example.com/bill">bill</a>.</p>`
+	mockLogger.On("Debug", "HTML Body content", "body", body).Return()
+	mockLogger.On("Debug", "Email sent successfully", "messageId", "<id@example.com>").Return()
+
+	service := email.NewMailgunEmailService("example.com", "key", mockMailgun, mockLogger)
+
+	text := "Read the bill [1].\n\n[1] https://example.com/bill"
+	message := mailgun.NewMessage("no-reply@example.com", "Subject", text, "test@example.com")
+
+	// The plain-text alternative is derived from the HTML body
+	mockMailgun.On("NewMessage", "no-reply@example.com", "Subject", text, "test@example.com").Return(message)
+	mockMailgun.On("Send", mock.Anything, message).Return("", "<id@example.com>", nil)
+
+	messageID, err := service.SendEmail("test@example.com", "Subject", body, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "<id@example.com>", messageID)
+	mockMailgun.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestMailgunEmailService_SendReplyToAndCopies(t *testing.T) {
+	mockMailgun := new(mocksEmail.MockMailgunClient)
+	mockLogger := mocksLogger.NewMockInterface(t)
+	mockLogger.On("Debug", "Email sent successfully", "messageId", "<id@example.com>").Return()
+
+	service := email.NewMailgunEmailService("example.com", "key", mockMailgun, mockLogger)
+
+	message := mailgun.NewMessage("no-reply@example.com", "Subject", "Body", "mp@example.com")
+	mockMailgun.On("NewMessage", "no-reply@example.com", "Subject", "Body", "mp@example.com").Return(message)
+	mockMailgun.On("Send", mock.Anything, message).Return("", "<id@example.com>", nil)
+
+	_, err := service.Send(&email.Message{
+		To:      "mp@example.com",
+		ReplyTo: "jane@example.com",
+		CC:      []string{"jane@example.com"},
+		BCC:     []string{"archive@example.com"},
+		Subject: "Subject",
+		Body:    "Body",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jane@example.com", message.GetHeaders()["Reply-To"])
+	assert.Equal(t, 3, message.RecipientCount())
+	mockMailgun.AssertExpectations(t)
+}
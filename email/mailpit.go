@@ -2,6 +2,10 @@ package email
 
 import (
 	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type MailpitEmailService struct {
@@ -20,21 +24,54 @@ func NewMailpitEmailService(host, port string, smtpClient SMTPClient, from strin
 	}
 }
 
-func (s *MailpitEmailService) SendEmail(to, subject, body string, isHTML bool) error {
+// SendEmail sends a message to a single recipient
+func (s *MailpitEmailService) SendEmail(to, subject, body string, isHTML bool) (string, error) {
+	return s.Send(&Message{To: to, Subject: subject, Body: body, IsHTML: isHTML})
+}
+
+// Send sends a multipart/alternative message over SMTP. An HTML body gets a
+// plain-text alternative derived from it, and a plain-text body an HTML one.
+func (s *MailpitEmailService) Send(msg *Message) (string, error) {
 	addr := fmt.Sprintf("%s:%s", s.host, s.port)
-	contentType := "text/plain"
-	if isHTML {
-		contentType = "text/html"
+
+	// SMTP has no provider-assigned ID, so we generate the Message-ID ourselves
+	messageID := fmt.Sprintf("<%s@%s>", uuid.New().String(), s.host)
+
+	text, html := alternatives(msg.Body, msg.IsHTML)
+	message, err := (&mimeMessage{
+		From:      s.from,
+		To:        msg.To,
+		CC:        msg.CC,
+		ReplyTo:   msg.ReplyTo,
+		Subject:   msg.Subject,
+		MessageID: messageID,
+		Date:      time.Now(),
+		Text:      text,
+		HTML:      html,
+	}).Bytes()
+	if err != nil {
+		return "", err
 	}
 
-	message := []byte(fmt.Sprintf("From: %s\r\n"+
-		"To: %s\r\n"+
-		"Subject: %s\r\n"+
-		"MIME-Version: 1.0\r\n"+
-		"Content-Type: %s; charset=UTF-8\r\n"+
-		"\r\n"+
-		"%s",
-		s.from, to, subject, contentType, body))
+	// Every recipient, blind copies included, is an envelope recipient
+	recipients := append([]string{msg.To}, msg.CC...)
+	recipients = append(recipients, msg.BCC...)
+	envelopeTo := make([]string, len(recipients))
+	for i, recipient := range recipients {
+		envelopeTo[i] = envelopeAddress(recipient)
+	}
 
-	return s.smtpClient.SendMail(addr, nil, s.from, []string{to}, message)
+	if err := s.smtpClient.SendMail(addr, nil, envelopeAddress(s.from), envelopeTo, message); err != nil {
+		return "", err
+	}
+
+	return messageID, nil
+}
+
+// envelopeAddress strips the display name from an address for the SMTP envelope
+func envelopeAddress(address string) string {
+	if parsed, err := mail.ParseAddress(address); err == nil {
+		return parsed.Address
+	}
+	return address
 }
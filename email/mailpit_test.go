@@ -1,11 +1,18 @@
-package email
+package email_test
 
 import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
 	"testing"
 
+	"github.com/jonesrussell/mp-emailer/email"
 	mocksEmail "github.com/jonesrussell/mp-emailer/mocks/email"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMailpitEmailService_SendEmail(t *testing.T) {
@@ -22,7 +29,7 @@ func TestMailpitEmailService_SendEmail(t *testing.T) {
 	).Return(nil)
 
 	// Create service with mock SMTP client
-	service := NewMailpitEmailService(
+	service := email.NewMailpitEmailService(
 		"localhost",
 		"1025",
 		mockSMTP,
@@ -30,8 +37,110 @@ func TestMailpitEmailService_SendEmail(t *testing.T) {
 	)
 
 	// Test sending HTML email
-	err := service.SendEmail("recipient@example.com", "Test Subject", "Test Body", true)
+	messageID, err := service.SendEmail("recipient@example.com", "Test Subject", "Test Body", true)
 
 	assert.NoError(t, err)
+	assert.Contains(t, messageID, "@localhost>")
 	mockSMTP.AssertExpectations(t)
 }
+
+func TestMailpitEmailService_SendEmailMultipart(t *testing.T) {
+	mockSMTP := new(mocksEmail.MockSMTPClient)
+
+	var sent []byte
+	mockSMTP.On("SendMail",
+		"localhost:1025",
+		mock.Anything,
+		"noreply@example.com",
+		[]string{"elise@example.ca"},
+		mock.AnythingOfType("[]uint8"),
+	).Run(func(args mock.Arguments) {
+		sent = args.Get(4).([]byte)
+	}).Return(nil)
+
+	service := email.NewMailpitEmailService("localhost", "1025", mockSMTP, "MP Emailer <noreply@example.com>")
+
+	body := `<p>Chère députée,</p><p>Lisez <a href="https://example.com/projet">le projet de loi</a>.</p>`
+	_, err := service.SendEmail("Élise Côté <elise@example.ca>", "Logement abordable", body, true)
+	require.NoError(t, err)
+	mockSMTP.AssertExpectations(t)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(sent))
+	require.NoError(t, err)
+
+	// Accented names are RFC 2047 encoded and decode back unchanged
+	assert.Contains(t, msg.Header.Get("To"), "=?utf-8?q?")
+	to, err := msg.Header.AddressList("To")
+	require.NoError(t, err)
+	assert.Equal(t, "Élise Côté", to[0].Name)
+	assert.Equal(t, "Logement abordable", msg.Header.Get("Subject"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var types, bodies []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+		types = append(types, part.Header.Get("Content-Type"))
+		bodies = append(bodies, string(content))
+	}
+
+	assert.Equal(t, []string{"text/plain; charset=UTF-8", "text/html; charset=UTF-8"}, types)
+	// Quoted-printable text parts use CRLF line endings on the wire
+	assert.Equal(t, "Chère députée,\r\n\r\nLisez le projet de loi [1].\r\n\r\n[1] https://example.com/projet", bodies[0])
+	assert.Equal(t, body, bodies[1])
+}
+
+func TestMailpitEmailService_SendEmailInvalidRecipient(t *testing.T) {
+	mockSMTP := new(mocksEmail.MockSMTPClient)
+	service := email.NewMailpitEmailService("localhost", "1025", mockSMTP, "test@example.com")
+
+	_, err := service.SendEmail("not an address", "Subject", "Body", false)
+
+	assert.Error(t, err)
+	mockSMTP.AssertNotCalled(t, "SendMail")
+}
+
+func TestMailpitEmailService_SendReplyToAndCopies(t *testing.T) {
+	mockSMTP := new(mocksEmail.MockSMTPClient)
+
+	var sent []byte
+	mockSMTP.On("SendMail",
+		"localhost:1025",
+		mock.Anything,
+		"noreply@example.com",
+		[]string{"mp@example.com", "jane@example.com", "archive@example.com"},
+		mock.AnythingOfType("[]uint8"),
+	).Run(func(args mock.Arguments) {
+		sent = args.Get(4).([]byte)
+	}).Return(nil)
+
+	service := email.NewMailpitEmailService("localhost", "1025", mockSMTP, "noreply@example.com")
+
+	_, err := service.Send(&email.Message{
+		To:      "mp@example.com",
+		ReplyTo: "Jane Doe <jane@example.com>",
+		CC:      []string{"Jane Doe <jane@example.com>"},
+		BCC:     []string{"archive@example.com"},
+		Subject: "Subject",
+		Body:    "Body",
+	})
+	require.NoError(t, err)
+	mockSMTP.AssertExpectations(t)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(sent))
+	require.NoError(t, err)
+	assert.Equal(t, `"Jane Doe" <jane@example.com>`, msg.Header.Get("Reply-To"))
+	assert.Equal(t, `"Jane Doe" <jane@example.com>`, msg.Header.Get("Cc"))
+	// Blind copies are delivered without appearing in the headers
+	assert.Empty(t, msg.Header.Get("Bcc"))
+	assert.NotContains(t, string(sent), "archive@example.com")
+}
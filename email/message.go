@@ -0,0 +1,15 @@
+package email
+
+// Message is an outgoing email. Addresses may carry a display name, as in
+// "Élise Roy <elise@example.ca>".
+type Message struct {
+	To string
+	// ReplyTo is where replies go when it isn't the sender
+	ReplyTo string
+	CC      []string
+	// BCC recipients receive the message without appearing in its headers
+	BCC     []string
+	Subject string
+	Body    string
+	IsHTML  bool
+}
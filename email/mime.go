@@ -0,0 +1,116 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// mimeMessage is an outgoing message with both a plain-text and an HTML
+// body, serialized as multipart/alternative
+type mimeMessage struct {
+	From      string
+	To        string
+	CC        []string
+	ReplyTo   string
+	Subject   string
+	MessageID string
+	Date      time.Time
+	Text      string
+	HTML      string
+}
+
+// Bytes renders the message for SMTP. Display names and the subject are
+// RFC 2047 encoded when they aren't plain ASCII, and both bodies are
+// quoted-printable so that accented text survives 7-bit relays. Blind copies
+// never appear in the headers; they are only envelope recipients.
+func (m *mimeMessage) Bytes() ([]byte, error) {
+	from, err := formatAddress(m.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+	to, err := formatAddress(m.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient address: %w", err)
+	}
+	cc := make([]string, len(m.CC))
+	for i, address := range m.CC {
+		if cc[i], err = formatAddress(address); err != nil {
+			return nil, fmt.Errorf("invalid cc address: %w", err)
+		}
+	}
+	var replyTo string
+	if m.ReplyTo != "" {
+		if replyTo, err = formatAddress(m.ReplyTo); err != nil {
+			return nil, fmt.Errorf("invalid reply-to address: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	parts := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	if len(cc) > 0 {
+		fmt.Fprintf(&buf, "Cc: %s\r\n", strings.Join(cc, ", "))
+	}
+	if replyTo != "" {
+		fmt.Fprintf(&buf, "Reply-To: %s\r\n", replyTo)
+	}
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", m.Date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", m.MessageID)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", parts.Boundary())
+
+	// Clients show the last alternative they support, so HTML goes last
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", m.Text},
+		{"text/html; charset=UTF-8", m.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// formatAddress parses an address such as "Élise Roy <elise@example.ca>"
+// and formats it for a header, encoding the display name if needed
+func formatAddress(address string) (string, error) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// alternatives returns the plain-text and HTML bodies of a message given
+// either one of them
+func alternatives(body string, isHTML bool) (text, html string) {
+	if isHTML {
+		return PlainText(body), body
+	}
+	return body, htmlFromText(body)
+}
@@ -1,6 +1,8 @@
 package email
 
 import (
+	"context"
+
 	"github.com/jonesrussell/mp-emailer/logger"
 	"go.uber.org/fx"
 )
@@ -25,6 +27,30 @@ var Module = fx.Options(
 	),
 )
 
+// QueueModule provides the outbound email queue and starts its worker pool
+//
+//nolint:gochecknoglobals
+var QueueModule = fx.Options(
+	fx.Provide(
+		NewQueueConfig,
+		NewQueueRepository,
+		NewQueue,
+		NewWorkerPool,
+	),
+	fx.Invoke(registerWorkerPoolHooks),
+)
+
+func registerWorkerPoolHooks(lc fx.Lifecycle, pool *WorkerPool) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			// The start context expires once startup completes, so the pool gets its own
+			pool.Start(context.Background())
+			return nil
+		},
+		OnStop: pool.Stop,
+	})
+}
+
 // Params holds the dependencies needed to create an email service
 type Params struct {
 	fx.In
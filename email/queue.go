@@ -0,0 +1,147 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+// QueueStatus represents the state of a queued message
+type QueueStatus string
+
+const (
+	QueueStatusPending    QueueStatus = "pending"
+	QueueStatusProcessing QueueStatus = "processing"
+	QueueStatusSent       QueueStatus = "sent"
+	QueueStatusDead       QueueStatus = "dead"
+)
+
+// QueuedMessage is an outbound email persisted until it is delivered
+type QueuedMessage struct {
+	ID                uuid.UUID   `gorm:"type:char(36);primarykey" json:"id"`
+	To                string      `gorm:"column:recipient;type:varchar(255);not null" json:"to"`
+	ReplyTo           string      `gorm:"type:varchar(255)" json:"reply_to,omitempty"`
+	CC                []string    `gorm:"type:json;serializer:json" json:"cc,omitempty"`
+	BCC               []string    `gorm:"type:json;serializer:json" json:"bcc,omitempty"`
+	Subject           string      `gorm:"type:varchar(255);not null" json:"subject"`
+	Body              string      `gorm:"type:mediumtext;not null" json:"body"`
+	IsHTML            bool        `gorm:"not null;default:false" json:"is_html"`
+	Reference         string      `gorm:"type:varchar(255);index" json:"reference,omitempty"`
+	Status            QueueStatus `gorm:"type:varchar(20);not null;default:pending" json:"status"`
+	Attempts          int         `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts       int         `gorm:"not null;default:5" json:"max_attempts"`
+	NextAttemptAt     time.Time   `gorm:"not null" json:"next_attempt_at"`
+	LockedUntil       *time.Time  `json:"locked_until,omitempty"`
+	LastError         string      `gorm:"type:text" json:"last_error,omitempty"`
+	ProviderMessageID string      `gorm:"type:varchar(255)" json:"provider_message_id,omitempty"`
+	SentAt            *time.Time  `json:"sent_at,omitempty"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+}
+
+// TableName overrides the default table name
+func (QueuedMessage) TableName() string {
+	return "email_queue"
+}
+
+// Message returns what is to be delivered
+func (m *QueuedMessage) Message() *Message {
+	return &Message{
+		To:      m.To,
+		ReplyTo: m.ReplyTo,
+		CC:      m.CC,
+		BCC:     m.BCC,
+		Subject: m.Subject,
+		Body:    m.Body,
+		IsHTML:  m.IsHTML,
+	}
+}
+
+// BeforeCreate assigns an ID so the message can be referenced after insert
+func (m *QueuedMessage) BeforeCreate(_ *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// QueueConfig controls the outbound queue and its worker pool
+type QueueConfig struct {
+	Workers        int
+	BatchSize      int
+	PollInterval   time.Duration
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	LockTimeout    time.Duration
+}
+
+// NewQueueConfig builds the queue configuration from the application config
+func NewQueueConfig(cfg *config.Config) QueueConfig {
+	q := cfg.Email.Queue
+	return QueueConfig{
+		Workers:        q.Workers,
+		BatchSize:      q.BatchSize,
+		PollInterval:   q.PollInterval,
+		MaxAttempts:    q.MaxAttempts,
+		InitialBackoff: q.InitialBackoff,
+		MaxBackoff:     q.MaxBackoff,
+		LockTimeout:    q.LockTimeout,
+	}
+}
+
+// Queue accepts messages for asynchronous delivery
+type Queue interface {
+	// Enqueue persists a message; it is delivered later by the worker pool
+	Enqueue(ctx context.Context, msg *QueuedMessage) error
+}
+
+// QueueParams holds the dependencies needed to create a queue
+type QueueParams struct {
+	fx.In
+
+	Repo   QueueRepository
+	Config QueueConfig
+	Logger logger.Interface
+}
+
+// DBQueue is a Queue backed by the email_queue table
+type DBQueue struct {
+	repo   QueueRepository
+	cfg    QueueConfig
+	logger logger.Interface
+}
+
+// NewQueue creates a new database-backed queue
+func NewQueue(params QueueParams) Queue {
+	return &DBQueue{
+		repo:   params.Repo,
+		cfg:    params.Config,
+		logger: params.Logger,
+	}
+}
+
+// Enqueue implements Queue
+func (q *DBQueue) Enqueue(ctx context.Context, msg *QueuedMessage) error {
+	msg.Status = QueueStatusPending
+	msg.Attempts = 0
+	if msg.MaxAttempts <= 0 {
+		msg.MaxAttempts = q.cfg.MaxAttempts
+	}
+	if msg.NextAttemptAt.IsZero() {
+		msg.NextAttemptAt = time.Now()
+	}
+
+	if err := q.repo.Create(ctx, msg); err != nil {
+		return fmt.Errorf("failed to enqueue email: %w", err)
+	}
+
+	q.logger.Debug("Email enqueued", "messageID", msg.ID, "reference", msg.Reference)
+	return nil
+}
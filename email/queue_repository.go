@@ -0,0 +1,99 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/database"
+	"go.uber.org/fx"
+	"gorm.io/gorm/clause"
+)
+
+// QueueRepository defines the persistence operations used by the queue
+type QueueRepository interface {
+	Create(ctx context.Context, msg *QueuedMessage) error
+	Update(ctx context.Context, msg *QueuedMessage) error
+	// ClaimDue locks up to limit messages that are ready to be attempted
+	ClaimDue(ctx context.Context, now time.Time, limit int, lockFor time.Duration) ([]*QueuedMessage, error)
+}
+
+// QueueRepositoryParams holds the dependencies for the queue repository
+type QueueRepositoryParams struct {
+	fx.In
+
+	DB database.Database
+}
+
+type queueRepository struct {
+	db database.Database
+}
+
+// NewQueueRepository creates a new database-backed queue repository
+func NewQueueRepository(params QueueRepositoryParams) QueueRepository {
+	return &queueRepository{db: params.DB}
+}
+
+func (r *queueRepository) Create(ctx context.Context, msg *QueuedMessage) error {
+	if err := r.db.Create(ctx, msg); err != nil {
+		return fmt.Errorf("error creating queued message: %w", err)
+	}
+	return nil
+}
+
+func (r *queueRepository) Update(ctx context.Context, msg *QueuedMessage) error {
+	if err := r.db.Update(ctx, msg); err != nil {
+		return fmt.Errorf("error updating queued message: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue selects due messages with SKIP LOCKED so several application
+// instances can poll the same table, then marks them as processing. A
+// message whose lock has expired (e.g. the process died mid-send) is
+// claimable again.
+func (r *queueRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lockFor time.Duration,
+) ([]*QueuedMessage, error) {
+	var msgs []*QueuedMessage
+
+	err := r.db.Transaction(ctx, func(tx database.Database) error {
+		err := tx.DB().
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND next_attempt_at <= ?) OR (status = ? AND locked_until <= ?)",
+				QueueStatusPending, now, QueueStatusProcessing, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&msgs).Error
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			return nil
+		}
+
+		lockedUntil := now.Add(lockFor)
+		ids := make([]uuid.UUID, len(msgs))
+		for i, msg := range msgs {
+			ids[i] = msg.ID
+			msg.Status = QueueStatusProcessing
+			msg.LockedUntil = &lockedUntil
+		}
+
+		return tx.DB().Model(&QueuedMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":       QueueStatusProcessing,
+				"locked_until": lockedUntil,
+			}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error claiming queued messages: %w", err)
+	}
+
+	return msgs, nil
+}
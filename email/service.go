@@ -5,7 +5,11 @@ import (
 )
 
 type Service interface {
-	SendEmail(to string, subject string, body string, isHTML bool) error
+	// Send sends a message and returns the provider's message ID
+	Send(msg *Message) (string, error)
+	// SendEmail sends a message to a single recipient and returns the
+	// provider's message ID
+	SendEmail(to string, subject string, body string, isHTML bool) (string, error)
 	SendPasswordReset(to string, resetToken string) error
 }
 
@@ -29,7 +33,8 @@ If you did not request this reset, please ignore this email.
 Best regards,
 Your Application Team`, resetToken)
 
-	return s.SendEmail(to, subject, body, false)
+	_, err := s.SendEmail(to, subject, body, false)
+	return err
 }
 
 func (s *MailgunEmailService) SendPasswordReset(to string, resetToken string) error {
@@ -44,5 +49,6 @@ If you did not request this reset, please ignore this email.
 Best regards,
 Your Application Team`, resetToken)
 
-	return s.SendEmail(to, subject, body, false)
+	_, err := s.SendEmail(to, subject, body, false)
+	return err
 }
@@ -0,0 +1,249 @@
+package email
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// blankLines matches runs of three or more line breaks
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// PlainText derives the plain-text alternative of an HTML body. Paragraphs
+// and headings are separated by blank lines, list items are bulleted or
+// numbered, and links are kept as numbered footnotes listed at the end.
+func PlainText(body string) string {
+	doc, err := nethtml.Parse(strings.NewReader(body))
+	if err != nil {
+		return body
+	}
+
+	w := &textWriter{}
+	w.walk(doc)
+
+	text := w.b.String()
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	text = strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+
+	if len(w.links) > 0 {
+		var footnotes strings.Builder
+		for i, link := range w.links {
+			fmt.Fprintf(&footnotes, "\n[%d] %s", i+1, link)
+		}
+		text += "\n\n" + strings.TrimPrefix(footnotes.String(), "\n")
+	}
+	return text
+}
+
+// textWriter accumulates the plain text of an HTML tree
+type textWriter struct {
+	b     strings.Builder
+	links []string
+	// lists holds the next item number of each open list; 0 marks a
+	// bulleted list
+	lists []int
+	pre   int
+}
+
+func (w *textWriter) walk(n *nethtml.Node) {
+	switch n.Type {
+	case nethtml.TextNode:
+		w.text(n.Data)
+		return
+	case nethtml.ElementNode:
+	default:
+		w.children(n)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Head, atom.Title:
+		return
+	case atom.Br:
+		w.b.WriteString("\n")
+	case atom.Img:
+		if alt := attr(n, "alt"); alt != "" {
+			w.text(alt)
+		}
+	case atom.A:
+		w.link(n)
+	case atom.Ul, atom.Ol:
+		next := 0
+		if n.DataAtom == atom.Ol {
+			next = 1
+		}
+		// Nested lists continue their parent item's list without a gap
+		nested := len(w.lists) > 0
+		w.lists = append(w.lists, next)
+		w.listBreak(nested)
+		w.children(n)
+		w.lists = w.lists[:len(w.lists)-1]
+		w.listBreak(nested)
+	case atom.Li:
+		w.newline()
+		w.listMarker()
+		w.children(n)
+		w.newline()
+	case atom.Pre:
+		w.block()
+		w.pre++
+		w.children(n)
+		w.pre--
+		w.block()
+	case atom.Tr:
+		w.newline()
+		w.children(n)
+		w.newline()
+	case atom.Td, atom.Th:
+		w.children(n)
+		w.b.WriteString(" ")
+	case atom.P, atom.Div, atom.Blockquote, atom.Table,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Hr:
+		w.block()
+		w.children(n)
+		w.block()
+	default:
+		w.children(n)
+	}
+}
+
+func (w *textWriter) children(n *nethtml.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		w.walk(c)
+	}
+}
+
+// text writes a text node, collapsing whitespace outside <pre>
+func (w *textWriter) text(s string) {
+	if w.pre > 0 {
+		w.b.WriteString(s)
+		return
+	}
+
+	collapsed := strings.Join(strings.Fields(s), " ")
+	if s != "" && isSpace(s[0]) && !w.atLineStart() && !w.endsWithSpace() {
+		collapsed = " " + collapsed
+	}
+	if collapsed != "" && collapsed != " " && isSpace(s[len(s)-1]) {
+		collapsed += " "
+	}
+	w.b.WriteString(collapsed)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func (w *textWriter) endsWithSpace() bool {
+	return strings.HasSuffix(w.b.String(), " ")
+}
+
+// link writes a link's text followed by a footnote marker. Links whose
+// text is already the URL, and links that go nowhere useful, get no footnote.
+func (w *textWriter) link(n *nethtml.Node) {
+	start := w.b.Len()
+	w.children(n)
+	label := strings.TrimSpace(w.b.String()[start:])
+
+	href := strings.TrimSpace(attr(n, "href"))
+	lower := strings.ToLower(href)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") &&
+		!strings.HasPrefix(lower, "mailto:") {
+		return
+	}
+	if label == href || "mailto:"+label == href {
+		return
+	}
+	if label == "" {
+		w.text(href)
+		return
+	}
+
+	if text := w.b.String(); strings.HasSuffix(text, " ") {
+		w.b.Reset()
+		w.b.WriteString(strings.TrimRight(text, " "))
+		defer w.b.WriteString(" ")
+	}
+	w.links = append(w.links, href)
+	fmt.Fprintf(&w.b, " [%d]", len(w.links))
+}
+
+func (w *textWriter) listMarker() {
+	if len(w.lists) == 0 {
+		w.b.WriteString("- ")
+		return
+	}
+	indent := strings.Repeat("  ", len(w.lists)-1)
+	top := len(w.lists) - 1
+	if w.lists[top] == 0 {
+		w.b.WriteString(indent + "- ")
+		return
+	}
+	fmt.Fprintf(&w.b, "%s%d. ", indent, w.lists[top])
+	w.lists[top]++
+}
+
+// newline ends the current line unless it is already empty
+func (w *textWriter) newline() {
+	if !w.atLineStart() {
+		w.b.WriteString("\n")
+	}
+}
+
+// block separates block-level content with a blank line
+func (w *textWriter) block() {
+	if w.b.Len() == 0 {
+		return
+	}
+	w.newline()
+	if !strings.HasSuffix(w.b.String(), "\n\n") {
+		w.b.WriteString("\n")
+	}
+}
+
+func (w *textWriter) listBreak(nested bool) {
+	if nested {
+		w.newline()
+		return
+	}
+	w.block()
+}
+
+func (w *textWriter) atLineStart() bool {
+	s := w.b.String()
+	return s == "" || strings.HasSuffix(s, "\n")
+}
+
+func attr(n *nethtml.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// htmlFromText wraps a plain-text body as HTML for the HTML alternative:
+// blank lines separate paragraphs and single line breaks are kept
+func htmlFromText(text string) string {
+	var b strings.Builder
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		lines := strings.Split(paragraph, "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+		b.WriteString("<p>" + strings.Join(lines, "<br>\n") + "</p>\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,67 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "paragraphs and line breaks",
+			html: "<p>Dear Jane Doe,</p>\n<p>I live in Ottawa<br>and vote.</p>",
+			want: "Dear Jane Doe,\n\nI live in Ottawa\nand vote.",
+		},
+		{
+			name: "inline formatting and entities",
+			html: "<p>It&#39;s <strong>urgent</strong> &amp; <em>overdue</em>.</p>",
+			want: "It's urgent & overdue.",
+		},
+		{
+			name: "links become footnotes",
+			html: `<p>See <a href="https://example.com/bill">the bill</a> and <a href="https://example.com/vote">the vote</a>.</p>`,
+			want: "See the bill [1] and the vote [2].\n\n[1] https://example.com/bill\n[2] https://example.com/vote",
+		},
+		{
+			name: "links that show their URL get no footnote",
+			html: `<p><a href="https://example.com">https://example.com</a> or <a href="mailto:mp@example.ca">mp@example.ca</a></p>`,
+			want: "https://example.com or mp@example.ca",
+		},
+		{
+			name: "relative and script links are dropped",
+			html: `<p><a href="/campaign/1">Campaign</a> <a href="javascript:alert(1)">here</a></p>`,
+			want: "Campaign here",
+		},
+		{
+			name: "lists",
+			html: "<p>Asks:</p><ol><li>Fund transit</li><li>Build housing<ul><li>near stations</li></ul></li></ol><p>Thanks</p>",
+			want: "Asks:\n\n1. Fund transit\n2. Build housing\n  - near stations\n\nThanks",
+		},
+		{
+			name: "scripts and styles are skipped",
+			html: "<style>p { color: red }</style><p>Hello</p><script>alert(1)</script>",
+			want: "Hello",
+		},
+		{
+			name: "accented text",
+			html: "<p>Chère députée, merci.</p>",
+			want: "Chère députée, merci.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PlainText(tt.html))
+		})
+	}
+}
+
+func TestHTMLFromText(t *testing.T) {
+	got := htmlFromText("Hello <you>,\r\n\r\nClick the link\nbelow.\n")
+	assert.Equal(t, "<p>Hello &lt;you&gt;,</p>\n<p>Click the link<br>\nbelow.</p>\n", got)
+}
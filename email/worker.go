@@ -0,0 +1,191 @@
+package email
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+)
+
+// DeliveryListener is notified when a queued message reaches a final state.
+// Implementations are collected through the "email_delivery_listeners" fx group.
+type DeliveryListener interface {
+	// MessageSent is called once the provider has accepted the message
+	MessageSent(ctx context.Context, msg *QueuedMessage)
+	// MessageDead is called when a message has exhausted its attempts
+	MessageDead(ctx context.Context, msg *QueuedMessage)
+}
+
+// WorkerPoolParams holds the dependencies for the worker pool
+type WorkerPoolParams struct {
+	fx.In
+
+	Repo      QueueRepository
+	Sender    Service
+	Config    QueueConfig
+	Logger    logger.Interface
+	Listeners []DeliveryListener `group:"email_delivery_listeners"`
+}
+
+// WorkerPool polls the queue and delivers messages concurrently
+type WorkerPool struct {
+	repo      QueueRepository
+	sender    Service
+	cfg       QueueConfig
+	logger    logger.Interface
+	listeners []DeliveryListener
+	now       func() time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWorkerPool creates a new worker pool
+func NewWorkerPool(params WorkerPoolParams) *WorkerPool {
+	return &WorkerPool{
+		repo:      params.Repo,
+		sender:    params.Sender,
+		cfg:       params.Config,
+		logger:    params.Logger,
+		listeners: params.Listeners,
+		now:       time.Now,
+	}
+}
+
+// Start launches the dispatcher and workers
+func (p *WorkerPool) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	p.cancel = cancel
+
+	workers := max(p.cfg.Workers, 1)
+	jobs := make(chan *QueuedMessage)
+
+	p.logger.Info("Starting email worker pool", "workers", workers, "pollInterval", p.cfg.PollInterval)
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer close(jobs)
+		p.dispatch(ctx, jobs)
+	}()
+
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for msg := range jobs {
+				// Let an in-flight delivery finish recording its outcome on shutdown
+				p.process(context.WithoutCancel(ctx), msg)
+			}
+		}()
+	}
+}
+
+// Stop signals the pool to stop and waits for in-flight messages
+func (p *WorkerPool) Stop(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.logger.Info("Email worker pool stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *WorkerPool) dispatch(ctx context.Context, jobs chan<- *QueuedMessage) {
+	ticker := time.NewTicker(p.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			msgs, err := p.repo.ClaimDue(ctx, p.now(), p.cfg.BatchSize, p.cfg.LockTimeout)
+			if err != nil {
+				p.logger.Error("Failed to claim queued emails", err)
+				continue
+			}
+			for _, msg := range msgs {
+				select {
+				case jobs <- msg:
+				case <-ctx.Done():
+					// Unsent claims are picked up again once their lock expires
+					return
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// process makes a single delivery attempt and records the outcome
+func (p *WorkerPool) process(ctx context.Context, msg *QueuedMessage) {
+	msg.Attempts++
+	msg.LockedUntil = nil
+
+	messageID, err := p.sender.Send(msg.Message())
+	if err == nil {
+		sentAt := p.now()
+		msg.Status = QueueStatusSent
+		msg.ProviderMessageID = messageID
+		msg.SentAt = &sentAt
+		msg.LastError = ""
+	} else {
+		msg.LastError = err.Error()
+		if msg.Attempts >= msg.MaxAttempts {
+			msg.Status = QueueStatusDead
+		} else {
+			msg.Status = QueueStatusPending
+			msg.NextAttemptAt = p.now().Add(p.retryDelay(msg.Attempts))
+		}
+	}
+
+	if updateErr := p.repo.Update(ctx, msg); updateErr != nil {
+		p.logger.Error("Failed to update queued email", updateErr, "messageID", msg.ID)
+	}
+
+	switch msg.Status {
+	case QueueStatusSent:
+		p.logger.Info("Queued email sent", "messageID", msg.ID, "attempts", msg.Attempts)
+		for _, l := range p.listeners {
+			l.MessageSent(ctx, msg)
+		}
+	case QueueStatusDead:
+		p.logger.Error("Queued email moved to dead letter", err, "messageID", msg.ID, "attempts", msg.Attempts)
+		for _, l := range p.listeners {
+			l.MessageDead(ctx, msg)
+		}
+	default:
+		p.logger.Warn("Queued email attempt failed", "error", err, "messageID", msg.ID,
+			"attempts", msg.Attempts, "nextAttemptAt", msg.NextAttemptAt)
+	}
+}
+
+// retryDelay returns the exponential backoff delay following the given attempt
+func (p *WorkerPool) retryDelay(attempt int) time.Duration {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = p.cfg.InitialBackoff
+	b.MaxInterval = p.cfg.MaxBackoff
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	delay := b.NextBackOff()
+	for i := 1; i < attempt; i++ {
+		delay = b.NextBackOff()
+	}
+	return delay
+}
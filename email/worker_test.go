@@ -0,0 +1,122 @@
+package email_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/email"
+	mocksEmail "github.com/jonesrussell/mp-emailer/mocks/email"
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testQueueConfig() email.QueueConfig {
+	return email.QueueConfig{
+		Workers:        1,
+		BatchSize:      10,
+		PollInterval:   5 * time.Millisecond,
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Hour,
+		LockTimeout:    time.Minute,
+	}
+}
+
+// runPool starts a pool that claims msg once and returns it after its first update
+func runPool(
+	t *testing.T,
+	msg *email.QueuedMessage,
+	sender *mocksEmail.MockService,
+	listener *mocksEmail.MockDeliveryListener,
+) *email.QueuedMessage {
+	t.Helper()
+
+	repo := mocksEmail.NewMockQueueRepository(t)
+	log := mocksLogger.NewMockInterface(t)
+	log.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Info", mock.Anything).Return().Maybe()
+	log.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+	repo.EXPECT().ClaimDue(mock.Anything, mock.Anything, 10, time.Minute).Return([]*email.QueuedMessage{msg}, nil).Once()
+	repo.EXPECT().ClaimDue(mock.Anything, mock.Anything, 10, time.Minute).Return(nil, nil).Maybe()
+
+	updated := make(chan *email.QueuedMessage, 1)
+	repo.EXPECT().Update(mock.Anything, msg).
+		Run(func(_ context.Context, m *email.QueuedMessage) { updated <- m }).
+		Return(nil).Once()
+
+	pool := email.NewWorkerPool(email.WorkerPoolParams{
+		Repo:      repo,
+		Sender:    sender,
+		Config:    testQueueConfig(),
+		Logger:    log,
+		Listeners: []email.DeliveryListener{listener},
+	})
+	pool.Start(context.Background())
+	defer func() {
+		require.NoError(t, pool.Stop(context.Background()))
+	}()
+
+	select {
+	case m := <-updated:
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("queued message was not processed")
+		return nil
+	}
+}
+
+func TestWorkerPool_DeliversMessage(t *testing.T) {
+	sender := mocksEmail.NewMockService(t)
+	listener := mocksEmail.NewMockDeliveryListener(t)
+	msg := &email.QueuedMessage{To: "mp@example.com", Subject: "Subject", Body: "Body", IsHTML: true, MaxAttempts: 3}
+
+	sender.EXPECT().Send(msg.Message()).Return("<id@example.com>", nil).Once()
+	listener.EXPECT().MessageSent(mock.Anything, msg).Return().Once()
+
+	got := runPool(t, msg, sender, listener)
+
+	assert.Equal(t, email.QueueStatusSent, got.Status)
+	assert.Equal(t, 1, got.Attempts)
+	assert.Equal(t, "<id@example.com>", got.ProviderMessageID)
+	assert.NotNil(t, got.SentAt)
+}
+
+func TestWorkerPool_SchedulesRetryWithBackoff(t *testing.T) {
+	sender := mocksEmail.NewMockService(t)
+	listener := mocksEmail.NewMockDeliveryListener(t)
+	msg := &email.QueuedMessage{To: "mp@example.com", Subject: "Subject", Body: "Body", MaxAttempts: 3}
+
+	sender.EXPECT().Send(msg.Message()).Return("", errors.New("smtp timeout")).Once()
+
+	before := time.Now()
+	got := runPool(t, msg, sender, listener)
+
+	assert.Equal(t, email.QueueStatusPending, got.Status)
+	assert.Equal(t, 1, got.Attempts)
+	assert.Equal(t, "smtp timeout", got.LastError)
+	// Default randomization keeps the first delay within 50% of the initial interval
+	assert.True(t, got.NextAttemptAt.After(before.Add(29*time.Second)))
+	assert.Nil(t, got.LockedUntil)
+}
+
+func TestWorkerPool_MovesToDeadLetterAfterMaxAttempts(t *testing.T) {
+	sender := mocksEmail.NewMockService(t)
+	listener := mocksEmail.NewMockDeliveryListener(t)
+	msg := &email.QueuedMessage{To: "mp@example.com", Subject: "Subject", Body: "Body", Attempts: 2, MaxAttempts: 3}
+
+	sender.EXPECT().Send(msg.Message()).Return("", errors.New("rejected")).Once()
+	listener.EXPECT().MessageDead(mock.Anything, msg).Return().Once()
+
+	got := runPool(t, msg, sender, listener)
+
+	assert.Equal(t, email.QueueStatusDead, got.Status)
+	assert.Equal(t, 3, got.Attempts)
+	assert.Equal(t, "rejected", got.LastError)
+}
@@ -0,0 +1,68 @@
+package httpclient
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker is a host's circuit breaker. After threshold failed requests in a
+// row it opens, and requests fail fast for the cooldown; then a single
+// request is let through to probe the host, which closes the breaker if it
+// succeeds and opens it again if it fails.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow reports whether a request may be made
+func (b *breaker) allow(now time.Time) bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch {
+	case b.failures < b.threshold:
+		return true
+	case now.Before(b.openUntil), b.probing:
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record notes how an allowed request went, and reports whether that opened
+// the breaker
+func (b *breaker) record(failed bool, now time.Time) bool {
+	if b.threshold <= 0 {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return false
+	}
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+	b.openUntil = now.Add(b.cooldown)
+	return true
+}
+
+// release gives up an allowed request that was cancelled by its caller,
+// which says nothing about the host
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
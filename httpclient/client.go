@@ -0,0 +1,227 @@
+// Package httpclient makes requests to external services, such as the
+// Represent API, with timeouts, retries and a circuit breaker per host.
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/logger"
+)
+
+// maxBodySize bounds how much of a response body is read
+const maxBodySize = 10 << 20
+
+// Options controls how requests are made
+type Options struct {
+	// Timeout bounds each attempt of a request
+	Timeout time.Duration
+	// MaxRetries is how many times a request is retried after a server
+	// error, a timeout or rate limiting
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, doubled for each
+	// retry after it up to MaxBackoff, with jitter
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// BreakerThreshold is how many failed requests in a row open a host's
+	// circuit breaker for BreakerCooldown; zero turns the breaker off
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// Client is an HTTP client for external services
+type Client struct {
+	http     *http.Client
+	opts     Options
+	mu       sync.Mutex
+	breakers map[string]*breaker
+	now      func() time.Time
+	sleep    func(ctx context.Context, d time.Duration) error
+	Logger   logger.Interface
+}
+
+// New creates a new instance of Client
+func New(opts Options, log logger.Interface) *Client {
+	return &Client{
+		http:     &http.Client{},
+		opts:     opts,
+		breakers: make(map[string]*breaker),
+		now:      time.Now,
+		sleep:    sleep,
+		Logger:   log,
+	}
+}
+
+// GetJSON fetches a URL and decodes its JSON body into v
+func (c *Client) GetJSON(ctx context.Context, rawURL string, v interface{}) error {
+	body, err := c.Get(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("%w: GET %s: %w", ErrInvalidResponse, rawURL, err)
+	}
+	return nil
+}
+
+// Get fetches a URL and returns the body of a successful response. Server
+// errors, timeouts and rate limiting are retried with jittered backoff,
+// waiting as long as a Retry-After header asks when it isn't longer than
+// MaxBackoff. Other unsuccessful responses are returned as a *StatusError.
+func (c *Client) Get(ctx context.Context, rawURL string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	host := u.Host
+	b := c.breaker(host)
+	if !b.allow(c.now()) {
+		return nil, fmt.Errorf("%w: %s", ErrCircuitOpen, host)
+	}
+
+	var body []byte
+	for attempt := 0; ; attempt++ {
+		var retryAfter time.Duration
+		body, retryAfter, err = c.attempt(ctx, rawURL)
+		if err == nil || !retryable(err) || attempt >= c.opts.MaxRetries {
+			break
+		}
+
+		wait := c.backoff(attempt)
+		if retryAfter > 0 {
+			if retryAfter > c.opts.MaxBackoff {
+				break
+			}
+			wait = retryAfter
+		}
+		c.Logger.Warn("Retrying request", "url", rawURL, "attempt", attempt+1, "wait", wait, "error", err)
+		if c.sleep(ctx, wait) != nil {
+			break
+		}
+	}
+
+	if err != nil && ctx.Err() != nil {
+		b.release()
+		return nil, err
+	}
+	if b.record(retryable(err), c.now()) {
+		c.Logger.Warn("Circuit breaker opened", "host", host, "cooldown", c.opts.BreakerCooldown, "error", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// attempt makes one request, bounded by the attempt timeout
+func (c *Client) attempt(ctx context.Context, rawURL string) ([]byte, time.Duration, error) {
+	attemptCtx := ctx
+	if c.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, c.opts.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(attemptCtx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, 0, transportError(ctx, rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, 0, transportError(ctx, rawURL, err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		statusErr := &StatusError{Method: http.MethodGet, URL: rawURL, StatusCode: resp.StatusCode}
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After"), c.now()), statusErr
+	}
+	return body, 0, nil
+}
+
+// breaker returns the circuit breaker of a host
+func (c *Client) breaker(host string) *breaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[host]
+	if !ok {
+		b = &breaker{threshold: c.opts.BreakerThreshold, cooldown: c.opts.BreakerCooldown}
+		c.breakers[host] = b
+	}
+	return b
+}
+
+// backoff picks the wait before a retry: the attempt's exponential backoff,
+// less up to half of it at random so that clients don't retry in step
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.opts.InitialBackoff
+	for i := 0; i < attempt && d < c.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, c.opts.MaxBackoff)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// transportError classifies a request that got no response. The caller's
+// own cancellation is returned as is; the attempt running out of time is a
+// timeout; anything else means the service couldn't be reached.
+func transportError(ctx context.Context, rawURL string, err error) error {
+	switch {
+	case ctx.Err() != nil:
+		return fmt.Errorf("GET %s: %w", rawURL, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return fmt.Errorf("%w: GET %s: %w", ErrTimeout, rawURL, err)
+	default:
+		return fmt.Errorf("%w: GET %s: %w", ErrUnavailable, rawURL, err)
+	}
+}
+
+// retryable reports whether a failed request may succeed if made again,
+// which is also what counts against a host's circuit breaker
+func retryable(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, ErrUnavailable) || errors.Is(err, ErrRateLimited)
+}
+
+// parseRetryAfter reads a Retry-After header, given either in seconds or as
+// a date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return 0
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,236 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient creates a client whose waits are recorded instead of slept
+func newTestClient(t *testing.T, opts Options) (*Client, *[]time.Duration) {
+	t.Helper()
+
+	log := mocksLogger.NewMockInterface(t)
+	log.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+	client := New(opts, log)
+	var waits []time.Duration
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return client, &waits
+}
+
+// newTestServer answers each request with the next of responses, repeating
+// the last one, and counts the requests
+func newTestServer(t *testing.T, responses ...func(w http.ResponseWriter)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := int(calls.Add(1)) - 1
+		responses[min(n, len(responses)-1)](w)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func status(code int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(code)
+	}
+}
+
+func body(s string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		_, _ = w.Write([]byte(s))
+	}
+}
+
+var defaultOptions = Options{
+	Timeout:        time.Second,
+	MaxRetries:     2,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     time.Second,
+}
+
+func TestGetJSON(t *testing.T) {
+	t.Run("server errors are retried", func(t *testing.T) {
+		server, calls := newTestServer(t, status(http.StatusBadGateway), status(http.StatusServiceUnavailable),
+			body(`{"name": "Jane Doe"}`))
+		client, waits := newTestClient(t, defaultOptions)
+
+		var result struct{ Name string }
+		err := client.GetJSON(context.Background(), server.URL, &result)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", result.Name)
+		assert.Equal(t, int32(3), calls.Load())
+		require.Len(t, *waits, 2)
+		assert.GreaterOrEqual(t, (*waits)[0], 50*time.Millisecond)
+		assert.LessOrEqual(t, (*waits)[0], 100*time.Millisecond)
+		assert.GreaterOrEqual(t, (*waits)[1], 100*time.Millisecond)
+		assert.LessOrEqual(t, (*waits)[1], 200*time.Millisecond)
+	})
+
+	t.Run("server errors give up after the retries", func(t *testing.T) {
+		server, calls := newTestServer(t, status(http.StatusInternalServerError))
+		client, _ := newTestClient(t, defaultOptions)
+
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+
+		assert.ErrorIs(t, err, ErrUnavailable)
+		var statusErr *StatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("rate limiting waits as Retry-After asks", func(t *testing.T) {
+		server, calls := newTestServer(t, func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}, body(`{}`))
+		client, waits := newTestClient(t, defaultOptions)
+
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+		assert.Equal(t, []time.Duration{time.Second}, *waits)
+	})
+
+	t.Run("rate limiting for longer than the backoff isn't waited out", func(t *testing.T) {
+		server, calls := newTestServer(t, func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		client, waits := newTestClient(t, defaultOptions)
+
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+
+		assert.ErrorIs(t, err, ErrRateLimited)
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Empty(t, *waits)
+	})
+
+	t.Run("client errors aren't retried", func(t *testing.T) {
+		server, calls := newTestServer(t, status(http.StatusNotFound))
+		client, _ := newTestClient(t, defaultOptions)
+
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+
+		var statusErr *StatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+		assert.False(t, errors.Is(err, ErrUnavailable))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("an HTML error page is an invalid response", func(t *testing.T) {
+		server, _ := newTestServer(t, body("<html><body>Oops</body></html>"))
+		client, _ := newTestClient(t, defaultOptions)
+
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+
+		assert.ErrorIs(t, err, ErrInvalidResponse)
+	})
+
+	t.Run("slow responses time out", func(t *testing.T) {
+		release := make(chan struct{})
+		server, calls := newTestServer(t, func(w http.ResponseWriter) {
+			<-release
+			w.WriteHeader(http.StatusOK)
+		})
+		defer close(release)
+		opts := defaultOptions
+		opts.Timeout = 20 * time.Millisecond
+		opts.MaxRetries = 1
+		client, _ := newTestClient(t, opts)
+
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+
+		assert.ErrorIs(t, err, ErrTimeout)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	healthy := atomic.Bool{}
+	server, calls := newTestServer(t, func(w http.ResponseWriter) {
+		if healthy.Load() {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	opts := defaultOptions
+	opts.MaxRetries = 0
+	opts.BreakerThreshold = 2
+	opts.BreakerCooldown = time.Minute
+	client, _ := newTestClient(t, opts)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	for range 2 {
+		err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+		assert.ErrorIs(t, err, ErrUnavailable)
+	}
+
+	// The breaker is open: requests fail without reaching the server
+	err := client.GetJSON(context.Background(), server.URL, &struct{}{})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// After the cooldown a failed probe opens it again
+	now = now.Add(time.Minute)
+	err = client.GetJSON(context.Background(), server.URL, &struct{}{})
+	assert.ErrorIs(t, err, ErrUnavailable)
+	err = client.GetJSON(context.Background(), server.URL, &struct{}{})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	// and a successful one closes it
+	now = now.Add(time.Minute)
+	healthy.Store(true)
+	require.NoError(t, client.GetJSON(context.Background(), server.URL, &struct{}{}))
+	require.NoError(t, client.GetJSON(context.Background(), server.URL, &struct{}{}))
+	assert.Equal(t, int32(5), calls.Load())
+}
+
+func TestCircuitBreakerIgnoresCancelledRequests(t *testing.T) {
+	server, _ := newTestServer(t, status(http.StatusServiceUnavailable))
+	opts := defaultOptions
+	opts.BreakerThreshold = 1
+	client, _ := newTestClient(t, opts)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.GetJSON(ctx, server.URL, &struct{}{})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, errors.Is(err, ErrUnavailable))
+	assert.True(t, client.breaker(server.Listener.Addr().String()).allow(time.Now()))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 12, 23, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Mon, 23 Dec 2024 12:01:30 GMT", now))
+	assert.Zero(t, parseRetryAfter("Mon, 23 Dec 2024 11:00:00 GMT", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+	assert.Zero(t, parseRetryAfter("", now))
+}
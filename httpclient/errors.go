@@ -0,0 +1,44 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrCircuitOpen is returned without making a request while a host's
+	// circuit breaker is open
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+	// ErrTimeout is returned when a request ran out of time
+	ErrTimeout = errors.New("request timed out")
+	// ErrRateLimited is returned when the service kept answering 429
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnavailable is returned when the service kept failing, either with
+	// a server error or without answering at all
+	ErrUnavailable = errors.New("service unavailable")
+	// ErrInvalidResponse is returned when a response body can't be decoded
+	ErrInvalidResponse = errors.New("invalid response")
+)
+
+// StatusError reports a response with an unsuccessful status code. It
+// unwraps to ErrRateLimited for 429 and to ErrUnavailable for server errors.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s %s: unexpected status %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (e *StatusError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrUnavailable
+	}
+	return nil
+}
@@ -24,7 +24,6 @@ type BaseTestSuite struct {
 	CampaignService             *mocksCampaign.MockServiceInterface
 	RepresentativeLookupService *mocksCampaign.MockRepresentativeLookupServiceInterface
 	EmailService                *mocksEmail.MockService
-	CampaignClient              *mocksCampaign.MockClientInterface
 	ErrorHandler                *mocksShared.MockErrorHandlerInterface
 	TemplateRenderer            *mocksShared.MockTemplateRendererInterface
 	Config                      *config.Config
@@ -38,7 +37,6 @@ func (s *BaseTestSuite) SetupTest() {
 	s.CampaignService = mocksCampaign.NewMockServiceInterface(s.T())
 	s.RepresentativeLookupService = mocksCampaign.NewMockRepresentativeLookupServiceInterface(s.T())
 	s.EmailService = mocksEmail.NewMockService(s.T())
-	s.CampaignClient = mocksCampaign.NewMockClientInterface(s.T())
 	s.ErrorHandler = mocksShared.NewMockErrorHandlerInterface(s.T())
 	s.TemplateRenderer = mocksShared.NewMockTemplateRendererInterface(s.T())
 	s.Config = &config.Config{}
@@ -50,7 +48,6 @@ func (s *BaseTestSuite) TearDownTest() {
 	s.CampaignService.AssertExpectations(s.T())
 	s.RepresentativeLookupService.AssertExpectations(s.T())
 	s.EmailService.AssertExpectations(s.T())
-	s.CampaignClient.AssertExpectations(s.T())
 	s.ErrorHandler.AssertExpectations(s.T())
 	s.TemplateRenderer.AssertExpectations(s.T())
 }
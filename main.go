@@ -5,12 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 
 	"github.com/jonesrussell/mp-emailer/api"
 	"github.com/jonesrussell/mp-emailer/campaign"
+	"github.com/jonesrussell/mp-emailer/cli"
 	"github.com/jonesrussell/mp-emailer/config"
+	"github.com/jonesrussell/mp-emailer/email"
 	"github.com/jonesrussell/mp-emailer/logger"
 	appMiddleware "github.com/jonesrussell/mp-emailer/middleware"
+	"github.com/jonesrussell/mp-emailer/organization"
 	"github.com/jonesrussell/mp-emailer/server"
 	"github.com/jonesrussell/mp-emailer/session"
 	"github.com/jonesrussell/mp-emailer/shared"
@@ -20,12 +24,19 @@ import (
 )
 
 func main() {
+	// Subcommands such as "campaigns export" run instead of the server
+	if len(os.Args) > 1 {
+		os.Exit(cli.Run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	// Initialize application using uber/fx dependency injection
 	app := fx.New(
 		fx.Options(
 			shared.App,
 			session.Module,
+			email.QueueModule,
 			campaign.Module,
+			organization.Module,
 			user.Module,
 			server.Module,
 			api.Module,
@@ -42,6 +53,7 @@ func registerRoutes(
 	e *echo.Echo,
 	serverHandler server.HandlerInterface,
 	campaignHandler *campaign.Handler,
+	organizationHandler *organization.Handler,
 	userHandler *user.Handler,
 	apiHandler *api.Handler,
 	renderer shared.TemplateRendererInterface,
@@ -56,7 +68,8 @@ func registerRoutes(
 	middlewareManager.Register(e)
 
 	// Register route handlers after middleware
-	registerHandlers(e, serverHandler, campaignHandler, userHandler, apiHandler, middlewareManager, sessionManager)
+	registerHandlers(e, serverHandler, campaignHandler, organizationHandler, userHandler, apiHandler,
+		middlewareManager, sessionManager)
 
 	// Serve static files
 	e.Static("/static", "web/public")
@@ -67,6 +80,7 @@ func registerHandlers(
 	e *echo.Echo,
 	serverHandler server.HandlerInterface,
 	campaignHandler *campaign.Handler,
+	organizationHandler *organization.Handler,
 	userHandler *user.Handler,
 	apiHandler *api.Handler,
 	middlewareManager *appMiddleware.Manager,
@@ -74,6 +88,7 @@ func registerHandlers(
 ) {
 	server.RegisterRoutes(serverHandler, e)
 	campaign.RegisterRoutes(campaignHandler, e, sessionManager)
+	organization.RegisterRoutes(organizationHandler, e, sessionManager)
 	user.RegisterRoutes(userHandler, e)
 	api.RegisterRoutes(apiHandler, e, middlewareManager)
 }
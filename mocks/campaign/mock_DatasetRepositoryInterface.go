@@ -0,0 +1,145 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDatasetRepositoryInterface is an autogenerated mock type for the DatasetRepositoryInterface type
+type MockDatasetRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockDatasetRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetRepositoryInterface) EXPECT() *MockDatasetRepositoryInterface_Expecter {
+	return &MockDatasetRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// FindByPostalCode provides a mock function with given fields: ctx, postalCode
+func (_m *MockDatasetRepositoryInterface) FindByPostalCode(ctx context.Context, postalCode string) ([]campaign.Representative, error) {
+	ret := _m.Called(ctx, postalCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPostalCode")
+	}
+
+	var r0 []campaign.Representative
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]campaign.Representative, error)); ok {
+		return rf(ctx, postalCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []campaign.Representative); ok {
+		r0 = rf(ctx, postalCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Representative)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postalCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRepositoryInterface_FindByPostalCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPostalCode'
+type MockDatasetRepositoryInterface_FindByPostalCode_Call struct {
+	*mock.Call
+}
+
+// FindByPostalCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postalCode string
+func (_e *MockDatasetRepositoryInterface_Expecter) FindByPostalCode(ctx interface{}, postalCode interface{}) *MockDatasetRepositoryInterface_FindByPostalCode_Call {
+	return &MockDatasetRepositoryInterface_FindByPostalCode_Call{Call: _e.mock.On("FindByPostalCode", ctx, postalCode)}
+}
+
+func (_c *MockDatasetRepositoryInterface_FindByPostalCode_Call) Run(run func(ctx context.Context, postalCode string)) *MockDatasetRepositoryInterface_FindByPostalCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRepositoryInterface_FindByPostalCode_Call) Return(_a0 []campaign.Representative, _a1 error) *MockDatasetRepositoryInterface_FindByPostalCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRepositoryInterface_FindByPostalCode_Call) RunAndReturn(run func(context.Context, string) ([]campaign.Representative, error)) *MockDatasetRepositoryInterface_FindByPostalCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Replace provides a mock function with given fields: ctx, ridings, representatives
+func (_m *MockDatasetRepositoryInterface) Replace(ctx context.Context, ridings []campaign.PostalCodeRiding, representatives []campaign.DatasetRepresentative) error {
+	ret := _m.Called(ctx, ridings, representatives)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []campaign.PostalCodeRiding, []campaign.DatasetRepresentative) error); ok {
+		r0 = rf(ctx, ridings, representatives)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetRepositoryInterface_Replace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replace'
+type MockDatasetRepositoryInterface_Replace_Call struct {
+	*mock.Call
+}
+
+// Replace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ridings []campaign.PostalCodeRiding
+//   - representatives []campaign.DatasetRepresentative
+func (_e *MockDatasetRepositoryInterface_Expecter) Replace(ctx interface{}, ridings interface{}, representatives interface{}) *MockDatasetRepositoryInterface_Replace_Call {
+	return &MockDatasetRepositoryInterface_Replace_Call{Call: _e.mock.On("Replace", ctx, ridings, representatives)}
+}
+
+func (_c *MockDatasetRepositoryInterface_Replace_Call) Run(run func(ctx context.Context, ridings []campaign.PostalCodeRiding, representatives []campaign.DatasetRepresentative)) *MockDatasetRepositoryInterface_Replace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]campaign.PostalCodeRiding), args[2].([]campaign.DatasetRepresentative))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRepositoryInterface_Replace_Call) Return(_a0 error) *MockDatasetRepositoryInterface_Replace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetRepositoryInterface_Replace_Call) RunAndReturn(run func(context.Context, []campaign.PostalCodeRiding, []campaign.DatasetRepresentative) error) *MockDatasetRepositoryInterface_Replace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetRepositoryInterface creates a new instance of MockDatasetRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetRepositoryInterface {
+	mock := &MockDatasetRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,418 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockMemberRepositoryInterface is an autogenerated mock type for the MemberRepositoryInterface type
+type MockMemberRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockMemberRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMemberRepositoryInterface) EXPECT() *MockMemberRepositoryInterface_Expecter {
+	return &MockMemberRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, member
+func (_m *MockMemberRepositoryInterface) Create(ctx context.Context, member *campaign.Member) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMemberRepositoryInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockMemberRepositoryInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *campaign.Member
+func (_e *MockMemberRepositoryInterface_Expecter) Create(ctx interface{}, member interface{}) *MockMemberRepositoryInterface_Create_Call {
+	return &MockMemberRepositoryInterface_Create_Call{Call: _e.mock.On("Create", ctx, member)}
+}
+
+func (_c *MockMemberRepositoryInterface_Create_Call) Run(run func(ctx context.Context, member *campaign.Member)) *MockMemberRepositoryInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.Member))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_Create_Call) Return(_a0 error) *MockMemberRepositoryInterface_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_Create_Call) RunAndReturn(run func(context.Context, *campaign.Member) error) *MockMemberRepositoryInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, member
+func (_m *MockMemberRepositoryInterface) Delete(ctx context.Context, member *campaign.Member) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMemberRepositoryInterface_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMemberRepositoryInterface_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *campaign.Member
+func (_e *MockMemberRepositoryInterface_Expecter) Delete(ctx interface{}, member interface{}) *MockMemberRepositoryInterface_Delete_Call {
+	return &MockMemberRepositoryInterface_Delete_Call{Call: _e.mock.On("Delete", ctx, member)}
+}
+
+func (_c *MockMemberRepositoryInterface_Delete_Call) Run(run func(ctx context.Context, member *campaign.Member)) *MockMemberRepositoryInterface_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.Member))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_Delete_Call) Return(_a0 error) *MockMemberRepositoryInterface_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_Delete_Call) RunAndReturn(run func(context.Context, *campaign.Member) error) *MockMemberRepositoryInterface_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignAndUser provides a mock function with given fields: ctx, campaignID, userID
+func (_m *MockMemberRepositoryInterface) GetByCampaignAndUser(ctx context.Context, campaignID uuid.UUID, userID uuid.UUID) (*campaign.Member, error) {
+	ret := _m.Called(ctx, campaignID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignAndUser")
+	}
+
+	var r0 *campaign.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*campaign.Member, error)); ok {
+		return rf(ctx, campaignID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *campaign.Member); ok {
+		r0 = rf(ctx, campaignID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, campaignID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMemberRepositoryInterface_GetByCampaignAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignAndUser'
+type MockMemberRepositoryInterface_GetByCampaignAndUser_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignAndUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockMemberRepositoryInterface_Expecter) GetByCampaignAndUser(ctx interface{}, campaignID interface{}, userID interface{}) *MockMemberRepositoryInterface_GetByCampaignAndUser_Call {
+	return &MockMemberRepositoryInterface_GetByCampaignAndUser_Call{Call: _e.mock.On("GetByCampaignAndUser", ctx, campaignID, userID)}
+}
+
+func (_c *MockMemberRepositoryInterface_GetByCampaignAndUser_Call) Run(run func(ctx context.Context, campaignID uuid.UUID, userID uuid.UUID)) *MockMemberRepositoryInterface_GetByCampaignAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_GetByCampaignAndUser_Call) Return(_a0 *campaign.Member, _a1 error) *MockMemberRepositoryInterface_GetByCampaignAndUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_GetByCampaignAndUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*campaign.Member, error)) *MockMemberRepositoryInterface_GetByCampaignAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockMemberRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*campaign.Member, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *campaign.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*campaign.Member, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *campaign.Member); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMemberRepositoryInterface_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockMemberRepositoryInterface_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMemberRepositoryInterface_Expecter) GetByID(ctx interface{}, id interface{}) *MockMemberRepositoryInterface_GetByID_Call {
+	return &MockMemberRepositoryInterface_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockMemberRepositoryInterface_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMemberRepositoryInterface_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_GetByID_Call) Return(_a0 *campaign.Member, _a1 error) *MockMemberRepositoryInterface_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*campaign.Member, error)) *MockMemberRepositoryInterface_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByToken provides a mock function with given fields: ctx, token
+func (_m *MockMemberRepositoryInterface) GetByToken(ctx context.Context, token string) (*campaign.Member, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByToken")
+	}
+
+	var r0 *campaign.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*campaign.Member, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *campaign.Member); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMemberRepositoryInterface_GetByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByToken'
+type MockMemberRepositoryInterface_GetByToken_Call struct {
+	*mock.Call
+}
+
+// GetByToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockMemberRepositoryInterface_Expecter) GetByToken(ctx interface{}, token interface{}) *MockMemberRepositoryInterface_GetByToken_Call {
+	return &MockMemberRepositoryInterface_GetByToken_Call{Call: _e.mock.On("GetByToken", ctx, token)}
+}
+
+func (_c *MockMemberRepositoryInterface_GetByToken_Call) Run(run func(ctx context.Context, token string)) *MockMemberRepositoryInterface_GetByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_GetByToken_Call) Return(_a0 *campaign.Member, _a1 error) *MockMemberRepositoryInterface_GetByToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_GetByToken_Call) RunAndReturn(run func(context.Context, string) (*campaign.Member, error)) *MockMemberRepositoryInterface_GetByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByCampaign provides a mock function with given fields: ctx, campaignID
+func (_m *MockMemberRepositoryInterface) ListByCampaign(ctx context.Context, campaignID uuid.UUID) ([]campaign.Member, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCampaign")
+	}
+
+	var r0 []campaign.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Member, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Member); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMemberRepositoryInterface_ListByCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByCampaign'
+type MockMemberRepositoryInterface_ListByCampaign_Call struct {
+	*mock.Call
+}
+
+// ListByCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID uuid.UUID
+func (_e *MockMemberRepositoryInterface_Expecter) ListByCampaign(ctx interface{}, campaignID interface{}) *MockMemberRepositoryInterface_ListByCampaign_Call {
+	return &MockMemberRepositoryInterface_ListByCampaign_Call{Call: _e.mock.On("ListByCampaign", ctx, campaignID)}
+}
+
+func (_c *MockMemberRepositoryInterface_ListByCampaign_Call) Run(run func(ctx context.Context, campaignID uuid.UUID)) *MockMemberRepositoryInterface_ListByCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_ListByCampaign_Call) Return(_a0 []campaign.Member, _a1 error) *MockMemberRepositoryInterface_ListByCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_ListByCampaign_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Member, error)) *MockMemberRepositoryInterface_ListByCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, member
+func (_m *MockMemberRepositoryInterface) Update(ctx context.Context, member *campaign.Member) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMemberRepositoryInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockMemberRepositoryInterface_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *campaign.Member
+func (_e *MockMemberRepositoryInterface_Expecter) Update(ctx interface{}, member interface{}) *MockMemberRepositoryInterface_Update_Call {
+	return &MockMemberRepositoryInterface_Update_Call{Call: _e.mock.On("Update", ctx, member)}
+}
+
+func (_c *MockMemberRepositoryInterface_Update_Call) Run(run func(ctx context.Context, member *campaign.Member)) *MockMemberRepositoryInterface_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.Member))
+	})
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_Update_Call) Return(_a0 error) *MockMemberRepositoryInterface_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMemberRepositoryInterface_Update_Call) RunAndReturn(run func(context.Context, *campaign.Member) error) *MockMemberRepositoryInterface_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMemberRepositoryInterface creates a new instance of MockMemberRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMemberRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMemberRepositoryInterface {
+	mock := &MockMemberRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
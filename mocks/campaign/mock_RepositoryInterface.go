@@ -8,6 +8,10 @@ import (
 	campaign "github.com/jonesrussell/mp-emailer/campaign"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockRepositoryInterface is an autogenerated mock type for the RepositoryInterface type
@@ -129,6 +133,65 @@ func (_c *MockRepositoryInterface_Delete_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetActive provides a mock function with given fields: ctx, now
+func (_m *MockRepositoryInterface) GetActive(ctx context.Context, now time.Time) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActive")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []campaign.Campaign); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActive'
+type MockRepositoryInterface_GetActive_Call struct {
+	*mock.Call
+}
+
+// GetActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockRepositoryInterface_Expecter) GetActive(ctx interface{}, now interface{}) *MockRepositoryInterface_GetActive_Call {
+	return &MockRepositoryInterface_GetActive_Call{Call: _e.mock.On("GetActive", ctx, now)}
+}
+
+func (_c *MockRepositoryInterface_GetActive_Call) Run(run func(ctx context.Context, now time.Time)) *MockRepositoryInterface_GetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetActive_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockRepositoryInterface_GetActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetActive_Call) RunAndReturn(run func(context.Context, time.Time) ([]campaign.Campaign, error)) *MockRepositoryInterface_GetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: ctx
 func (_m *MockRepositoryInterface) GetAll(ctx context.Context) ([]campaign.Campaign, error) {
 	ret := _m.Called(ctx)
@@ -246,6 +309,419 @@ func (_c *MockRepositoryInterface_GetByID_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetByOrganization provides a mock function with given fields: ctx, orgID
+func (_m *MockRepositoryInterface) GetByOrganization(ctx context.Context, orgID uuid.UUID) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByOrganization")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Campaign); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetByOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByOrganization'
+type MockRepositoryInterface_GetByOrganization_Call struct {
+	*mock.Call
+}
+
+// GetByOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) GetByOrganization(ctx interface{}, orgID interface{}) *MockRepositoryInterface_GetByOrganization_Call {
+	return &MockRepositoryInterface_GetByOrganization_Call{Call: _e.mock.On("GetByOrganization", ctx, orgID)}
+}
+
+func (_c *MockRepositoryInterface_GetByOrganization_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *MockRepositoryInterface_GetByOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetByOrganization_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockRepositoryInterface_GetByOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetByOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Campaign, error)) *MockRepositoryInterface_GetByOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByOwner provides a mock function with given fields: ctx, ownerID
+func (_m *MockRepositoryInterface) GetByOwner(ctx context.Context, ownerID uuid.UUID) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByOwner")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Campaign); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByOwner'
+type MockRepositoryInterface_GetByOwner_Call struct {
+	*mock.Call
+}
+
+// GetByOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) GetByOwner(ctx interface{}, ownerID interface{}) *MockRepositoryInterface_GetByOwner_Call {
+	return &MockRepositoryInterface_GetByOwner_Call{Call: _e.mock.On("GetByOwner", ctx, ownerID)}
+}
+
+func (_c *MockRepositoryInterface_GetByOwner_Call) Run(run func(ctx context.Context, ownerID uuid.UUID)) *MockRepositoryInterface_GetByOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetByOwner_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockRepositoryInterface_GetByOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetByOwner_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Campaign, error)) *MockRepositoryInterface_GetByOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDueForActivation provides a mock function with given fields: ctx, now
+func (_m *MockRepositoryInterface) GetDueForActivation(ctx context.Context, now time.Time) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueForActivation")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []campaign.Campaign); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetDueForActivation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDueForActivation'
+type MockRepositoryInterface_GetDueForActivation_Call struct {
+	*mock.Call
+}
+
+// GetDueForActivation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockRepositoryInterface_Expecter) GetDueForActivation(ctx interface{}, now interface{}) *MockRepositoryInterface_GetDueForActivation_Call {
+	return &MockRepositoryInterface_GetDueForActivation_Call{Call: _e.mock.On("GetDueForActivation", ctx, now)}
+}
+
+func (_c *MockRepositoryInterface_GetDueForActivation_Call) Run(run func(ctx context.Context, now time.Time)) *MockRepositoryInterface_GetDueForActivation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetDueForActivation_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockRepositoryInterface_GetDueForActivation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetDueForActivation_Call) RunAndReturn(run func(context.Context, time.Time) ([]campaign.Campaign, error)) *MockRepositoryInterface_GetDueForActivation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDueForClosing provides a mock function with given fields: ctx, now
+func (_m *MockRepositoryInterface) GetDueForClosing(ctx context.Context, now time.Time) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueForClosing")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []campaign.Campaign); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetDueForClosing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDueForClosing'
+type MockRepositoryInterface_GetDueForClosing_Call struct {
+	*mock.Call
+}
+
+// GetDueForClosing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockRepositoryInterface_Expecter) GetDueForClosing(ctx interface{}, now interface{}) *MockRepositoryInterface_GetDueForClosing_Call {
+	return &MockRepositoryInterface_GetDueForClosing_Call{Call: _e.mock.On("GetDueForClosing", ctx, now)}
+}
+
+func (_c *MockRepositoryInterface_GetDueForClosing_Call) Run(run func(ctx context.Context, now time.Time)) *MockRepositoryInterface_GetDueForClosing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetDueForClosing_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockRepositoryInterface_GetDueForClosing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetDueForClosing_Call) RunAndReturn(run func(context.Context, time.Time) ([]campaign.Campaign, error)) *MockRepositoryInterface_GetDueForClosing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSharedWith provides a mock function with given fields: ctx, userID
+func (_m *MockRepositoryInterface) GetSharedWith(ctx context.Context, userID uuid.UUID) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedWith")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Campaign); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetSharedWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSharedWith'
+type MockRepositoryInterface_GetSharedWith_Call struct {
+	*mock.Call
+}
+
+// GetSharedWith is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) GetSharedWith(ctx interface{}, userID interface{}) *MockRepositoryInterface_GetSharedWith_Call {
+	return &MockRepositoryInterface_GetSharedWith_Call{Call: _e.mock.On("GetSharedWith", ctx, userID)}
+}
+
+func (_c *MockRepositoryInterface_GetSharedWith_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRepositoryInterface_GetSharedWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetSharedWith_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockRepositoryInterface_GetSharedWith_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetSharedWith_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Campaign, error)) *MockRepositoryInterface_GetSharedWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, params
+func (_m *MockRepositoryInterface) List(ctx context.Context, params campaign.ListCampaignsParams) (*campaign.CampaignPage, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *campaign.CampaignPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ListCampaignsParams) (*campaign.CampaignPage, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ListCampaignsParams) *campaign.CampaignPage); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.CampaignPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, campaign.ListCampaignsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepositoryInterface_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params campaign.ListCampaignsParams
+func (_e *MockRepositoryInterface_Expecter) List(ctx interface{}, params interface{}) *MockRepositoryInterface_List_Call {
+	return &MockRepositoryInterface_List_Call{Call: _e.mock.On("List", ctx, params)}
+}
+
+func (_c *MockRepositoryInterface_List_Call) Run(run func(ctx context.Context, params campaign.ListCampaignsParams)) *MockRepositoryInterface_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.ListCampaignsParams))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_List_Call) Return(_a0 *campaign.CampaignPage, _a1 error) *MockRepositoryInterface_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_List_Call) RunAndReturn(run func(context.Context, campaign.ListCampaignsParams) (*campaign.CampaignPage, error)) *MockRepositoryInterface_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, params
+func (_m *MockRepositoryInterface) Search(ctx context.Context, params campaign.ListCampaignsParams) (*campaign.CampaignPage, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 *campaign.CampaignPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ListCampaignsParams) (*campaign.CampaignPage, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ListCampaignsParams) *campaign.CampaignPage); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.CampaignPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, campaign.ListCampaignsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockRepositoryInterface_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params campaign.ListCampaignsParams
+func (_e *MockRepositoryInterface_Expecter) Search(ctx interface{}, params interface{}) *MockRepositoryInterface_Search_Call {
+	return &MockRepositoryInterface_Search_Call{Call: _e.mock.On("Search", ctx, params)}
+}
+
+func (_c *MockRepositoryInterface_Search_Call) Run(run func(ctx context.Context, params campaign.ListCampaignsParams)) *MockRepositoryInterface_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.ListCampaignsParams))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_Search_Call) Return(_a0 *campaign.CampaignPage, _a1 error) *MockRepositoryInterface_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_Search_Call) RunAndReturn(run func(context.Context, campaign.ListCampaignsParams) (*campaign.CampaignPage, error)) *MockRepositoryInterface_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, dto
 func (_m *MockRepositoryInterface) Update(ctx context.Context, dto *campaign.UpdateCampaignDTO) error {
 	ret := _m.Called(ctx, dto)
@@ -293,6 +769,54 @@ func (_c *MockRepositoryInterface_Update_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdateStatus provides a mock function with given fields: ctx, id, status
+func (_m *MockRepositoryInterface) UpdateStatus(ctx context.Context, id uuid.UUID, status campaign.Status) error {
+	ret := _m.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, campaign.Status) error); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepositoryInterface_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockRepositoryInterface_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - status campaign.Status
+func (_e *MockRepositoryInterface_Expecter) UpdateStatus(ctx interface{}, id interface{}, status interface{}) *MockRepositoryInterface_UpdateStatus_Call {
+	return &MockRepositoryInterface_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, status)}
+}
+
+func (_c *MockRepositoryInterface_UpdateStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, status campaign.Status)) *MockRepositoryInterface_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(campaign.Status))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_UpdateStatus_Call) Return(_a0 error) *MockRepositoryInterface_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_UpdateStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, campaign.Status) error) *MockRepositoryInterface_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepositoryInterface creates a new instance of MockRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepositoryInterface(t interface {
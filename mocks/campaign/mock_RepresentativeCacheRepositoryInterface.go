@@ -0,0 +1,144 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepresentativeCacheRepositoryInterface is an autogenerated mock type for the RepresentativeCacheRepositoryInterface type
+type MockRepresentativeCacheRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockRepresentativeCacheRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepresentativeCacheRepositoryInterface) EXPECT() *MockRepresentativeCacheRepositoryInterface_Expecter {
+	return &MockRepresentativeCacheRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, postalCode
+func (_m *MockRepresentativeCacheRepositoryInterface) Get(ctx context.Context, postalCode string) (*campaign.CachedLookup, error) {
+	ret := _m.Called(ctx, postalCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *campaign.CachedLookup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*campaign.CachedLookup, error)); ok {
+		return rf(ctx, postalCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *campaign.CachedLookup); ok {
+		r0 = rf(ctx, postalCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.CachedLookup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postalCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepresentativeCacheRepositoryInterface_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRepresentativeCacheRepositoryInterface_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postalCode string
+func (_e *MockRepresentativeCacheRepositoryInterface_Expecter) Get(ctx interface{}, postalCode interface{}) *MockRepresentativeCacheRepositoryInterface_Get_Call {
+	return &MockRepresentativeCacheRepositoryInterface_Get_Call{Call: _e.mock.On("Get", ctx, postalCode)}
+}
+
+func (_c *MockRepresentativeCacheRepositoryInterface_Get_Call) Run(run func(ctx context.Context, postalCode string)) *MockRepresentativeCacheRepositoryInterface_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepresentativeCacheRepositoryInterface_Get_Call) Return(_a0 *campaign.CachedLookup, _a1 error) *MockRepresentativeCacheRepositoryInterface_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepresentativeCacheRepositoryInterface_Get_Call) RunAndReturn(run func(context.Context, string) (*campaign.CachedLookup, error)) *MockRepresentativeCacheRepositoryInterface_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, lookup
+func (_m *MockRepresentativeCacheRepositoryInterface) Put(ctx context.Context, lookup *campaign.CachedLookup) error {
+	ret := _m.Called(ctx, lookup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CachedLookup) error); ok {
+		r0 = rf(ctx, lookup)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepresentativeCacheRepositoryInterface_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockRepresentativeCacheRepositoryInterface_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lookup *campaign.CachedLookup
+func (_e *MockRepresentativeCacheRepositoryInterface_Expecter) Put(ctx interface{}, lookup interface{}) *MockRepresentativeCacheRepositoryInterface_Put_Call {
+	return &MockRepresentativeCacheRepositoryInterface_Put_Call{Call: _e.mock.On("Put", ctx, lookup)}
+}
+
+func (_c *MockRepresentativeCacheRepositoryInterface_Put_Call) Run(run func(ctx context.Context, lookup *campaign.CachedLookup)) *MockRepresentativeCacheRepositoryInterface_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.CachedLookup))
+	})
+	return _c
+}
+
+func (_c *MockRepresentativeCacheRepositoryInterface_Put_Call) Return(_a0 error) *MockRepresentativeCacheRepositoryInterface_Put_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepresentativeCacheRepositoryInterface_Put_Call) RunAndReturn(run func(context.Context, *campaign.CachedLookup) error) *MockRepresentativeCacheRepositoryInterface_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepresentativeCacheRepositoryInterface creates a new instance of MockRepresentativeCacheRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepresentativeCacheRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepresentativeCacheRepositoryInterface {
+	mock := &MockRepresentativeCacheRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
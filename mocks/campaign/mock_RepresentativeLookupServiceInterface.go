@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	context "context"
+
 	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -20,9 +23,9 @@ func (_m *MockRepresentativeLookupServiceInterface) EXPECT() *MockRepresentative
 	return &MockRepresentativeLookupServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// FetchRepresentatives provides a mock function with given fields: postalCode
-func (_m *MockRepresentativeLookupServiceInterface) FetchRepresentatives(postalCode string) ([]campaign.Representative, error) {
-	ret := _m.Called(postalCode)
+// FetchRepresentatives provides a mock function with given fields: ctx, postalCode
+func (_m *MockRepresentativeLookupServiceInterface) FetchRepresentatives(ctx context.Context, postalCode string) ([]campaign.Representative, error) {
+	ret := _m.Called(ctx, postalCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchRepresentatives")
@@ -30,19 +33,19 @@ func (_m *MockRepresentativeLookupServiceInterface) FetchRepresentatives(postalC
 
 	var r0 []campaign.Representative
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]campaign.Representative, error)); ok {
-		return rf(postalCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]campaign.Representative, error)); ok {
+		return rf(ctx, postalCode)
 	}
-	if rf, ok := ret.Get(0).(func(string) []campaign.Representative); ok {
-		r0 = rf(postalCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []campaign.Representative); ok {
+		r0 = rf(ctx, postalCode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]campaign.Representative)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(postalCode)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postalCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,14 +59,15 @@ type MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call struct {
 }
 
 // FetchRepresentatives is a helper method to define mock.On call
+//   - ctx context.Context
 //   - postalCode string
-func (_e *MockRepresentativeLookupServiceInterface_Expecter) FetchRepresentatives(postalCode interface{}) *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call {
-	return &MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call{Call: _e.mock.On("FetchRepresentatives", postalCode)}
+func (_e *MockRepresentativeLookupServiceInterface_Expecter) FetchRepresentatives(ctx interface{}, postalCode interface{}) *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call {
+	return &MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call{Call: _e.mock.On("FetchRepresentatives", ctx, postalCode)}
 }
 
-func (_c *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call) Run(run func(postalCode string)) *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call {
+func (_c *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call) Run(run func(ctx context.Context, postalCode string)) *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -73,7 +77,7 @@ func (_c *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call) Re
 	return _c
 }
 
-func (_c *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call) RunAndReturn(run func(string) ([]campaign.Representative, error)) *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call {
+func (_c *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call) RunAndReturn(run func(context.Context, string) ([]campaign.Representative, error)) *MockRepresentativeLookupServiceInterface_FetchRepresentatives_Call {
 	_c.Call.Return(run)
 	return _c
 }
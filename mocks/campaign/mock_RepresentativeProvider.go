@@ -0,0 +1,142 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepresentativeProvider is an autogenerated mock type for the RepresentativeProvider type
+type MockRepresentativeProvider struct {
+	mock.Mock
+}
+
+type MockRepresentativeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepresentativeProvider) EXPECT() *MockRepresentativeProvider_Expecter {
+	return &MockRepresentativeProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchRepresentatives provides a mock function with given fields: ctx, postalCode
+func (_m *MockRepresentativeProvider) FetchRepresentatives(ctx context.Context, postalCode string) ([]campaign.Representative, error) {
+	ret := _m.Called(ctx, postalCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRepresentatives")
+	}
+
+	var r0 []campaign.Representative
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]campaign.Representative, error)); ok {
+		return rf(ctx, postalCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []campaign.Representative); ok {
+		r0 = rf(ctx, postalCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Representative)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postalCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepresentativeProvider_FetchRepresentatives_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchRepresentatives'
+type MockRepresentativeProvider_FetchRepresentatives_Call struct {
+	*mock.Call
+}
+
+// FetchRepresentatives is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postalCode string
+func (_e *MockRepresentativeProvider_Expecter) FetchRepresentatives(ctx interface{}, postalCode interface{}) *MockRepresentativeProvider_FetchRepresentatives_Call {
+	return &MockRepresentativeProvider_FetchRepresentatives_Call{Call: _e.mock.On("FetchRepresentatives", ctx, postalCode)}
+}
+
+func (_c *MockRepresentativeProvider_FetchRepresentatives_Call) Run(run func(ctx context.Context, postalCode string)) *MockRepresentativeProvider_FetchRepresentatives_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepresentativeProvider_FetchRepresentatives_Call) Return(_a0 []campaign.Representative, _a1 error) *MockRepresentativeProvider_FetchRepresentatives_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepresentativeProvider_FetchRepresentatives_Call) RunAndReturn(run func(context.Context, string) ([]campaign.Representative, error)) *MockRepresentativeProvider_FetchRepresentatives_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with given fields:
+func (_m *MockRepresentativeProvider) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockRepresentativeProvider_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockRepresentativeProvider_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockRepresentativeProvider_Expecter) Name() *MockRepresentativeProvider_Name_Call {
+	return &MockRepresentativeProvider_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockRepresentativeProvider_Name_Call) Run(run func()) *MockRepresentativeProvider_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepresentativeProvider_Name_Call) Return(_a0 string) *MockRepresentativeProvider_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepresentativeProvider_Name_Call) RunAndReturn(run func() string) *MockRepresentativeProvider_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepresentativeProvider creates a new instance of MockRepresentativeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepresentativeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepresentativeProvider {
+	mock := &MockRepresentativeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
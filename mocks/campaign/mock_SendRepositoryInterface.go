@@ -0,0 +1,535 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSendRepositoryInterface is an autogenerated mock type for the SendRepositoryInterface type
+type MockSendRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockSendRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSendRepositoryInterface) EXPECT() *MockSendRepositoryInterface_Expecter {
+	return &MockSendRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// Confirm provides a mock function with given fields: ctx, id, confirmedAt
+func (_m *MockSendRepositoryInterface) Confirm(ctx context.Context, id uuid.UUID, confirmedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, confirmedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (bool, error)); ok {
+		return rf(ctx, id, confirmedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) bool); ok {
+		r0 = rf(ctx, id, confirmedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, id, confirmedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSendRepositoryInterface_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockSendRepositoryInterface_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - confirmedAt time.Time
+func (_e *MockSendRepositoryInterface_Expecter) Confirm(ctx interface{}, id interface{}, confirmedAt interface{}) *MockSendRepositoryInterface_Confirm_Call {
+	return &MockSendRepositoryInterface_Confirm_Call{Call: _e.mock.On("Confirm", ctx, id, confirmedAt)}
+}
+
+func (_c *MockSendRepositoryInterface_Confirm_Call) Run(run func(ctx context.Context, id uuid.UUID, confirmedAt time.Time)) *MockSendRepositoryInterface_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_Confirm_Call) Return(_a0 bool, _a1 error) *MockSendRepositoryInterface_Confirm_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_Confirm_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (bool, error)) *MockSendRepositoryInterface_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByCampaign provides a mock function with given fields: ctx, campaignIDs
+func (_m *MockSendRepositoryInterface) CountByCampaign(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]campaign.SendStats, error) {
+	ret := _m.Called(ctx, campaignIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCampaign")
+	}
+
+	var r0 map[uuid.UUID]campaign.SendStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]campaign.SendStats, error)); ok {
+		return rf(ctx, campaignIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]campaign.SendStats); ok {
+		r0 = rf(ctx, campaignIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]campaign.SendStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, campaignIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSendRepositoryInterface_CountByCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCampaign'
+type MockSendRepositoryInterface_CountByCampaign_Call struct {
+	*mock.Call
+}
+
+// CountByCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignIDs []uuid.UUID
+func (_e *MockSendRepositoryInterface_Expecter) CountByCampaign(ctx interface{}, campaignIDs interface{}) *MockSendRepositoryInterface_CountByCampaign_Call {
+	return &MockSendRepositoryInterface_CountByCampaign_Call{Call: _e.mock.On("CountByCampaign", ctx, campaignIDs)}
+}
+
+func (_c *MockSendRepositoryInterface_CountByCampaign_Call) Run(run func(ctx context.Context, campaignIDs []uuid.UUID)) *MockSendRepositoryInterface_CountByCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_CountByCampaign_Call) Return(_a0 map[uuid.UUID]campaign.SendStats, _a1 error) *MockSendRepositoryInterface_CountByCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_CountByCampaign_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID]campaign.SendStats, error)) *MockSendRepositoryInterface_CountByCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, send
+func (_m *MockSendRepositoryInterface) Create(ctx context.Context, send *campaign.Send) error {
+	ret := _m.Called(ctx, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.Send) error); ok {
+		r0 = rf(ctx, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendRepositoryInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSendRepositoryInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - send *campaign.Send
+func (_e *MockSendRepositoryInterface_Expecter) Create(ctx interface{}, send interface{}) *MockSendRepositoryInterface_Create_Call {
+	return &MockSendRepositoryInterface_Create_Call{Call: _e.mock.On("Create", ctx, send)}
+}
+
+func (_c *MockSendRepositoryInterface_Create_Call) Run(run func(ctx context.Context, send *campaign.Send)) *MockSendRepositoryInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.Send))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_Create_Call) Return(_a0 error) *MockSendRepositoryInterface_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_Create_Call) RunAndReturn(run func(context.Context, *campaign.Send) error) *MockSendRepositoryInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireUnconfirmed provides a mock function with given fields: ctx, now
+func (_m *MockSendRepositoryInterface) ExpireUnconfirmed(ctx context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireUnconfirmed")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSendRepositoryInterface_ExpireUnconfirmed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireUnconfirmed'
+type MockSendRepositoryInterface_ExpireUnconfirmed_Call struct {
+	*mock.Call
+}
+
+// ExpireUnconfirmed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockSendRepositoryInterface_Expecter) ExpireUnconfirmed(ctx interface{}, now interface{}) *MockSendRepositoryInterface_ExpireUnconfirmed_Call {
+	return &MockSendRepositoryInterface_ExpireUnconfirmed_Call{Call: _e.mock.On("ExpireUnconfirmed", ctx, now)}
+}
+
+func (_c *MockSendRepositoryInterface_ExpireUnconfirmed_Call) Run(run func(ctx context.Context, now time.Time)) *MockSendRepositoryInterface_ExpireUnconfirmed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_ExpireUnconfirmed_Call) Return(_a0 int64, _a1 error) *MockSendRepositoryInterface_ExpireUnconfirmed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_ExpireUnconfirmed_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockSendRepositoryInterface_ExpireUnconfirmed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockSendRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*campaign.Send, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *campaign.Send
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*campaign.Send, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *campaign.Send); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Send)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSendRepositoryInterface_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockSendRepositoryInterface_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockSendRepositoryInterface_Expecter) GetByID(ctx interface{}, id interface{}) *MockSendRepositoryInterface_GetByID_Call {
+	return &MockSendRepositoryInterface_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockSendRepositoryInterface_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSendRepositoryInterface_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_GetByID_Call) Return(_a0 *campaign.Send, _a1 error) *MockSendRepositoryInterface_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*campaign.Send, error)) *MockSendRepositoryInterface_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByCampaign provides a mock function with given fields: ctx, campaignID
+func (_m *MockSendRepositoryInterface) ListByCampaign(ctx context.Context, campaignID uuid.UUID) ([]campaign.Send, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCampaign")
+	}
+
+	var r0 []campaign.Send
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Send, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Send); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Send)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSendRepositoryInterface_ListByCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByCampaign'
+type MockSendRepositoryInterface_ListByCampaign_Call struct {
+	*mock.Call
+}
+
+// ListByCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID uuid.UUID
+func (_e *MockSendRepositoryInterface_Expecter) ListByCampaign(ctx interface{}, campaignID interface{}) *MockSendRepositoryInterface_ListByCampaign_Call {
+	return &MockSendRepositoryInterface_ListByCampaign_Call{Call: _e.mock.On("ListByCampaign", ctx, campaignID)}
+}
+
+func (_c *MockSendRepositoryInterface_ListByCampaign_Call) Run(run func(ctx context.Context, campaignID uuid.UUID)) *MockSendRepositoryInterface_ListByCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_ListByCampaign_Call) Return(_a0 []campaign.Send, _a1 error) *MockSendRepositoryInterface_ListByCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_ListByCampaign_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Send, error)) *MockSendRepositoryInterface_ListByCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByConfirmationToken provides a mock function with given fields: ctx, token
+func (_m *MockSendRepositoryInterface) ListByConfirmationToken(ctx context.Context, token string) ([]campaign.Send, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByConfirmationToken")
+	}
+
+	var r0 []campaign.Send
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]campaign.Send, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []campaign.Send); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Send)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSendRepositoryInterface_ListByConfirmationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByConfirmationToken'
+type MockSendRepositoryInterface_ListByConfirmationToken_Call struct {
+	*mock.Call
+}
+
+// ListByConfirmationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockSendRepositoryInterface_Expecter) ListByConfirmationToken(ctx interface{}, token interface{}) *MockSendRepositoryInterface_ListByConfirmationToken_Call {
+	return &MockSendRepositoryInterface_ListByConfirmationToken_Call{Call: _e.mock.On("ListByConfirmationToken", ctx, token)}
+}
+
+func (_c *MockSendRepositoryInterface_ListByConfirmationToken_Call) Run(run func(ctx context.Context, token string)) *MockSendRepositoryInterface_ListByConfirmationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_ListByConfirmationToken_Call) Return(_a0 []campaign.Send, _a1 error) *MockSendRepositoryInterface_ListByConfirmationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_ListByConfirmationToken_Call) RunAndReturn(run func(context.Context, string) ([]campaign.Send, error)) *MockSendRepositoryInterface_ListByConfirmationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamByCampaign provides a mock function with given fields: ctx, campaignID, fn
+func (_m *MockSendRepositoryInterface) StreamByCampaign(ctx context.Context, campaignID uuid.UUID, fn func(*campaign.Send) error) error {
+	ret := _m.Called(ctx, campaignID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamByCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*campaign.Send) error) error); ok {
+		r0 = rf(ctx, campaignID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendRepositoryInterface_StreamByCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamByCampaign'
+type MockSendRepositoryInterface_StreamByCampaign_Call struct {
+	*mock.Call
+}
+
+// StreamByCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID uuid.UUID
+//   - fn func(*campaign.Send) error
+func (_e *MockSendRepositoryInterface_Expecter) StreamByCampaign(ctx interface{}, campaignID interface{}, fn interface{}) *MockSendRepositoryInterface_StreamByCampaign_Call {
+	return &MockSendRepositoryInterface_StreamByCampaign_Call{Call: _e.mock.On("StreamByCampaign", ctx, campaignID, fn)}
+}
+
+func (_c *MockSendRepositoryInterface_StreamByCampaign_Call) Run(run func(ctx context.Context, campaignID uuid.UUID, fn func(*campaign.Send) error)) *MockSendRepositoryInterface_StreamByCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*campaign.Send) error))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_StreamByCampaign_Call) Return(_a0 error) *MockSendRepositoryInterface_StreamByCampaign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_StreamByCampaign_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*campaign.Send) error) error) *MockSendRepositoryInterface_StreamByCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, send
+func (_m *MockSendRepositoryInterface) Update(ctx context.Context, send *campaign.Send) error {
+	ret := _m.Called(ctx, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.Send) error); ok {
+		r0 = rf(ctx, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendRepositoryInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSendRepositoryInterface_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - send *campaign.Send
+func (_e *MockSendRepositoryInterface_Expecter) Update(ctx interface{}, send interface{}) *MockSendRepositoryInterface_Update_Call {
+	return &MockSendRepositoryInterface_Update_Call{Call: _e.mock.On("Update", ctx, send)}
+}
+
+func (_c *MockSendRepositoryInterface_Update_Call) Run(run func(ctx context.Context, send *campaign.Send)) *MockSendRepositoryInterface_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.Send))
+	})
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_Update_Call) Return(_a0 error) *MockSendRepositoryInterface_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendRepositoryInterface_Update_Call) RunAndReturn(run func(context.Context, *campaign.Send) error) *MockSendRepositoryInterface_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSendRepositoryInterface creates a new instance of MockSendRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSendRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSendRepositoryInterface {
+	mock := &MockSendRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
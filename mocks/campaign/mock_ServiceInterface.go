@@ -8,6 +8,10 @@ import (
 	campaign "github.com/jonesrussell/mp-emailer/campaign"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockServiceInterface is an autogenerated mock type for the ServiceInterface type
@@ -23,27 +27,29 @@ func (_m *MockServiceInterface) EXPECT() *MockServiceInterface_Expecter {
 	return &MockServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// ComposeEmail provides a mock function with given fields: ctx, params
-func (_m *MockServiceInterface) ComposeEmail(ctx context.Context, params campaign.ComposeEmailParams) (string, error) {
-	ret := _m.Called(ctx, params)
+// AcceptInvite provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) AcceptInvite(ctx context.Context, dto *campaign.AcceptInviteDTO) (*campaign.Member, error) {
+	ret := _m.Called(ctx, dto)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ComposeEmail")
+		panic("no return value specified for AcceptInvite")
 	}
 
-	var r0 string
+	var r0 *campaign.Member
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.ComposeEmailParams) (string, error)); ok {
-		return rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.AcceptInviteDTO) (*campaign.Member, error)); ok {
+		return rf(ctx, dto)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.ComposeEmailParams) string); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.AcceptInviteDTO) *campaign.Member); ok {
+		r0 = rf(ctx, dto)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Member)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, campaign.ComposeEmailParams) error); ok {
-		r1 = rf(ctx, params)
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.AcceptInviteDTO) error); ok {
+		r1 = rf(ctx, dto)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,49 +57,96 @@ func (_m *MockServiceInterface) ComposeEmail(ctx context.Context, params campaig
 	return r0, r1
 }
 
-// MockServiceInterface_ComposeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComposeEmail'
-type MockServiceInterface_ComposeEmail_Call struct {
+// MockServiceInterface_AcceptInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvite'
+type MockServiceInterface_AcceptInvite_Call struct {
 	*mock.Call
 }
 
-// ComposeEmail is a helper method to define mock.On call
+// AcceptInvite is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params campaign.ComposeEmailParams
-func (_e *MockServiceInterface_Expecter) ComposeEmail(ctx interface{}, params interface{}) *MockServiceInterface_ComposeEmail_Call {
-	return &MockServiceInterface_ComposeEmail_Call{Call: _e.mock.On("ComposeEmail", ctx, params)}
+//   - dto *campaign.AcceptInviteDTO
+func (_e *MockServiceInterface_Expecter) AcceptInvite(ctx interface{}, dto interface{}) *MockServiceInterface_AcceptInvite_Call {
+	return &MockServiceInterface_AcceptInvite_Call{Call: _e.mock.On("AcceptInvite", ctx, dto)}
 }
 
-func (_c *MockServiceInterface_ComposeEmail_Call) Run(run func(ctx context.Context, params campaign.ComposeEmailParams)) *MockServiceInterface_ComposeEmail_Call {
+func (_c *MockServiceInterface_AcceptInvite_Call) Run(run func(ctx context.Context, dto *campaign.AcceptInviteDTO)) *MockServiceInterface_AcceptInvite_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(campaign.ComposeEmailParams))
+		run(args[0].(context.Context), args[1].(*campaign.AcceptInviteDTO))
 	})
 	return _c
 }
 
-func (_c *MockServiceInterface_ComposeEmail_Call) Return(_a0 string, _a1 error) *MockServiceInterface_ComposeEmail_Call {
+func (_c *MockServiceInterface_AcceptInvite_Call) Return(_a0 *campaign.Member, _a1 error) *MockServiceInterface_AcceptInvite_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockServiceInterface_ComposeEmail_Call) RunAndReturn(run func(context.Context, campaign.ComposeEmailParams) (string, error)) *MockServiceInterface_ComposeEmail_Call {
+func (_c *MockServiceInterface_AcceptInvite_Call) RunAndReturn(run func(context.Context, *campaign.AcceptInviteDTO) (*campaign.Member, error)) *MockServiceInterface_AcceptInvite_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCampaign provides a mock function with given fields: ctx, dto
-func (_m *MockServiceInterface) CreateCampaign(ctx context.Context, dto *campaign.CreateCampaignDTO) (*campaign.Campaign, error) {
+// ApplySchedule provides a mock function with given fields: ctx, now
+func (_m *MockServiceInterface) ApplySchedule(ctx context.Context, now time.Time) error {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplySchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceInterface_ApplySchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplySchedule'
+type MockServiceInterface_ApplySchedule_Call struct {
+	*mock.Call
+}
+
+// ApplySchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockServiceInterface_Expecter) ApplySchedule(ctx interface{}, now interface{}) *MockServiceInterface_ApplySchedule_Call {
+	return &MockServiceInterface_ApplySchedule_Call{Call: _e.mock.On("ApplySchedule", ctx, now)}
+}
+
+func (_c *MockServiceInterface_ApplySchedule_Call) Run(run func(ctx context.Context, now time.Time)) *MockServiceInterface_ApplySchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ApplySchedule_Call) Return(_a0 error) *MockServiceInterface_ApplySchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_ApplySchedule_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockServiceInterface_ApplySchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeStatus provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) ChangeStatus(ctx context.Context, dto *campaign.ChangeStatusDTO) (*campaign.Campaign, error) {
 	ret := _m.Called(ctx, dto)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCampaign")
+		panic("no return value specified for ChangeStatus")
 	}
 
 	var r0 *campaign.Campaign
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CreateCampaignDTO) (*campaign.Campaign, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.ChangeStatusDTO) (*campaign.Campaign, error)); ok {
 		return rf(ctx, dto)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CreateCampaignDTO) *campaign.Campaign); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.ChangeStatusDTO) *campaign.Campaign); ok {
 		r0 = rf(ctx, dto)
 	} else {
 		if ret.Get(0) != nil {
@@ -101,7 +154,7 @@ func (_m *MockServiceInterface) CreateCampaign(ctx context.Context, dto *campaig
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *campaign.CreateCampaignDTO) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.ChangeStatusDTO) error); ok {
 		r1 = rf(ctx, dto)
 	} else {
 		r1 = ret.Error(1)
@@ -110,46 +163,46 @@ func (_m *MockServiceInterface) CreateCampaign(ctx context.Context, dto *campaig
 	return r0, r1
 }
 
-// MockServiceInterface_CreateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampaign'
-type MockServiceInterface_CreateCampaign_Call struct {
+// MockServiceInterface_ChangeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStatus'
+type MockServiceInterface_ChangeStatus_Call struct {
 	*mock.Call
 }
 
-// CreateCampaign is a helper method to define mock.On call
+// ChangeStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dto *campaign.CreateCampaignDTO
-func (_e *MockServiceInterface_Expecter) CreateCampaign(ctx interface{}, dto interface{}) *MockServiceInterface_CreateCampaign_Call {
-	return &MockServiceInterface_CreateCampaign_Call{Call: _e.mock.On("CreateCampaign", ctx, dto)}
+//   - dto *campaign.ChangeStatusDTO
+func (_e *MockServiceInterface_Expecter) ChangeStatus(ctx interface{}, dto interface{}) *MockServiceInterface_ChangeStatus_Call {
+	return &MockServiceInterface_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus", ctx, dto)}
 }
 
-func (_c *MockServiceInterface_CreateCampaign_Call) Run(run func(ctx context.Context, dto *campaign.CreateCampaignDTO)) *MockServiceInterface_CreateCampaign_Call {
+func (_c *MockServiceInterface_ChangeStatus_Call) Run(run func(ctx context.Context, dto *campaign.ChangeStatusDTO)) *MockServiceInterface_ChangeStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*campaign.CreateCampaignDTO))
+		run(args[0].(context.Context), args[1].(*campaign.ChangeStatusDTO))
 	})
 	return _c
 }
 
-func (_c *MockServiceInterface_CreateCampaign_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_CreateCampaign_Call {
+func (_c *MockServiceInterface_ChangeStatus_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_ChangeStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockServiceInterface_CreateCampaign_Call) RunAndReturn(run func(context.Context, *campaign.CreateCampaignDTO) (*campaign.Campaign, error)) *MockServiceInterface_CreateCampaign_Call {
+func (_c *MockServiceInterface_ChangeStatus_Call) RunAndReturn(run func(context.Context, *campaign.ChangeStatusDTO) (*campaign.Campaign, error)) *MockServiceInterface_ChangeStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteCampaign provides a mock function with given fields: ctx, params
-func (_m *MockServiceInterface) DeleteCampaign(ctx context.Context, params campaign.DeleteCampaignDTO) error {
-	ret := _m.Called(ctx, params)
+// CheckPermission provides a mock function with given fields: ctx, _a1, userID, permission
+func (_m *MockServiceInterface) CheckPermission(ctx context.Context, _a1 *campaign.Campaign, userID uuid.UUID, permission campaign.Permission) error {
+	ret := _m.Called(ctx, _a1, userID, permission)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCampaign")
+		panic("no return value specified for CheckPermission")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.DeleteCampaignDTO) error); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.Campaign, uuid.UUID, campaign.Permission) error); ok {
+		r0 = rf(ctx, _a1, userID, permission)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -157,57 +210,59 @@ func (_m *MockServiceInterface) DeleteCampaign(ctx context.Context, params campa
 	return r0
 }
 
-// MockServiceInterface_DeleteCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCampaign'
-type MockServiceInterface_DeleteCampaign_Call struct {
+// MockServiceInterface_CheckPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPermission'
+type MockServiceInterface_CheckPermission_Call struct {
 	*mock.Call
 }
 
-// DeleteCampaign is a helper method to define mock.On call
+// CheckPermission is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params campaign.DeleteCampaignDTO
-func (_e *MockServiceInterface_Expecter) DeleteCampaign(ctx interface{}, params interface{}) *MockServiceInterface_DeleteCampaign_Call {
-	return &MockServiceInterface_DeleteCampaign_Call{Call: _e.mock.On("DeleteCampaign", ctx, params)}
+//   - _a1 *campaign.Campaign
+//   - userID uuid.UUID
+//   - permission campaign.Permission
+func (_e *MockServiceInterface_Expecter) CheckPermission(ctx interface{}, _a1 interface{}, userID interface{}, permission interface{}) *MockServiceInterface_CheckPermission_Call {
+	return &MockServiceInterface_CheckPermission_Call{Call: _e.mock.On("CheckPermission", ctx, _a1, userID, permission)}
 }
 
-func (_c *MockServiceInterface_DeleteCampaign_Call) Run(run func(ctx context.Context, params campaign.DeleteCampaignDTO)) *MockServiceInterface_DeleteCampaign_Call {
+func (_c *MockServiceInterface_CheckPermission_Call) Run(run func(ctx context.Context, _a1 *campaign.Campaign, userID uuid.UUID, permission campaign.Permission)) *MockServiceInterface_CheckPermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(campaign.DeleteCampaignDTO))
+		run(args[0].(context.Context), args[1].(*campaign.Campaign), args[2].(uuid.UUID), args[3].(campaign.Permission))
 	})
 	return _c
 }
 
-func (_c *MockServiceInterface_DeleteCampaign_Call) Return(_a0 error) *MockServiceInterface_DeleteCampaign_Call {
+func (_c *MockServiceInterface_CheckPermission_Call) Return(_a0 error) *MockServiceInterface_CheckPermission_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockServiceInterface_DeleteCampaign_Call) RunAndReturn(run func(context.Context, campaign.DeleteCampaignDTO) error) *MockServiceInterface_DeleteCampaign_Call {
+func (_c *MockServiceInterface_CheckPermission_Call) RunAndReturn(run func(context.Context, *campaign.Campaign, uuid.UUID, campaign.Permission) error) *MockServiceInterface_CheckPermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchCampaign provides a mock function with given fields: ctx, params
-func (_m *MockServiceInterface) FetchCampaign(ctx context.Context, params campaign.GetCampaignParams) (*campaign.Campaign, error) {
+// ComposeEmail provides a mock function with given fields: ctx, params
+func (_m *MockServiceInterface) ComposeEmail(ctx context.Context, params campaign.ComposeEmailParams) (*campaign.ComposedEmail, error) {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchCampaign")
+		panic("no return value specified for ComposeEmail")
 	}
 
-	var r0 *campaign.Campaign
+	var r0 *campaign.ComposedEmail
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ComposeEmailParams) (*campaign.ComposedEmail, error)); ok {
 		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) *campaign.Campaign); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ComposeEmailParams) *campaign.ComposedEmail); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*campaign.Campaign)
+			r0 = ret.Get(0).(*campaign.ComposedEmail)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, campaign.GetCampaignParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, campaign.ComposeEmailParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -216,58 +271,117 @@ func (_m *MockServiceInterface) FetchCampaign(ctx context.Context, params campai
 	return r0, r1
 }
 
-// MockServiceInterface_FetchCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchCampaign'
-type MockServiceInterface_FetchCampaign_Call struct {
+// MockServiceInterface_ComposeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComposeEmail'
+type MockServiceInterface_ComposeEmail_Call struct {
 	*mock.Call
 }
 
-// FetchCampaign is a helper method to define mock.On call
+// ComposeEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params campaign.GetCampaignParams
-func (_e *MockServiceInterface_Expecter) FetchCampaign(ctx interface{}, params interface{}) *MockServiceInterface_FetchCampaign_Call {
-	return &MockServiceInterface_FetchCampaign_Call{Call: _e.mock.On("FetchCampaign", ctx, params)}
+//   - params campaign.ComposeEmailParams
+func (_e *MockServiceInterface_Expecter) ComposeEmail(ctx interface{}, params interface{}) *MockServiceInterface_ComposeEmail_Call {
+	return &MockServiceInterface_ComposeEmail_Call{Call: _e.mock.On("ComposeEmail", ctx, params)}
 }
 
-func (_c *MockServiceInterface_FetchCampaign_Call) Run(run func(ctx context.Context, params campaign.GetCampaignParams)) *MockServiceInterface_FetchCampaign_Call {
+func (_c *MockServiceInterface_ComposeEmail_Call) Run(run func(ctx context.Context, params campaign.ComposeEmailParams)) *MockServiceInterface_ComposeEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(campaign.GetCampaignParams))
+		run(args[0].(context.Context), args[1].(campaign.ComposeEmailParams))
 	})
 	return _c
 }
 
-func (_c *MockServiceInterface_FetchCampaign_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_FetchCampaign_Call {
+func (_c *MockServiceInterface_ComposeEmail_Call) Return(_a0 *campaign.ComposedEmail, _a1 error) *MockServiceInterface_ComposeEmail_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockServiceInterface_FetchCampaign_Call) RunAndReturn(run func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)) *MockServiceInterface_FetchCampaign_Call {
+func (_c *MockServiceInterface_ComposeEmail_Call) RunAndReturn(run func(context.Context, campaign.ComposeEmailParams) (*campaign.ComposedEmail, error)) *MockServiceInterface_ComposeEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCampaignByID provides a mock function with given fields: ctx, params
-func (_m *MockServiceInterface) GetCampaignByID(ctx context.Context, params campaign.GetCampaignParams) (*campaign.Campaign, error) {
-	ret := _m.Called(ctx, params)
+// ConfirmSend provides a mock function with given fields: ctx, token
+func (_m *MockServiceInterface) ConfirmSend(ctx context.Context, token string) ([]campaign.Send, error) {
+	ret := _m.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCampaignByID")
+		panic("no return value specified for ConfirmSend")
+	}
+
+	var r0 []campaign.Send
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]campaign.Send, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []campaign.Send); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Send)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ConfirmSend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmSend'
+type MockServiceInterface_ConfirmSend_Call struct {
+	*mock.Call
+}
+
+// ConfirmSend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockServiceInterface_Expecter) ConfirmSend(ctx interface{}, token interface{}) *MockServiceInterface_ConfirmSend_Call {
+	return &MockServiceInterface_ConfirmSend_Call{Call: _e.mock.On("ConfirmSend", ctx, token)}
+}
+
+func (_c *MockServiceInterface_ConfirmSend_Call) Run(run func(ctx context.Context, token string)) *MockServiceInterface_ConfirmSend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ConfirmSend_Call) Return(_a0 []campaign.Send, _a1 error) *MockServiceInterface_ConfirmSend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ConfirmSend_Call) RunAndReturn(run func(context.Context, string) ([]campaign.Send, error)) *MockServiceInterface_ConfirmSend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCampaign provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) CreateCampaign(ctx context.Context, dto *campaign.CreateCampaignDTO) (*campaign.Campaign, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampaign")
 	}
 
 	var r0 *campaign.Campaign
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)); ok {
-		return rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CreateCampaignDTO) (*campaign.Campaign, error)); ok {
+		return rf(ctx, dto)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) *campaign.Campaign); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CreateCampaignDTO) *campaign.Campaign); ok {
+		r0 = rf(ctx, dto)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*campaign.Campaign)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, campaign.GetCampaignParams) error); ok {
-		r1 = rf(ctx, params)
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.CreateCampaignDTO) error); ok {
+		r1 = rf(ctx, dto)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -275,58 +389,58 @@ func (_m *MockServiceInterface) GetCampaignByID(ctx context.Context, params camp
 	return r0, r1
 }
 
-// MockServiceInterface_GetCampaignByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignByID'
-type MockServiceInterface_GetCampaignByID_Call struct {
+// MockServiceInterface_CreateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampaign'
+type MockServiceInterface_CreateCampaign_Call struct {
 	*mock.Call
 }
 
-// GetCampaignByID is a helper method to define mock.On call
+// CreateCampaign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params campaign.GetCampaignParams
-func (_e *MockServiceInterface_Expecter) GetCampaignByID(ctx interface{}, params interface{}) *MockServiceInterface_GetCampaignByID_Call {
-	return &MockServiceInterface_GetCampaignByID_Call{Call: _e.mock.On("GetCampaignByID", ctx, params)}
+//   - dto *campaign.CreateCampaignDTO
+func (_e *MockServiceInterface_Expecter) CreateCampaign(ctx interface{}, dto interface{}) *MockServiceInterface_CreateCampaign_Call {
+	return &MockServiceInterface_CreateCampaign_Call{Call: _e.mock.On("CreateCampaign", ctx, dto)}
 }
 
-func (_c *MockServiceInterface_GetCampaignByID_Call) Run(run func(ctx context.Context, params campaign.GetCampaignParams)) *MockServiceInterface_GetCampaignByID_Call {
+func (_c *MockServiceInterface_CreateCampaign_Call) Run(run func(ctx context.Context, dto *campaign.CreateCampaignDTO)) *MockServiceInterface_CreateCampaign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(campaign.GetCampaignParams))
+		run(args[0].(context.Context), args[1].(*campaign.CreateCampaignDTO))
 	})
 	return _c
 }
 
-func (_c *MockServiceInterface_GetCampaignByID_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_GetCampaignByID_Call {
+func (_c *MockServiceInterface_CreateCampaign_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_CreateCampaign_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockServiceInterface_GetCampaignByID_Call) RunAndReturn(run func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)) *MockServiceInterface_GetCampaignByID_Call {
+func (_c *MockServiceInterface_CreateCampaign_Call) RunAndReturn(run func(context.Context, *campaign.CreateCampaignDTO) (*campaign.Campaign, error)) *MockServiceInterface_CreateCampaign_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCampaigns provides a mock function with given fields: ctx
-func (_m *MockServiceInterface) GetCampaigns(ctx context.Context) ([]campaign.Campaign, error) {
-	ret := _m.Called(ctx)
+// CreateFromStarter provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) CreateFromStarter(ctx context.Context, dto *campaign.CreateFromStarterDTO) (*campaign.Campaign, error) {
+	ret := _m.Called(ctx, dto)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCampaigns")
+		panic("no return value specified for CreateFromStarter")
 	}
 
-	var r0 []campaign.Campaign
+	var r0 *campaign.Campaign
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]campaign.Campaign, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CreateFromStarterDTO) (*campaign.Campaign, error)); ok {
+		return rf(ctx, dto)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []campaign.Campaign); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.CreateFromStarterDTO) *campaign.Campaign); ok {
+		r0 = rf(ctx, dto)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]campaign.Campaign)
+			r0 = ret.Get(0).(*campaign.Campaign)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.CreateFromStarterDTO) error); ok {
+		r1 = rf(ctx, dto)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -334,30 +448,1269 @@ func (_m *MockServiceInterface) GetCampaigns(ctx context.Context) ([]campaign.Ca
 	return r0, r1
 }
 
-// MockServiceInterface_GetCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaigns'
-type MockServiceInterface_GetCampaigns_Call struct {
+// MockServiceInterface_CreateFromStarter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFromStarter'
+type MockServiceInterface_CreateFromStarter_Call struct {
 	*mock.Call
 }
 
-// GetCampaigns is a helper method to define mock.On call
+// CreateFromStarter is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockServiceInterface_Expecter) GetCampaigns(ctx interface{}) *MockServiceInterface_GetCampaigns_Call {
-	return &MockServiceInterface_GetCampaigns_Call{Call: _e.mock.On("GetCampaigns", ctx)}
+//   - dto *campaign.CreateFromStarterDTO
+func (_e *MockServiceInterface_Expecter) CreateFromStarter(ctx interface{}, dto interface{}) *MockServiceInterface_CreateFromStarter_Call {
+	return &MockServiceInterface_CreateFromStarter_Call{Call: _e.mock.On("CreateFromStarter", ctx, dto)}
 }
 
-func (_c *MockServiceInterface_GetCampaigns_Call) Run(run func(ctx context.Context)) *MockServiceInterface_GetCampaigns_Call {
+func (_c *MockServiceInterface_CreateFromStarter_Call) Run(run func(ctx context.Context, dto *campaign.CreateFromStarterDTO)) *MockServiceInterface_CreateFromStarter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*campaign.CreateFromStarterDTO))
 	})
 	return _c
 }
 
-func (_c *MockServiceInterface_GetCampaigns_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockServiceInterface_GetCampaigns_Call {
+func (_c *MockServiceInterface_CreateFromStarter_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_CreateFromStarter_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockServiceInterface_GetCampaigns_Call) RunAndReturn(run func(context.Context) ([]campaign.Campaign, error)) *MockServiceInterface_GetCampaigns_Call {
+func (_c *MockServiceInterface_CreateFromStarter_Call) RunAndReturn(run func(context.Context, *campaign.CreateFromStarterDTO) (*campaign.Campaign, error)) *MockServiceInterface_CreateFromStarter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCampaign provides a mock function with given fields: ctx, params
+func (_m *MockServiceInterface) DeleteCampaign(ctx context.Context, params campaign.DeleteCampaignDTO) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.DeleteCampaignDTO) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceInterface_DeleteCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCampaign'
+type MockServiceInterface_DeleteCampaign_Call struct {
+	*mock.Call
+}
+
+// DeleteCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params campaign.DeleteCampaignDTO
+func (_e *MockServiceInterface_Expecter) DeleteCampaign(ctx interface{}, params interface{}) *MockServiceInterface_DeleteCampaign_Call {
+	return &MockServiceInterface_DeleteCampaign_Call{Call: _e.mock.On("DeleteCampaign", ctx, params)}
+}
+
+func (_c *MockServiceInterface_DeleteCampaign_Call) Run(run func(ctx context.Context, params campaign.DeleteCampaignDTO)) *MockServiceInterface_DeleteCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.DeleteCampaignDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_DeleteCampaign_Call) Return(_a0 error) *MockServiceInterface_DeleteCampaign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_DeleteCampaign_Call) RunAndReturn(run func(context.Context, campaign.DeleteCampaignDTO) error) *MockServiceInterface_DeleteCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicateCampaign provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) DuplicateCampaign(ctx context.Context, dto *campaign.DuplicateCampaignDTO) (*campaign.Campaign, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DuplicateCampaign")
+	}
+
+	var r0 *campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.DuplicateCampaignDTO) (*campaign.Campaign, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.DuplicateCampaignDTO) *campaign.Campaign); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.DuplicateCampaignDTO) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_DuplicateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateCampaign'
+type MockServiceInterface_DuplicateCampaign_Call struct {
+	*mock.Call
+}
+
+// DuplicateCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto *campaign.DuplicateCampaignDTO
+func (_e *MockServiceInterface_Expecter) DuplicateCampaign(ctx interface{}, dto interface{}) *MockServiceInterface_DuplicateCampaign_Call {
+	return &MockServiceInterface_DuplicateCampaign_Call{Call: _e.mock.On("DuplicateCampaign", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_DuplicateCampaign_Call) Run(run func(ctx context.Context, dto *campaign.DuplicateCampaignDTO)) *MockServiceInterface_DuplicateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.DuplicateCampaignDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_DuplicateCampaign_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_DuplicateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_DuplicateCampaign_Call) RunAndReturn(run func(context.Context, *campaign.DuplicateCampaignDTO) (*campaign.Campaign, error)) *MockServiceInterface_DuplicateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireUnconfirmedSends provides a mock function with given fields: ctx, now
+func (_m *MockServiceInterface) ExpireUnconfirmedSends(ctx context.Context, now time.Time) error {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireUnconfirmedSends")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceInterface_ExpireUnconfirmedSends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireUnconfirmedSends'
+type MockServiceInterface_ExpireUnconfirmedSends_Call struct {
+	*mock.Call
+}
+
+// ExpireUnconfirmedSends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockServiceInterface_Expecter) ExpireUnconfirmedSends(ctx interface{}, now interface{}) *MockServiceInterface_ExpireUnconfirmedSends_Call {
+	return &MockServiceInterface_ExpireUnconfirmedSends_Call{Call: _e.mock.On("ExpireUnconfirmedSends", ctx, now)}
+}
+
+func (_c *MockServiceInterface_ExpireUnconfirmedSends_Call) Run(run func(ctx context.Context, now time.Time)) *MockServiceInterface_ExpireUnconfirmedSends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ExpireUnconfirmedSends_Call) Return(_a0 error) *MockServiceInterface_ExpireUnconfirmedSends_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_ExpireUnconfirmedSends_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockServiceInterface_ExpireUnconfirmedSends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchCampaign provides a mock function with given fields: ctx, params
+func (_m *MockServiceInterface) FetchCampaign(ctx context.Context, params campaign.GetCampaignParams) (*campaign.Campaign, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCampaign")
+	}
+
+	var r0 *campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) *campaign.Campaign); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, campaign.GetCampaignParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_FetchCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchCampaign'
+type MockServiceInterface_FetchCampaign_Call struct {
+	*mock.Call
+}
+
+// FetchCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params campaign.GetCampaignParams
+func (_e *MockServiceInterface_Expecter) FetchCampaign(ctx interface{}, params interface{}) *MockServiceInterface_FetchCampaign_Call {
+	return &MockServiceInterface_FetchCampaign_Call{Call: _e.mock.On("FetchCampaign", ctx, params)}
+}
+
+func (_c *MockServiceInterface_FetchCampaign_Call) Run(run func(ctx context.Context, params campaign.GetCampaignParams)) *MockServiceInterface_FetchCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.GetCampaignParams))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_FetchCampaign_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_FetchCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_FetchCampaign_Call) RunAndReturn(run func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)) *MockServiceInterface_FetchCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveCampaigns provides a mock function with given fields: ctx
+func (_m *MockServiceInterface) GetActiveCampaigns(ctx context.Context) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveCampaigns")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]campaign.Campaign, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []campaign.Campaign); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetActiveCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveCampaigns'
+type MockServiceInterface_GetActiveCampaigns_Call struct {
+	*mock.Call
+}
+
+// GetActiveCampaigns is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockServiceInterface_Expecter) GetActiveCampaigns(ctx interface{}) *MockServiceInterface_GetActiveCampaigns_Call {
+	return &MockServiceInterface_GetActiveCampaigns_Call{Call: _e.mock.On("GetActiveCampaigns", ctx)}
+}
+
+func (_c *MockServiceInterface_GetActiveCampaigns_Call) Run(run func(ctx context.Context)) *MockServiceInterface_GetActiveCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetActiveCampaigns_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockServiceInterface_GetActiveCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetActiveCampaigns_Call) RunAndReturn(run func(context.Context) ([]campaign.Campaign, error)) *MockServiceInterface_GetActiveCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignByID provides a mock function with given fields: ctx, params
+func (_m *MockServiceInterface) GetCampaignByID(ctx context.Context, params campaign.GetCampaignParams) (*campaign.Campaign, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignByID")
+	}
+
+	var r0 *campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.GetCampaignParams) *campaign.Campaign); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, campaign.GetCampaignParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetCampaignByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignByID'
+type MockServiceInterface_GetCampaignByID_Call struct {
+	*mock.Call
+}
+
+// GetCampaignByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params campaign.GetCampaignParams
+func (_e *MockServiceInterface_Expecter) GetCampaignByID(ctx interface{}, params interface{}) *MockServiceInterface_GetCampaignByID_Call {
+	return &MockServiceInterface_GetCampaignByID_Call{Call: _e.mock.On("GetCampaignByID", ctx, params)}
+}
+
+func (_c *MockServiceInterface_GetCampaignByID_Call) Run(run func(ctx context.Context, params campaign.GetCampaignParams)) *MockServiceInterface_GetCampaignByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.GetCampaignParams))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaignByID_Call) Return(_a0 *campaign.Campaign, _a1 error) *MockServiceInterface_GetCampaignByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaignByID_Call) RunAndReturn(run func(context.Context, campaign.GetCampaignParams) (*campaign.Campaign, error)) *MockServiceInterface_GetCampaignByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaigns provides a mock function with given fields: ctx
+func (_m *MockServiceInterface) GetCampaigns(ctx context.Context) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaigns")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]campaign.Campaign, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []campaign.Campaign); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaigns'
+type MockServiceInterface_GetCampaigns_Call struct {
+	*mock.Call
+}
+
+// GetCampaigns is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockServiceInterface_Expecter) GetCampaigns(ctx interface{}) *MockServiceInterface_GetCampaigns_Call {
+	return &MockServiceInterface_GetCampaigns_Call{Call: _e.mock.On("GetCampaigns", ctx)}
+}
+
+func (_c *MockServiceInterface_GetCampaigns_Call) Run(run func(ctx context.Context)) *MockServiceInterface_GetCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaigns_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockServiceInterface_GetCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaigns_Call) RunAndReturn(run func(context.Context) ([]campaign.Campaign, error)) *MockServiceInterface_GetCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignsByOrganization provides a mock function with given fields: ctx, orgID
+func (_m *MockServiceInterface) GetCampaignsByOrganization(ctx context.Context, orgID uuid.UUID) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignsByOrganization")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Campaign); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetCampaignsByOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignsByOrganization'
+type MockServiceInterface_GetCampaignsByOrganization_Call struct {
+	*mock.Call
+}
+
+// GetCampaignsByOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *MockServiceInterface_Expecter) GetCampaignsByOrganization(ctx interface{}, orgID interface{}) *MockServiceInterface_GetCampaignsByOrganization_Call {
+	return &MockServiceInterface_GetCampaignsByOrganization_Call{Call: _e.mock.On("GetCampaignsByOrganization", ctx, orgID)}
+}
+
+func (_c *MockServiceInterface_GetCampaignsByOrganization_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *MockServiceInterface_GetCampaignsByOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaignsByOrganization_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockServiceInterface_GetCampaignsByOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaignsByOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Campaign, error)) *MockServiceInterface_GetCampaignsByOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignsByOwner provides a mock function with given fields: ctx, ownerID
+func (_m *MockServiceInterface) GetCampaignsByOwner(ctx context.Context, ownerID uuid.UUID) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignsByOwner")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Campaign); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetCampaignsByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignsByOwner'
+type MockServiceInterface_GetCampaignsByOwner_Call struct {
+	*mock.Call
+}
+
+// GetCampaignsByOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+func (_e *MockServiceInterface_Expecter) GetCampaignsByOwner(ctx interface{}, ownerID interface{}) *MockServiceInterface_GetCampaignsByOwner_Call {
+	return &MockServiceInterface_GetCampaignsByOwner_Call{Call: _e.mock.On("GetCampaignsByOwner", ctx, ownerID)}
+}
+
+func (_c *MockServiceInterface_GetCampaignsByOwner_Call) Run(run func(ctx context.Context, ownerID uuid.UUID)) *MockServiceInterface_GetCampaignsByOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaignsByOwner_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockServiceInterface_GetCampaignsByOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetCampaignsByOwner_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Campaign, error)) *MockServiceInterface_GetCampaignsByOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfirmation provides a mock function with given fields: ctx, token
+func (_m *MockServiceInterface) GetConfirmation(ctx context.Context, token string) ([]campaign.Send, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfirmation")
+	}
+
+	var r0 []campaign.Send
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]campaign.Send, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []campaign.Send); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Send)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetConfirmation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfirmation'
+type MockServiceInterface_GetConfirmation_Call struct {
+	*mock.Call
+}
+
+// GetConfirmation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockServiceInterface_Expecter) GetConfirmation(ctx interface{}, token interface{}) *MockServiceInterface_GetConfirmation_Call {
+	return &MockServiceInterface_GetConfirmation_Call{Call: _e.mock.On("GetConfirmation", ctx, token)}
+}
+
+func (_c *MockServiceInterface_GetConfirmation_Call) Run(run func(ctx context.Context, token string)) *MockServiceInterface_GetConfirmation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetConfirmation_Call) Return(_a0 []campaign.Send, _a1 error) *MockServiceInterface_GetConfirmation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetConfirmation_Call) RunAndReturn(run func(context.Context, string) ([]campaign.Send, error)) *MockServiceInterface_GetConfirmation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationDashboard provides a mock function with given fields: ctx, orgID
+func (_m *MockServiceInterface) GetOrganizationDashboard(ctx context.Context, orgID uuid.UUID) (*campaign.OrganizationDashboard, error) {
+	ret := _m.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationDashboard")
+	}
+
+	var r0 *campaign.OrganizationDashboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*campaign.OrganizationDashboard, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *campaign.OrganizationDashboard); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.OrganizationDashboard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetOrganizationDashboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationDashboard'
+type MockServiceInterface_GetOrganizationDashboard_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationDashboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *MockServiceInterface_Expecter) GetOrganizationDashboard(ctx interface{}, orgID interface{}) *MockServiceInterface_GetOrganizationDashboard_Call {
+	return &MockServiceInterface_GetOrganizationDashboard_Call{Call: _e.mock.On("GetOrganizationDashboard", ctx, orgID)}
+}
+
+func (_c *MockServiceInterface_GetOrganizationDashboard_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *MockServiceInterface_GetOrganizationDashboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetOrganizationDashboard_Call) Return(_a0 *campaign.OrganizationDashboard, _a1 error) *MockServiceInterface_GetOrganizationDashboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetOrganizationDashboard_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*campaign.OrganizationDashboard, error)) *MockServiceInterface_GetOrganizationDashboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSharedCampaigns provides a mock function with given fields: ctx, userID
+func (_m *MockServiceInterface) GetSharedCampaigns(ctx context.Context, userID uuid.UUID) ([]campaign.Campaign, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedCampaigns")
+	}
+
+	var r0 []campaign.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Campaign, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Campaign); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetSharedCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSharedCampaigns'
+type MockServiceInterface_GetSharedCampaigns_Call struct {
+	*mock.Call
+}
+
+// GetSharedCampaigns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockServiceInterface_Expecter) GetSharedCampaigns(ctx interface{}, userID interface{}) *MockServiceInterface_GetSharedCampaigns_Call {
+	return &MockServiceInterface_GetSharedCampaigns_Call{Call: _e.mock.On("GetSharedCampaigns", ctx, userID)}
+}
+
+func (_c *MockServiceInterface_GetSharedCampaigns_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockServiceInterface_GetSharedCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetSharedCampaigns_Call) Return(_a0 []campaign.Campaign, _a1 error) *MockServiceInterface_GetSharedCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetSharedCampaigns_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Campaign, error)) *MockServiceInterface_GetSharedCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStarterTemplate provides a mock function with given fields: ctx, id
+func (_m *MockServiceInterface) GetStarterTemplate(ctx context.Context, id uuid.UUID) (*campaign.StarterTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStarterTemplate")
+	}
+
+	var r0 *campaign.StarterTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*campaign.StarterTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *campaign.StarterTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.StarterTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetStarterTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStarterTemplate'
+type MockServiceInterface_GetStarterTemplate_Call struct {
+	*mock.Call
+}
+
+// GetStarterTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockServiceInterface_Expecter) GetStarterTemplate(ctx interface{}, id interface{}) *MockServiceInterface_GetStarterTemplate_Call {
+	return &MockServiceInterface_GetStarterTemplate_Call{Call: _e.mock.On("GetStarterTemplate", ctx, id)}
+}
+
+func (_c *MockServiceInterface_GetStarterTemplate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockServiceInterface_GetStarterTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetStarterTemplate_Call) Return(_a0 *campaign.StarterTemplate, _a1 error) *MockServiceInterface_GetStarterTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetStarterTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*campaign.StarterTemplate, error)) *MockServiceInterface_GetStarterTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportCampaigns provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) ImportCampaigns(ctx context.Context, dto *campaign.ImportCampaignsDTO) (*campaign.ImportResult, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCampaigns")
+	}
+
+	var r0 *campaign.ImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.ImportCampaignsDTO) (*campaign.ImportResult, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.ImportCampaignsDTO) *campaign.ImportResult); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.ImportResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.ImportCampaignsDTO) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ImportCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportCampaigns'
+type MockServiceInterface_ImportCampaigns_Call struct {
+	*mock.Call
+}
+
+// ImportCampaigns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto *campaign.ImportCampaignsDTO
+func (_e *MockServiceInterface_Expecter) ImportCampaigns(ctx interface{}, dto interface{}) *MockServiceInterface_ImportCampaigns_Call {
+	return &MockServiceInterface_ImportCampaigns_Call{Call: _e.mock.On("ImportCampaigns", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_ImportCampaigns_Call) Run(run func(ctx context.Context, dto *campaign.ImportCampaignsDTO)) *MockServiceInterface_ImportCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.ImportCampaignsDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ImportCampaigns_Call) Return(_a0 *campaign.ImportResult, _a1 error) *MockServiceInterface_ImportCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ImportCampaigns_Call) RunAndReturn(run func(context.Context, *campaign.ImportCampaignsDTO) (*campaign.ImportResult, error)) *MockServiceInterface_ImportCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteMember provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) InviteMember(ctx context.Context, dto *campaign.InviteMemberDTO) (*campaign.Member, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteMember")
+	}
+
+	var r0 *campaign.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.InviteMemberDTO) (*campaign.Member, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.InviteMemberDTO) *campaign.Member); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.InviteMemberDTO) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_InviteMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteMember'
+type MockServiceInterface_InviteMember_Call struct {
+	*mock.Call
+}
+
+// InviteMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto *campaign.InviteMemberDTO
+func (_e *MockServiceInterface_Expecter) InviteMember(ctx interface{}, dto interface{}) *MockServiceInterface_InviteMember_Call {
+	return &MockServiceInterface_InviteMember_Call{Call: _e.mock.On("InviteMember", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_InviteMember_Call) Run(run func(ctx context.Context, dto *campaign.InviteMemberDTO)) *MockServiceInterface_InviteMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.InviteMemberDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_InviteMember_Call) Return(_a0 *campaign.Member, _a1 error) *MockServiceInterface_InviteMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_InviteMember_Call) RunAndReturn(run func(context.Context, *campaign.InviteMemberDTO) (*campaign.Member, error)) *MockServiceInterface_InviteMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCampaigns provides a mock function with given fields: ctx, params
+func (_m *MockServiceInterface) ListCampaigns(ctx context.Context, params campaign.ListCampaignsParams) (*campaign.CampaignPage, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCampaigns")
+	}
+
+	var r0 *campaign.CampaignPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ListCampaignsParams) (*campaign.CampaignPage, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.ListCampaignsParams) *campaign.CampaignPage); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.CampaignPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, campaign.ListCampaignsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ListCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCampaigns'
+type MockServiceInterface_ListCampaigns_Call struct {
+	*mock.Call
+}
+
+// ListCampaigns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params campaign.ListCampaignsParams
+func (_e *MockServiceInterface_Expecter) ListCampaigns(ctx interface{}, params interface{}) *MockServiceInterface_ListCampaigns_Call {
+	return &MockServiceInterface_ListCampaigns_Call{Call: _e.mock.On("ListCampaigns", ctx, params)}
+}
+
+func (_c *MockServiceInterface_ListCampaigns_Call) Run(run func(ctx context.Context, params campaign.ListCampaignsParams)) *MockServiceInterface_ListCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.ListCampaignsParams))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ListCampaigns_Call) Return(_a0 *campaign.CampaignPage, _a1 error) *MockServiceInterface_ListCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ListCampaigns_Call) RunAndReturn(run func(context.Context, campaign.ListCampaignsParams) (*campaign.CampaignPage, error)) *MockServiceInterface_ListCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, campaignID
+func (_m *MockServiceInterface) ListMembers(ctx context.Context, campaignID uuid.UUID) ([]campaign.Member, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 []campaign.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]campaign.Member, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []campaign.Member); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type MockServiceInterface_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID uuid.UUID
+func (_e *MockServiceInterface_Expecter) ListMembers(ctx interface{}, campaignID interface{}) *MockServiceInterface_ListMembers_Call {
+	return &MockServiceInterface_ListMembers_Call{Call: _e.mock.On("ListMembers", ctx, campaignID)}
+}
+
+func (_c *MockServiceInterface_ListMembers_Call) Run(run func(ctx context.Context, campaignID uuid.UUID)) *MockServiceInterface_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ListMembers_Call) Return(_a0 []campaign.Member, _a1 error) *MockServiceInterface_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ListMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]campaign.Member, error)) *MockServiceInterface_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStarterTemplates provides a mock function with given fields: ctx
+func (_m *MockServiceInterface) ListStarterTemplates(ctx context.Context) ([]campaign.StarterTemplate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStarterTemplates")
+	}
+
+	var r0 []campaign.StarterTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]campaign.StarterTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []campaign.StarterTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.StarterTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ListStarterTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStarterTemplates'
+type MockServiceInterface_ListStarterTemplates_Call struct {
+	*mock.Call
+}
+
+// ListStarterTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockServiceInterface_Expecter) ListStarterTemplates(ctx interface{}) *MockServiceInterface_ListStarterTemplates_Call {
+	return &MockServiceInterface_ListStarterTemplates_Call{Call: _e.mock.On("ListStarterTemplates", ctx)}
+}
+
+func (_c *MockServiceInterface_ListStarterTemplates_Call) Run(run func(ctx context.Context)) *MockServiceInterface_ListStarterTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ListStarterTemplates_Call) Return(_a0 []campaign.StarterTemplate, _a1 error) *MockServiceInterface_ListStarterTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ListStarterTemplates_Call) RunAndReturn(run func(context.Context) ([]campaign.StarterTemplate, error)) *MockServiceInterface_ListStarterTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) RemoveMember(ctx context.Context, dto campaign.RemoveMemberDTO) error {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, campaign.RemoveMemberDTO) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceInterface_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockServiceInterface_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto campaign.RemoveMemberDTO
+func (_e *MockServiceInterface_Expecter) RemoveMember(ctx interface{}, dto interface{}) *MockServiceInterface_RemoveMember_Call {
+	return &MockServiceInterface_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_RemoveMember_Call) Run(run func(ctx context.Context, dto campaign.RemoveMemberDTO)) *MockServiceInterface_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(campaign.RemoveMemberDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_RemoveMember_Call) Return(_a0 error) *MockServiceInterface_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_RemoveMember_Call) RunAndReturn(run func(context.Context, campaign.RemoveMemberDTO) error) *MockServiceInterface_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendCampaignEmail provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) SendCampaignEmail(ctx context.Context, dto *campaign.SendCampaignEmailDTO) (*campaign.Send, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCampaignEmail")
+	}
+
+	var r0 *campaign.Send
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.SendCampaignEmailDTO) (*campaign.Send, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *campaign.SendCampaignEmailDTO) *campaign.Send); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.Send)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *campaign.SendCampaignEmailDTO) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_SendCampaignEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCampaignEmail'
+type MockServiceInterface_SendCampaignEmail_Call struct {
+	*mock.Call
+}
+
+// SendCampaignEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto *campaign.SendCampaignEmailDTO
+func (_e *MockServiceInterface_Expecter) SendCampaignEmail(ctx interface{}, dto interface{}) *MockServiceInterface_SendCampaignEmail_Call {
+	return &MockServiceInterface_SendCampaignEmail_Call{Call: _e.mock.On("SendCampaignEmail", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_SendCampaignEmail_Call) Run(run func(ctx context.Context, dto *campaign.SendCampaignEmailDTO)) *MockServiceInterface_SendCampaignEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*campaign.SendCampaignEmailDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_SendCampaignEmail_Call) Return(_a0 *campaign.Send, _a1 error) *MockServiceInterface_SendCampaignEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_SendCampaignEmail_Call) RunAndReturn(run func(context.Context, *campaign.SendCampaignEmailDTO) (*campaign.Send, error)) *MockServiceInterface_SendCampaignEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendCampaignEmails provides a mock function with given fields: ctx, dtos
+func (_m *MockServiceInterface) SendCampaignEmails(ctx context.Context, dtos []*campaign.SendCampaignEmailDTO) []campaign.SendResult {
+	ret := _m.Called(ctx, dtos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCampaignEmails")
+	}
+
+	var r0 []campaign.SendResult
+	if rf, ok := ret.Get(0).(func(context.Context, []*campaign.SendCampaignEmailDTO) []campaign.SendResult); ok {
+		r0 = rf(ctx, dtos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.SendResult)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceInterface_SendCampaignEmails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCampaignEmails'
+type MockServiceInterface_SendCampaignEmails_Call struct {
+	*mock.Call
+}
+
+// SendCampaignEmails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dtos []*campaign.SendCampaignEmailDTO
+func (_e *MockServiceInterface_Expecter) SendCampaignEmails(ctx interface{}, dtos interface{}) *MockServiceInterface_SendCampaignEmails_Call {
+	return &MockServiceInterface_SendCampaignEmails_Call{Call: _e.mock.On("SendCampaignEmails", ctx, dtos)}
+}
+
+func (_c *MockServiceInterface_SendCampaignEmails_Call) Run(run func(ctx context.Context, dtos []*campaign.SendCampaignEmailDTO)) *MockServiceInterface_SendCampaignEmails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*campaign.SendCampaignEmailDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_SendCampaignEmails_Call) Return(_a0 []campaign.SendResult) *MockServiceInterface_SendCampaignEmails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_SendCampaignEmails_Call) RunAndReturn(run func(context.Context, []*campaign.SendCampaignEmailDTO) []campaign.SendResult) *MockServiceInterface_SendCampaignEmails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamSends provides a mock function with given fields: ctx, campaignID, fn
+func (_m *MockServiceInterface) StreamSends(ctx context.Context, campaignID uuid.UUID, fn func(*campaign.Send) error) error {
+	ret := _m.Called(ctx, campaignID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamSends")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*campaign.Send) error) error); ok {
+		r0 = rf(ctx, campaignID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceInterface_StreamSends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSends'
+type MockServiceInterface_StreamSends_Call struct {
+	*mock.Call
+}
+
+// StreamSends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID uuid.UUID
+//   - fn func(*campaign.Send) error
+func (_e *MockServiceInterface_Expecter) StreamSends(ctx interface{}, campaignID interface{}, fn interface{}) *MockServiceInterface_StreamSends_Call {
+	return &MockServiceInterface_StreamSends_Call{Call: _e.mock.On("StreamSends", ctx, campaignID, fn)}
+}
+
+func (_c *MockServiceInterface_StreamSends_Call) Run(run func(ctx context.Context, campaignID uuid.UUID, fn func(*campaign.Send) error)) *MockServiceInterface_StreamSends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*campaign.Send) error))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_StreamSends_Call) Return(_a0 error) *MockServiceInterface_StreamSends_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_StreamSends_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*campaign.Send) error) error) *MockServiceInterface_StreamSends_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,157 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	campaign "github.com/jonesrussell/mp-emailer/campaign"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockStarterTemplateRepositoryInterface is an autogenerated mock type for the StarterTemplateRepositoryInterface type
+type MockStarterTemplateRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockStarterTemplateRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStarterTemplateRepositoryInterface) EXPECT() *MockStarterTemplateRepositoryInterface_Expecter {
+	return &MockStarterTemplateRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockStarterTemplateRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*campaign.StarterTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *campaign.StarterTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*campaign.StarterTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *campaign.StarterTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*campaign.StarterTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStarterTemplateRepositoryInterface_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockStarterTemplateRepositoryInterface_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStarterTemplateRepositoryInterface_Expecter) GetByID(ctx interface{}, id interface{}) *MockStarterTemplateRepositoryInterface_GetByID_Call {
+	return &MockStarterTemplateRepositoryInterface_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockStarterTemplateRepositoryInterface_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStarterTemplateRepositoryInterface_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStarterTemplateRepositoryInterface_GetByID_Call) Return(_a0 *campaign.StarterTemplate, _a1 error) *MockStarterTemplateRepositoryInterface_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStarterTemplateRepositoryInterface_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*campaign.StarterTemplate, error)) *MockStarterTemplateRepositoryInterface_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *MockStarterTemplateRepositoryInterface) List(ctx context.Context) ([]campaign.StarterTemplate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []campaign.StarterTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]campaign.StarterTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []campaign.StarterTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]campaign.StarterTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStarterTemplateRepositoryInterface_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStarterTemplateRepositoryInterface_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStarterTemplateRepositoryInterface_Expecter) List(ctx interface{}) *MockStarterTemplateRepositoryInterface_List_Call {
+	return &MockStarterTemplateRepositoryInterface_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockStarterTemplateRepositoryInterface_List_Call) Run(run func(ctx context.Context)) *MockStarterTemplateRepositoryInterface_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStarterTemplateRepositoryInterface_List_Call) Return(_a0 []campaign.StarterTemplate, _a1 error) *MockStarterTemplateRepositoryInterface_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStarterTemplateRepositoryInterface_List_Call) RunAndReturn(run func(context.Context) ([]campaign.StarterTemplate, error)) *MockStarterTemplateRepositoryInterface_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStarterTemplateRepositoryInterface creates a new instance of MockStarterTemplateRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStarterTemplateRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStarterTemplateRepositoryInterface {
+	mock := &MockStarterTemplateRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
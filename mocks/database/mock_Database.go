@@ -328,6 +328,64 @@ func (_c *MockDatabase_FindOne_Call) RunAndReturn(run func(context.Context, inte
 	return _c
 }
 
+// FindPage provides a mock function with given fields: ctx, dest, opts
+func (_m *MockDatabase) FindPage(ctx context.Context, dest interface{}, opts database.QueryOptions) (int64, error) {
+	ret := _m.Called(ctx, dest, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPage")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, database.QueryOptions) (int64, error)); ok {
+		return rf(ctx, dest, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, database.QueryOptions) int64); ok {
+		r0 = rf(ctx, dest, opts)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, database.QueryOptions) error); ok {
+		r1 = rf(ctx, dest, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_FindPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPage'
+type MockDatabase_FindPage_Call struct {
+	*mock.Call
+}
+
+// FindPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dest interface{}
+//   - opts database.QueryOptions
+func (_e *MockDatabase_Expecter) FindPage(ctx interface{}, dest interface{}, opts interface{}) *MockDatabase_FindPage_Call {
+	return &MockDatabase_FindPage_Call{Call: _e.mock.On("FindPage", ctx, dest, opts)}
+}
+
+func (_c *MockDatabase_FindPage_Call) Run(run func(ctx context.Context, dest interface{}, opts database.QueryOptions)) *MockDatabase_FindPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(database.QueryOptions))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_FindPage_Call) Return(_a0 int64, _a1 error) *MockDatabase_FindPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_FindPage_Call) RunAndReturn(run func(context.Context, interface{}, database.QueryOptions) (int64, error)) *MockDatabase_FindPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transaction provides a mock function with given fields: ctx, fn
 func (_m *MockDatabase) Transaction(ctx context.Context, fn func(database.Database) error) error {
 	ret := _m.Called(ctx, fn)
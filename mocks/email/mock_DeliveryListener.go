@@ -0,0 +1,105 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	email "github.com/jonesrussell/mp-emailer/email"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeliveryListener is an autogenerated mock type for the DeliveryListener type
+type MockDeliveryListener struct {
+	mock.Mock
+}
+
+type MockDeliveryListener_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeliveryListener) EXPECT() *MockDeliveryListener_Expecter {
+	return &MockDeliveryListener_Expecter{mock: &_m.Mock}
+}
+
+// MessageDead provides a mock function with given fields: ctx, msg
+func (_m *MockDeliveryListener) MessageDead(ctx context.Context, msg *email.QueuedMessage) {
+	_m.Called(ctx, msg)
+}
+
+// MockDeliveryListener_MessageDead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageDead'
+type MockDeliveryListener_MessageDead_Call struct {
+	*mock.Call
+}
+
+// MessageDead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *email.QueuedMessage
+func (_e *MockDeliveryListener_Expecter) MessageDead(ctx interface{}, msg interface{}) *MockDeliveryListener_MessageDead_Call {
+	return &MockDeliveryListener_MessageDead_Call{Call: _e.mock.On("MessageDead", ctx, msg)}
+}
+
+func (_c *MockDeliveryListener_MessageDead_Call) Run(run func(ctx context.Context, msg *email.QueuedMessage)) *MockDeliveryListener_MessageDead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*email.QueuedMessage))
+	})
+	return _c
+}
+
+func (_c *MockDeliveryListener_MessageDead_Call) Return() *MockDeliveryListener_MessageDead_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockDeliveryListener_MessageDead_Call) RunAndReturn(run func(context.Context, *email.QueuedMessage)) *MockDeliveryListener_MessageDead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessageSent provides a mock function with given fields: ctx, msg
+func (_m *MockDeliveryListener) MessageSent(ctx context.Context, msg *email.QueuedMessage) {
+	_m.Called(ctx, msg)
+}
+
+// MockDeliveryListener_MessageSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageSent'
+type MockDeliveryListener_MessageSent_Call struct {
+	*mock.Call
+}
+
+// MessageSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *email.QueuedMessage
+func (_e *MockDeliveryListener_Expecter) MessageSent(ctx interface{}, msg interface{}) *MockDeliveryListener_MessageSent_Call {
+	return &MockDeliveryListener_MessageSent_Call{Call: _e.mock.On("MessageSent", ctx, msg)}
+}
+
+func (_c *MockDeliveryListener_MessageSent_Call) Run(run func(ctx context.Context, msg *email.QueuedMessage)) *MockDeliveryListener_MessageSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*email.QueuedMessage))
+	})
+	return _c
+}
+
+func (_c *MockDeliveryListener_MessageSent_Call) Return() *MockDeliveryListener_MessageSent_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockDeliveryListener_MessageSent_Call) RunAndReturn(run func(context.Context, *email.QueuedMessage)) *MockDeliveryListener_MessageSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeliveryListener creates a new instance of MockDeliveryListener. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeliveryListener(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeliveryListener {
+	mock := &MockDeliveryListener{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	email "github.com/jonesrussell/mp-emailer/email"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockQueue is an autogenerated mock type for the Queue type
+type MockQueue struct {
+	mock.Mock
+}
+
+type MockQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQueue) EXPECT() *MockQueue_Expecter {
+	return &MockQueue_Expecter{mock: &_m.Mock}
+}
+
+// Enqueue provides a mock function with given fields: ctx, msg
+func (_m *MockQueue) Enqueue(ctx context.Context, msg *email.QueuedMessage) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *email.QueuedMessage) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueue_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockQueue_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *email.QueuedMessage
+func (_e *MockQueue_Expecter) Enqueue(ctx interface{}, msg interface{}) *MockQueue_Enqueue_Call {
+	return &MockQueue_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, msg)}
+}
+
+func (_c *MockQueue_Enqueue_Call) Run(run func(ctx context.Context, msg *email.QueuedMessage)) *MockQueue_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*email.QueuedMessage))
+	})
+	return _c
+}
+
+func (_c *MockQueue_Enqueue_Call) Return(_a0 error) *MockQueue_Enqueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueue_Enqueue_Call) RunAndReturn(run func(context.Context, *email.QueuedMessage) error) *MockQueue_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQueue creates a new instance of MockQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQueue {
+	mock := &MockQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
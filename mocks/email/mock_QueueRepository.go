@@ -0,0 +1,194 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	email "github.com/jonesrussell/mp-emailer/email"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockQueueRepository is an autogenerated mock type for the QueueRepository type
+type MockQueueRepository struct {
+	mock.Mock
+}
+
+type MockQueueRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQueueRepository) EXPECT() *MockQueueRepository_Expecter {
+	return &MockQueueRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now, limit, lockFor
+func (_m *MockQueueRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lockFor time.Duration) ([]*email.QueuedMessage, error) {
+	ret := _m.Called(ctx, now, limit, lockFor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []*email.QueuedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, time.Duration) ([]*email.QueuedMessage, error)); ok {
+		return rf(ctx, now, limit, lockFor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, time.Duration) []*email.QueuedMessage); ok {
+		r0 = rf(ctx, now, limit, lockFor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*email.QueuedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, time.Duration) error); ok {
+		r1 = rf(ctx, now, limit, lockFor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueueRepository_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type MockQueueRepository_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+//   - lockFor time.Duration
+func (_e *MockQueueRepository_Expecter) ClaimDue(ctx interface{}, now interface{}, limit interface{}, lockFor interface{}) *MockQueueRepository_ClaimDue_Call {
+	return &MockQueueRepository_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, limit, lockFor)}
+}
+
+func (_c *MockQueueRepository_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, limit int, lockFor time.Duration)) *MockQueueRepository_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockQueueRepository_ClaimDue_Call) Return(_a0 []*email.QueuedMessage, _a1 error) *MockQueueRepository_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueueRepository_ClaimDue_Call) RunAndReturn(run func(context.Context, time.Time, int, time.Duration) ([]*email.QueuedMessage, error)) *MockQueueRepository_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, msg
+func (_m *MockQueueRepository) Create(ctx context.Context, msg *email.QueuedMessage) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *email.QueuedMessage) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueueRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockQueueRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *email.QueuedMessage
+func (_e *MockQueueRepository_Expecter) Create(ctx interface{}, msg interface{}) *MockQueueRepository_Create_Call {
+	return &MockQueueRepository_Create_Call{Call: _e.mock.On("Create", ctx, msg)}
+}
+
+func (_c *MockQueueRepository_Create_Call) Run(run func(ctx context.Context, msg *email.QueuedMessage)) *MockQueueRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*email.QueuedMessage))
+	})
+	return _c
+}
+
+func (_c *MockQueueRepository_Create_Call) Return(_a0 error) *MockQueueRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueueRepository_Create_Call) RunAndReturn(run func(context.Context, *email.QueuedMessage) error) *MockQueueRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, msg
+func (_m *MockQueueRepository) Update(ctx context.Context, msg *email.QueuedMessage) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *email.QueuedMessage) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueueRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockQueueRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *email.QueuedMessage
+func (_e *MockQueueRepository_Expecter) Update(ctx interface{}, msg interface{}) *MockQueueRepository_Update_Call {
+	return &MockQueueRepository_Update_Call{Call: _e.mock.On("Update", ctx, msg)}
+}
+
+func (_c *MockQueueRepository_Update_Call) Run(run func(ctx context.Context, msg *email.QueuedMessage)) *MockQueueRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*email.QueuedMessage))
+	})
+	return _c
+}
+
+func (_c *MockQueueRepository_Update_Call) Return(_a0 error) *MockQueueRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueueRepository_Update_Call) RunAndReturn(run func(context.Context, *email.QueuedMessage) error) *MockQueueRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQueueRepository creates a new instance of MockQueueRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQueueRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQueueRepository {
+	mock := &MockQueueRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
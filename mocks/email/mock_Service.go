@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	email "github.com/jonesrussell/mp-emailer/email"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockService is an autogenerated mock type for the Service type
 type MockService struct {
@@ -17,22 +20,88 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// Send provides a mock function with given fields: msg
+func (_m *MockService) Send(msg *email.Message) (string, error) {
+	ret := _m.Called(msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*email.Message) (string, error)); ok {
+		return rf(msg)
+	}
+	if rf, ok := ret.Get(0).(func(*email.Message) string); ok {
+		r0 = rf(msg)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*email.Message) error); ok {
+		r1 = rf(msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockService_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - msg *email.Message
+func (_e *MockService_Expecter) Send(msg interface{}) *MockService_Send_Call {
+	return &MockService_Send_Call{Call: _e.mock.On("Send", msg)}
+}
+
+func (_c *MockService_Send_Call) Run(run func(msg *email.Message)) *MockService_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*email.Message))
+	})
+	return _c
+}
+
+func (_c *MockService_Send_Call) Return(_a0 string, _a1 error) *MockService_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Send_Call) RunAndReturn(run func(*email.Message) (string, error)) *MockService_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendEmail provides a mock function with given fields: to, subject, body, isHTML
-func (_m *MockService) SendEmail(to string, subject string, body string, isHTML bool) error {
+func (_m *MockService) SendEmail(to string, subject string, body string, isHTML bool) (string, error) {
 	ret := _m.Called(to, subject, body, isHTML)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendEmail")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, bool) error); ok {
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) (string, error)); ok {
+		return rf(to, subject, body, isHTML)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) string); ok {
 		r0 = rf(to, subject, body, isHTML)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string, string, bool) error); ok {
+		r1 = rf(to, subject, body, isHTML)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockService_SendEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendEmail'
@@ -56,12 +125,12 @@ func (_c *MockService_SendEmail_Call) Run(run func(to string, subject string, bo
 	return _c
 }
 
-func (_c *MockService_SendEmail_Call) Return(_a0 error) *MockService_SendEmail_Call {
-	_c.Call.Return(_a0)
+func (_c *MockService_SendEmail_Call) Return(_a0 string, _a1 error) *MockService_SendEmail_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_SendEmail_Call) RunAndReturn(run func(string, string, string, bool) error) *MockService_SendEmail_Call {
+func (_c *MockService_SendEmail_Call) RunAndReturn(run func(string, string, string, bool) (string, error)) *MockService_SendEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
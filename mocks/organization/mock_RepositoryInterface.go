@@ -0,0 +1,593 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	organization "github.com/jonesrussell/mp-emailer/organization"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepositoryInterface is an autogenerated mock type for the RepositoryInterface type
+type MockRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepositoryInterface) EXPECT() *MockRepositoryInterface_Expecter {
+	return &MockRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddMember provides a mock function with given fields: ctx, member
+func (_m *MockRepositoryInterface) AddMember(ctx context.Context, member *organization.Member) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepositoryInterface_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type MockRepositoryInterface_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *organization.Member
+func (_e *MockRepositoryInterface_Expecter) AddMember(ctx interface{}, member interface{}) *MockRepositoryInterface_AddMember_Call {
+	return &MockRepositoryInterface_AddMember_Call{Call: _e.mock.On("AddMember", ctx, member)}
+}
+
+func (_c *MockRepositoryInterface_AddMember_Call) Run(run func(ctx context.Context, member *organization.Member)) *MockRepositoryInterface_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*organization.Member))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_AddMember_Call) Return(_a0 error) *MockRepositoryInterface_AddMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_AddMember_Call) RunAndReturn(run func(context.Context, *organization.Member) error) *MockRepositoryInterface_AddMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountAdmins provides a mock function with given fields: ctx, orgID
+func (_m *MockRepositoryInterface) CountAdmins(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAdmins")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_CountAdmins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAdmins'
+type MockRepositoryInterface_CountAdmins_Call struct {
+	*mock.Call
+}
+
+// CountAdmins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) CountAdmins(ctx interface{}, orgID interface{}) *MockRepositoryInterface_CountAdmins_Call {
+	return &MockRepositoryInterface_CountAdmins_Call{Call: _e.mock.On("CountAdmins", ctx, orgID)}
+}
+
+func (_c *MockRepositoryInterface_CountAdmins_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *MockRepositoryInterface_CountAdmins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_CountAdmins_Call) Return(_a0 int64, _a1 error) *MockRepositoryInterface_CountAdmins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_CountAdmins_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *MockRepositoryInterface_CountAdmins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithAdmin provides a mock function with given fields: ctx, org, adminID
+func (_m *MockRepositoryInterface) CreateWithAdmin(ctx context.Context, org *organization.Organization, adminID uuid.UUID) error {
+	ret := _m.Called(ctx, org, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.Organization, uuid.UUID) error); ok {
+		r0 = rf(ctx, org, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepositoryInterface_CreateWithAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithAdmin'
+type MockRepositoryInterface_CreateWithAdmin_Call struct {
+	*mock.Call
+}
+
+// CreateWithAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - org *organization.Organization
+//   - adminID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) CreateWithAdmin(ctx interface{}, org interface{}, adminID interface{}) *MockRepositoryInterface_CreateWithAdmin_Call {
+	return &MockRepositoryInterface_CreateWithAdmin_Call{Call: _e.mock.On("CreateWithAdmin", ctx, org, adminID)}
+}
+
+func (_c *MockRepositoryInterface_CreateWithAdmin_Call) Run(run func(ctx context.Context, org *organization.Organization, adminID uuid.UUID)) *MockRepositoryInterface_CreateWithAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*organization.Organization), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_CreateWithAdmin_Call) Return(_a0 error) *MockRepositoryInterface_CreateWithAdmin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_CreateWithAdmin_Call) RunAndReturn(run func(context.Context, *organization.Organization, uuid.UUID) error) *MockRepositoryInterface_CreateWithAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*organization.Organization, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *organization.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*organization.Organization, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Organization); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockRepositoryInterface_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) GetByID(ctx interface{}, id interface{}) *MockRepositoryInterface_GetByID_Call {
+	return &MockRepositoryInterface_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockRepositoryInterface_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepositoryInterface_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetByID_Call) Return(_a0 *organization.Organization, _a1 error) *MockRepositoryInterface_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*organization.Organization, error)) *MockRepositoryInterface_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBySlug provides a mock function with given fields: ctx, slug
+func (_m *MockRepositoryInterface) GetBySlug(ctx context.Context, slug string) (*organization.Organization, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 *organization.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*organization.Organization, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *organization.Organization); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySlug'
+type MockRepositoryInterface_GetBySlug_Call struct {
+	*mock.Call
+}
+
+// GetBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockRepositoryInterface_Expecter) GetBySlug(ctx interface{}, slug interface{}) *MockRepositoryInterface_GetBySlug_Call {
+	return &MockRepositoryInterface_GetBySlug_Call{Call: _e.mock.On("GetBySlug", ctx, slug)}
+}
+
+func (_c *MockRepositoryInterface_GetBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockRepositoryInterface_GetBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetBySlug_Call) Return(_a0 *organization.Organization, _a1 error) *MockRepositoryInterface_GetBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetBySlug_Call) RunAndReturn(run func(context.Context, string) (*organization.Organization, error)) *MockRepositoryInterface_GetBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMember provides a mock function with given fields: ctx, orgID, userID
+func (_m *MockRepositoryInterface) GetMember(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (*organization.Member, error) {
+	ret := _m.Called(ctx, orgID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMember")
+	}
+
+	var r0 *organization.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*organization.Member, error)); ok {
+		return rf(ctx, orgID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *organization.Member); ok {
+		r0 = rf(ctx, orgID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMember'
+type MockRepositoryInterface_GetMember_Call struct {
+	*mock.Call
+}
+
+// GetMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) GetMember(ctx interface{}, orgID interface{}, userID interface{}) *MockRepositoryInterface_GetMember_Call {
+	return &MockRepositoryInterface_GetMember_Call{Call: _e.mock.On("GetMember", ctx, orgID, userID)}
+}
+
+func (_c *MockRepositoryInterface_GetMember_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID)) *MockRepositoryInterface_GetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetMember_Call) Return(_a0 *organization.Member, _a1 error) *MockRepositoryInterface_GetMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetMember_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*organization.Member, error)) *MockRepositoryInterface_GetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberByID provides a mock function with given fields: ctx, id
+func (_m *MockRepositoryInterface) GetMemberByID(ctx context.Context, id uuid.UUID) (*organization.Member, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberByID")
+	}
+
+	var r0 *organization.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*organization.Member, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Member); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_GetMemberByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberByID'
+type MockRepositoryInterface_GetMemberByID_Call struct {
+	*mock.Call
+}
+
+// GetMemberByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) GetMemberByID(ctx interface{}, id interface{}) *MockRepositoryInterface_GetMemberByID_Call {
+	return &MockRepositoryInterface_GetMemberByID_Call{Call: _e.mock.On("GetMemberByID", ctx, id)}
+}
+
+func (_c *MockRepositoryInterface_GetMemberByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepositoryInterface_GetMemberByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetMemberByID_Call) Return(_a0 *organization.Member, _a1 error) *MockRepositoryInterface_GetMemberByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_GetMemberByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*organization.Member, error)) *MockRepositoryInterface_GetMemberByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockRepositoryInterface) ListByUser(ctx context.Context, userID uuid.UUID) ([]organization.Organization, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []organization.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]organization.Organization, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []organization.Organization); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]organization.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type MockRepositoryInterface_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) ListByUser(ctx interface{}, userID interface{}) *MockRepositoryInterface_ListByUser_Call {
+	return &MockRepositoryInterface_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userID)}
+}
+
+func (_c *MockRepositoryInterface_ListByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRepositoryInterface_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_ListByUser_Call) Return(_a0 []organization.Organization, _a1 error) *MockRepositoryInterface_ListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_ListByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]organization.Organization, error)) *MockRepositoryInterface_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, orgID
+func (_m *MockRepositoryInterface) ListMembers(ctx context.Context, orgID uuid.UUID) ([]organization.Member, error) {
+	ret := _m.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 []organization.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]organization.Member, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []organization.Member); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]organization.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryInterface_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type MockRepositoryInterface_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *MockRepositoryInterface_Expecter) ListMembers(ctx interface{}, orgID interface{}) *MockRepositoryInterface_ListMembers_Call {
+	return &MockRepositoryInterface_ListMembers_Call{Call: _e.mock.On("ListMembers", ctx, orgID)}
+}
+
+func (_c *MockRepositoryInterface_ListMembers_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *MockRepositoryInterface_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_ListMembers_Call) Return(_a0 []organization.Member, _a1 error) *MockRepositoryInterface_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_ListMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]organization.Member, error)) *MockRepositoryInterface_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, member
+func (_m *MockRepositoryInterface) RemoveMember(ctx context.Context, member *organization.Member) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepositoryInterface_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockRepositoryInterface_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *organization.Member
+func (_e *MockRepositoryInterface_Expecter) RemoveMember(ctx interface{}, member interface{}) *MockRepositoryInterface_RemoveMember_Call {
+	return &MockRepositoryInterface_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, member)}
+}
+
+func (_c *MockRepositoryInterface_RemoveMember_Call) Run(run func(ctx context.Context, member *organization.Member)) *MockRepositoryInterface_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*organization.Member))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryInterface_RemoveMember_Call) Return(_a0 error) *MockRepositoryInterface_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepositoryInterface_RemoveMember_Call) RunAndReturn(run func(context.Context, *organization.Member) error) *MockRepositoryInterface_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepositoryInterface creates a new instance of MockRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepositoryInterface {
+	mock := &MockRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,439 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	organization "github.com/jonesrussell/mp-emailer/organization"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockServiceInterface is an autogenerated mock type for the ServiceInterface type
+type MockServiceInterface struct {
+	mock.Mock
+}
+
+type MockServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockServiceInterface) EXPECT() *MockServiceInterface_Expecter {
+	return &MockServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddMember provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) AddMember(ctx context.Context, dto *organization.AddMemberDTO) (*organization.Member, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 *organization.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.AddMemberDTO) (*organization.Member, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.AddMemberDTO) *organization.Member); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organization.AddMemberDTO) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type MockServiceInterface_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto *organization.AddMemberDTO
+func (_e *MockServiceInterface_Expecter) AddMember(ctx interface{}, dto interface{}) *MockServiceInterface_AddMember_Call {
+	return &MockServiceInterface_AddMember_Call{Call: _e.mock.On("AddMember", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_AddMember_Call) Run(run func(ctx context.Context, dto *organization.AddMemberDTO)) *MockServiceInterface_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*organization.AddMemberDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_AddMember_Call) Return(_a0 *organization.Member, _a1 error) *MockServiceInterface_AddMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_AddMember_Call) RunAndReturn(run func(context.Context, *organization.AddMemberDTO) (*organization.Member, error)) *MockServiceInterface_AddMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) CreateOrganization(ctx context.Context, dto *organization.CreateDTO) (*organization.Organization, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *organization.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.CreateDTO) (*organization.Organization, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.CreateDTO) *organization.Organization); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organization.CreateDTO) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockServiceInterface_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto *organization.CreateDTO
+func (_e *MockServiceInterface_Expecter) CreateOrganization(ctx interface{}, dto interface{}) *MockServiceInterface_CreateOrganization_Call {
+	return &MockServiceInterface_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_CreateOrganization_Call) Run(run func(ctx context.Context, dto *organization.CreateDTO)) *MockServiceInterface_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*organization.CreateDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_CreateOrganization_Call) Return(_a0 *organization.Organization, _a1 error) *MockServiceInterface_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_CreateOrganization_Call) RunAndReturn(run func(context.Context, *organization.CreateDTO) (*organization.Organization, error)) *MockServiceInterface_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganization provides a mock function with given fields: ctx, id
+func (_m *MockServiceInterface) GetOrganization(ctx context.Context, id uuid.UUID) (*organization.Organization, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganization")
+	}
+
+	var r0 *organization.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*organization.Organization, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Organization); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type MockServiceInterface_GetOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockServiceInterface_Expecter) GetOrganization(ctx interface{}, id interface{}) *MockServiceInterface_GetOrganization_Call {
+	return &MockServiceInterface_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, id)}
+}
+
+func (_c *MockServiceInterface_GetOrganization_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockServiceInterface_GetOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_GetOrganization_Call) Return(_a0 *organization.Organization, _a1 error) *MockServiceInterface_GetOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_GetOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*organization.Organization, error)) *MockServiceInterface_GetOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListForUser provides a mock function with given fields: ctx, userID
+func (_m *MockServiceInterface) ListForUser(ctx context.Context, userID uuid.UUID) ([]organization.Organization, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListForUser")
+	}
+
+	var r0 []organization.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]organization.Organization, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []organization.Organization); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]organization.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ListForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListForUser'
+type MockServiceInterface_ListForUser_Call struct {
+	*mock.Call
+}
+
+// ListForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockServiceInterface_Expecter) ListForUser(ctx interface{}, userID interface{}) *MockServiceInterface_ListForUser_Call {
+	return &MockServiceInterface_ListForUser_Call{Call: _e.mock.On("ListForUser", ctx, userID)}
+}
+
+func (_c *MockServiceInterface_ListForUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockServiceInterface_ListForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ListForUser_Call) Return(_a0 []organization.Organization, _a1 error) *MockServiceInterface_ListForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ListForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]organization.Organization, error)) *MockServiceInterface_ListForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, orgID
+func (_m *MockServiceInterface) ListMembers(ctx context.Context, orgID uuid.UUID) ([]organization.Member, error) {
+	ret := _m.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 []organization.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]organization.Member, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []organization.Member); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]organization.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type MockServiceInterface_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *MockServiceInterface_Expecter) ListMembers(ctx interface{}, orgID interface{}) *MockServiceInterface_ListMembers_Call {
+	return &MockServiceInterface_ListMembers_Call{Call: _e.mock.On("ListMembers", ctx, orgID)}
+}
+
+func (_c *MockServiceInterface_ListMembers_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *MockServiceInterface_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_ListMembers_Call) Return(_a0 []organization.Member, _a1 error) *MockServiceInterface_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_ListMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]organization.Member, error)) *MockServiceInterface_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MemberRole provides a mock function with given fields: ctx, orgID, userID
+func (_m *MockServiceInterface) MemberRole(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (organization.Role, error) {
+	ret := _m.Called(ctx, orgID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MemberRole")
+	}
+
+	var r0 organization.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (organization.Role, error)); ok {
+		return rf(ctx, orgID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) organization.Role); ok {
+		r0 = rf(ctx, orgID, userID)
+	} else {
+		r0 = ret.Get(0).(organization.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceInterface_MemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MemberRole'
+type MockServiceInterface_MemberRole_Call struct {
+	*mock.Call
+}
+
+// MemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockServiceInterface_Expecter) MemberRole(ctx interface{}, orgID interface{}, userID interface{}) *MockServiceInterface_MemberRole_Call {
+	return &MockServiceInterface_MemberRole_Call{Call: _e.mock.On("MemberRole", ctx, orgID, userID)}
+}
+
+func (_c *MockServiceInterface_MemberRole_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID)) *MockServiceInterface_MemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_MemberRole_Call) Return(_a0 organization.Role, _a1 error) *MockServiceInterface_MemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceInterface_MemberRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (organization.Role, error)) *MockServiceInterface_MemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, dto
+func (_m *MockServiceInterface) RemoveMember(ctx context.Context, dto organization.RemoveMemberDTO) error {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, organization.RemoveMemberDTO) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceInterface_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockServiceInterface_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto organization.RemoveMemberDTO
+func (_e *MockServiceInterface_Expecter) RemoveMember(ctx interface{}, dto interface{}) *MockServiceInterface_RemoveMember_Call {
+	return &MockServiceInterface_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, dto)}
+}
+
+func (_c *MockServiceInterface_RemoveMember_Call) Run(run func(ctx context.Context, dto organization.RemoveMemberDTO)) *MockServiceInterface_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(organization.RemoveMemberDTO))
+	})
+	return _c
+}
+
+func (_c *MockServiceInterface_RemoveMember_Call) Return(_a0 error) *MockServiceInterface_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceInterface_RemoveMember_Call) RunAndReturn(run func(context.Context, organization.RemoveMemberDTO) error) *MockServiceInterface_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockServiceInterface creates a new instance of MockServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockServiceInterface {
+	mock := &MockServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
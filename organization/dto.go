@@ -0,0 +1,22 @@
+package organization
+
+import "github.com/google/uuid"
+
+// CreateDTO represents the data structure for creating an organization
+type CreateDTO struct {
+	Name        string    `validate:"required,min=3,max=255"`
+	CreatedByID uuid.UUID `validate:"required"`
+}
+
+// AddMemberDTO represents the data structure for adding a registered user to an organization
+type AddMemberDTO struct {
+	OrganizationID uuid.UUID `validate:"required"`
+	Email          string    `validate:"required,email"`
+	Role           Role      `validate:"required,oneof=admin editor viewer"`
+}
+
+// RemoveMemberDTO represents the data structure for removing a member
+type RemoveMemberDTO struct {
+	OrganizationID uuid.UUID `validate:"required"`
+	MemberID       uuid.UUID `validate:"required"`
+}
@@ -0,0 +1,43 @@
+package organization
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Standard organization errors
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrInvalidOrganization  = errors.New("invalid organization ID")
+	ErrMemberNotFound       = errors.New("organization member not found")
+	ErrMemberExists         = errors.New("already a member of this organization")
+	ErrUserNotFound         = errors.New("no user is registered with that email")
+	ErrLastAdmin            = errors.New("an organization needs at least one admin")
+	ErrUnauthorizedAccess   = errors.New("unauthorized access")
+)
+
+// mapErrorToHTTPStatus maps domain errors to HTTP status codes and messages
+func mapErrorToHTTPStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, ErrOrganizationNotFound):
+		return http.StatusNotFound, "Organization not found"
+	case errors.Is(err, ErrInvalidOrganization):
+		return http.StatusBadRequest, "Invalid organization ID"
+	case errors.Is(err, ErrMemberNotFound):
+		return http.StatusNotFound, "Member not found"
+	case errors.Is(err, ErrMemberExists):
+		return http.StatusConflict, "That user is already a member"
+	case errors.Is(err, ErrUserNotFound):
+		return http.StatusNotFound, "No user is registered with that email"
+	case errors.Is(err, ErrLastAdmin):
+		return http.StatusConflict, "An organization needs at least one admin"
+	case errors.Is(err, ErrUnauthorizedAccess):
+		return http.StatusUnauthorized, "Unauthorized access"
+	case errors.Is(err, validator.ValidationErrors{}):
+		return http.StatusBadRequest, "Invalid input"
+	default:
+		return http.StatusInternalServerError, "Internal server error"
+	}
+}
@@ -0,0 +1,249 @@
+package organization
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/fx"
+)
+
+// Handler serves the organization management pages
+type Handler struct {
+	shared.BaseHandler
+	service ServiceInterface
+}
+
+// HandlerParams for dependency injection
+type HandlerParams struct {
+	shared.BaseHandlerParams
+	fx.In
+	Service ServiceInterface
+}
+
+// NewHandler initializes a new Handler
+func NewHandler(params HandlerParams) *Handler {
+	base := shared.NewBaseHandler(params.BaseHandlerParams)
+	base.MapError = mapErrorToHTTPStatus
+
+	return &Handler{
+		BaseHandler: base,
+		service:     params.Service,
+	}
+}
+
+// OrganizationsGET lists the signed-in user's organizations
+func (h *Handler) OrganizationsGET(c echo.Context) error {
+	h.Logger.Debug("Handling OrganizationsGET request")
+
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	return h.renderOrganizations(c, http.StatusOK, userID, nil)
+}
+
+// CreateOrganization handles POST requests for creating an organization
+func (h *Handler) CreateOrganization(c echo.Context) error {
+	h.Logger.Debug("Handling CreateOrganization request")
+
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	org, err := h.service.CreateOrganization(c.Request().Context(), &CreateDTO{
+		Name:        c.FormValue("name"),
+		CreatedByID: userID,
+	})
+	if err != nil {
+		status, msg := h.MapError(err)
+		if status >= http.StatusInternalServerError {
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		if status == http.StatusBadRequest {
+			msg = "Organization names must be between 3 and 255 characters"
+		}
+		return h.renderOrganizations(c, status, userID, []string{msg})
+	}
+
+	if err := h.AddFlashMessage(c, "Organization created"); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/organizations/"+org.ID.String())
+}
+
+// MembersGET handles GET requests for an organization's members page
+func (h *Handler) MembersGET(c echo.Context) error {
+	h.Logger.Debug("Handling organization MembersGET request")
+
+	org, role, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+
+	return h.renderMembers(c, http.StatusOK, org, role, nil)
+}
+
+// AddMember handles POST requests for adding a member by email
+func (h *Handler) AddMember(c echo.Context) error {
+	h.Logger.Debug("Handling organization AddMember request")
+
+	org, role, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+	if role != RoleAdmin {
+		status, msg := h.MapError(ErrUnauthorizedAccess)
+		return h.ErrorHandler.HandleHTTPError(c, ErrUnauthorizedAccess, msg, status)
+	}
+
+	dto := &AddMemberDTO{
+		OrganizationID: org.ID,
+		Email:          strings.TrimSpace(c.FormValue("email")),
+		Role:           Role(c.FormValue("role")),
+	}
+
+	if _, err := h.service.AddMember(c.Request().Context(), dto); err != nil {
+		status, msg := h.MapError(err)
+		if status >= http.StatusInternalServerError {
+			return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+		}
+		if status == http.StatusBadRequest {
+			msg = "Enter a valid email address and role"
+		}
+		return h.renderMembers(c, status, org, role, []string{msg})
+	}
+
+	if err := h.AddFlashMessage(c, dto.Email+" added to "+org.Name); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/organizations/"+org.ID.String()+"/members")
+}
+
+// RemoveMember handles DELETE requests for removing a member
+func (h *Handler) RemoveMember(c echo.Context) error {
+	h.Logger.Debug("Handling organization RemoveMember request")
+
+	org, role, err := h.fetchForMember(c)
+	if err != nil {
+		return err
+	}
+	if role != RoleAdmin {
+		status, msg := h.MapError(ErrUnauthorizedAccess)
+		return h.ErrorHandler.HandleHTTPError(c, ErrUnauthorizedAccess, msg, status)
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberID"))
+	if err != nil {
+		status, msg := h.MapError(ErrMemberNotFound)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	err = h.service.RemoveMember(c.Request().Context(), RemoveMemberDTO{
+		OrganizationID: org.ID,
+		MemberID:       memberID,
+	})
+	if errors.Is(err, ErrLastAdmin) {
+		_, msg := h.MapError(err)
+		return h.renderMembers(c, http.StatusConflict, org, role, []string{msg})
+	}
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	if err := h.AddFlashMessage(c, "Member removed"); err != nil {
+		h.Logger.Error("Failed to add flash message", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/organizations/"+org.ID.String()+"/members")
+}
+
+// currentUserID returns the signed-in user's ID
+func (h *Handler) currentUserID(c echo.Context) (uuid.UUID, error) {
+	userID, err := h.GetUserIDFromSession(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(userID)
+}
+
+// fetchForMember loads the organization named in the URL and the signed-in
+// user's role in it. Non-members get a 404 so organizations can't be probed.
+// On failure the returned error is the rendered HTTP error.
+func (h *Handler) fetchForMember(c echo.Context) (*Organization, Role, error) {
+	userID, err := h.currentUserID(c)
+	if err != nil {
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, "Unauthorized", http.StatusUnauthorized)
+	}
+
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		status, msg := h.MapError(ErrInvalidOrganization)
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	ctx := c.Request().Context()
+	role, err := h.service.MemberRole(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			err = ErrOrganizationNotFound
+		}
+		status, msg := h.MapError(err)
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	org, err := h.service.GetOrganization(ctx, orgID)
+	if err != nil {
+		status, msg := h.MapError(err)
+		return nil, "", h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return org, role, nil
+}
+
+func (h *Handler) renderOrganizations(c echo.Context, code int, userID uuid.UUID, errs []string) error {
+	orgs, err := h.service.ListForUser(c.Request().Context(), userID)
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return c.Render(code, "organizations", shared.Data{
+		Title:           "Organizations",
+		PageName:        "organizations",
+		IsAuthenticated: true,
+		Content: map[string]interface{}{
+			"Organizations": orgs,
+			"Errors":        errs,
+		},
+	})
+}
+
+func (h *Handler) renderMembers(c echo.Context, code int, org *Organization, role Role, errs []string) error {
+	members, err := h.service.ListMembers(c.Request().Context(), org.ID)
+	if err != nil {
+		status, msg := h.MapError(err)
+		return h.ErrorHandler.HandleHTTPError(c, err, msg, status)
+	}
+
+	return c.Render(code, "organization_members", shared.Data{
+		Title:           "Organization Members",
+		PageName:        "organization_members",
+		IsAuthenticated: true,
+		Content: map[string]interface{}{
+			"Organization": org,
+			"Members":      members,
+			"IsAdmin":      role == RoleAdmin,
+			"Roles":        Roles,
+			"Errors":       errs,
+		},
+	})
+}
@@ -0,0 +1,63 @@
+// Package organization lets groups of users own and run campaigns together.
+package organization
+
+import (
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/shared"
+	"github.com/jonesrussell/mp-emailer/user"
+	"gorm.io/gorm"
+)
+
+// Role is a member's access within an organization
+type Role string
+
+const (
+	// RoleAdmin manages the organization, its members and all of its campaigns
+	RoleAdmin Role = "admin"
+	// RoleEditor creates and edits the organization's campaigns
+	RoleEditor Role = "editor"
+	// RoleViewer sees the organization's campaigns and dashboard
+	RoleViewer Role = "viewer"
+)
+
+// Roles lists every organization role, most privileged first
+//
+//nolint:gochecknoglobals
+var Roles = []Role{RoleAdmin, RoleEditor, RoleViewer}
+
+// Organization is a group of users that owns campaigns
+type Organization struct {
+	shared.BaseModel
+	Name string `gorm:"type:varchar(255);not null" json:"name"`
+	Slug string `gorm:"type:varchar(100);uniqueIndex;not null" json:"slug"`
+}
+
+// BeforeCreate assigns an ID so the record can be referenced after insert
+func (o *Organization) BeforeCreate(_ *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	return nil
+}
+
+// Member links a user to an organization with a role
+type Member struct {
+	shared.BaseModel
+	OrganizationID uuid.UUID `gorm:"type:char(36);not null;index" json:"organization_id"`
+	UserID         uuid.UUID `gorm:"type:char(36);not null;index" json:"user_id"`
+	User           user.User `gorm:"foreignKey:UserID" json:"-"`
+	Role           Role      `gorm:"type:varchar(20);not null" json:"role"`
+}
+
+// TableName overrides the default table name
+func (Member) TableName() string {
+	return "organization_members"
+}
+
+// BeforeCreate assigns an ID so the record can be referenced after insert
+func (m *Member) BeforeCreate(_ *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package organization
+
+import (
+	"github.com/jonesrussell/mp-emailer/logger"
+	"go.uber.org/fx"
+)
+
+// Module defines the organization module
+//
+//nolint:gochecknoglobals
+var Module = fx.Options(
+	fx.Provide(
+		NewRepository,
+		fx.Annotate(
+			NewService,
+			fx.As(new(ServiceInterface)),
+		),
+		NewHandler,
+	),
+	fx.Decorate(
+		func(base ServiceInterface, log logger.Interface) ServiceInterface {
+			return NewLoggingDecorator(base, log)
+		},
+	),
+)
@@ -0,0 +1,161 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/database"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+// RepositoryInterface defines the contract for organization persistence
+type RepositoryInterface interface {
+	CreateWithAdmin(ctx context.Context, org *Organization, adminID uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Organization, error)
+	GetBySlug(ctx context.Context, slug string) (*Organization, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+	AddMember(ctx context.Context, member *Member) error
+	RemoveMember(ctx context.Context, member *Member) error
+	GetMember(ctx context.Context, orgID, userID uuid.UUID) (*Member, error)
+	GetMemberByID(ctx context.Context, id uuid.UUID) (*Member, error)
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]Member, error)
+	CountAdmins(ctx context.Context, orgID uuid.UUID) (int64, error)
+}
+
+// RepositoryParams for dependency injection
+type RepositoryParams struct {
+	fx.In
+	DB database.Database
+}
+
+// Repository implements RepositoryInterface
+type Repository struct {
+	db database.Database
+}
+
+// NewRepository creates a new instance of Repository
+func NewRepository(params RepositoryParams) RepositoryInterface {
+	return &Repository{db: params.DB}
+}
+
+// CreateWithAdmin creates an organization and makes adminID its first admin
+func (r *Repository) CreateWithAdmin(ctx context.Context, org *Organization, adminID uuid.UUID) error {
+	err := r.db.Transaction(ctx, func(tx database.Database) error {
+		if err := tx.Create(ctx, org); err != nil {
+			return err
+		}
+		return tx.Create(ctx, &Member{
+			OrganizationID: org.ID,
+			UserID:         adminID,
+			Role:           RoleAdmin,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error creating organization: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves an organization by its ID
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+// GetBySlug retrieves an organization by its slug
+func (r *Repository) GetBySlug(ctx context.Context, slug string) (*Organization, error) {
+	return r.findOne(ctx, "slug = ?", slug)
+}
+
+// ListByUser retrieves the organizations a user belongs to
+func (r *Repository) ListByUser(ctx context.Context, userID uuid.UUID) ([]Organization, error) {
+	var orgs []Organization
+	err := r.db.FindAll(ctx, &orgs,
+		"id IN (SELECT organization_id FROM organization_members WHERE user_id = ? AND deleted_at IS NULL)",
+		userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error listing organizations: %w", err)
+	}
+	if len(orgs) == 0 {
+		return []Organization{}, nil
+	}
+	return orgs, nil
+}
+
+// AddMember records a new membership
+func (r *Repository) AddMember(ctx context.Context, member *Member) error {
+	if err := r.db.Create(ctx, member); err != nil {
+		return fmt.Errorf("error adding organization member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember deletes a membership
+func (r *Repository) RemoveMember(ctx context.Context, member *Member) error {
+	if err := r.db.Delete(ctx, member); err != nil {
+		return fmt.Errorf("error removing organization member: %w", err)
+	}
+	return nil
+}
+
+// GetMember retrieves a user's membership in an organization
+func (r *Repository) GetMember(ctx context.Context, orgID, userID uuid.UUID) (*Member, error) {
+	return r.findMember(ctx, "organization_id = ? AND user_id = ?", orgID, userID)
+}
+
+// GetMemberByID retrieves a membership by its ID
+func (r *Repository) GetMemberByID(ctx context.Context, id uuid.UUID) (*Member, error) {
+	return r.findMember(ctx, "id = ?", id)
+}
+
+// ListMembers retrieves an organization's members along with their user accounts
+func (r *Repository) ListMembers(ctx context.Context, orgID uuid.UUID) ([]Member, error) {
+	var members []Member
+	err := r.db.DB().WithContext(ctx).
+		Preload("User").
+		Where("organization_id = ?", orgID).
+		Order("created_at").
+		Find(&members).Error
+	if err != nil {
+		return nil, fmt.Errorf("error listing organization members: %w", err)
+	}
+	return members, nil
+}
+
+// CountAdmins returns the number of admins an organization has
+func (r *Repository) CountAdmins(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.DB().WithContext(ctx).
+		Model(&Member{}).
+		Where("organization_id = ? AND role = ?", orgID, RoleAdmin).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("error counting organization admins: %w", err)
+	}
+	return count, nil
+}
+
+func (r *Repository) findOne(ctx context.Context, query string, args ...interface{}) (*Organization, error) {
+	var org Organization
+	if err := r.db.FindOne(ctx, &org, query, args...); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("error retrieving organization: %w", err)
+	}
+	return &org, nil
+}
+
+func (r *Repository) findMember(ctx context.Context, query string, args ...interface{}) (*Member, error) {
+	var member Member
+	if err := r.db.FindOne(ctx, &member, query, args...); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("error retrieving organization member: %w", err)
+	}
+	return &member, nil
+}
@@ -0,0 +1,28 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/jonesrussell/mp-emailer/session"
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers the organization routes. The dashboard at
+// /organizations/:id is served by the campaign package.
+func RegisterRoutes(h *Handler, e *echo.Echo, sessionManager session.Manager) {
+	protected := e.Group("/organizations")
+	protected.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := sessionManager.ValidateSession(c); err != nil {
+				return c.Redirect(http.StatusSeeOther, "/user/login")
+			}
+			return next(c)
+		}
+	})
+
+	protected.GET("", h.OrganizationsGET)
+	protected.POST("", h.CreateOrganization)
+	protected.GET("/:id/members", h.MembersGET)
+	protected.POST("/:id/members", h.AddMember)
+	protected.DELETE("/:id/members/:memberID", h.RemoveMember)
+}
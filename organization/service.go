@@ -0,0 +1,227 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/logger"
+	"github.com/jonesrussell/mp-emailer/user"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+// maxSlugAttempts bounds the numeric suffixes tried when a slug is taken
+const maxSlugAttempts = 100
+
+// ServiceParams for dependency injection
+type ServiceParams struct {
+	fx.In
+	Repo     RepositoryInterface
+	UserRepo user.RepositoryInterface
+	Validate *validator.Validate
+	Logger   logger.Interface
+}
+
+// ServiceInterface defines the methods of the organization service
+type ServiceInterface interface {
+	CreateOrganization(ctx context.Context, dto *CreateDTO) (*Organization, error)
+	GetOrganization(ctx context.Context, id uuid.UUID) (*Organization, error)
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+	MemberRole(ctx context.Context, orgID, userID uuid.UUID) (Role, error)
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]Member, error)
+	AddMember(ctx context.Context, dto *AddMemberDTO) (*Member, error)
+	RemoveMember(ctx context.Context, dto RemoveMemberDTO) error
+}
+
+// Service implements the organization service
+type Service struct {
+	repo     RepositoryInterface
+	userRepo user.RepositoryInterface
+	validate *validator.Validate
+	Logger   logger.Interface
+}
+
+// Ensure Service implements ServiceInterface
+var _ ServiceInterface = &Service{}
+
+// NewService creates a new organization service
+func NewService(params ServiceParams) ServiceInterface {
+	return &Service{
+		repo:     params.Repo,
+		userRepo: params.UserRepo,
+		validate: params.Validate,
+		Logger:   params.Logger,
+	}
+}
+
+// CreateOrganization creates an organization with its creator as the first admin
+func (s *Service) CreateOrganization(ctx context.Context, dto *CreateDTO) (*Organization, error) {
+	if dto == nil {
+		return nil, fmt.Errorf("organization data is required")
+	}
+
+	dto.Name = strings.TrimSpace(dto.Name)
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	slug, err := s.uniqueSlug(ctx, slugify(dto.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	org := &Organization{Name: dto.Name, Slug: slug}
+	if err := s.repo.CreateWithAdmin(ctx, org, dto.CreatedByID); err != nil {
+		s.Logger.Error("Failed to create organization", err)
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	s.Logger.Info("Organization created", "id", org.ID, "slug", org.Slug)
+	return org, nil
+}
+
+// GetOrganization retrieves an organization by its ID
+func (s *Service) GetOrganization(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListForUser retrieves the organizations a user belongs to
+func (s *Service) ListForUser(ctx context.Context, userID uuid.UUID) ([]Organization, error) {
+	orgs, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	return orgs, nil
+}
+
+// MemberRole returns a user's role in an organization. It returns
+// ErrMemberNotFound when the user does not belong to it.
+func (s *Service) MemberRole(ctx context.Context, orgID, userID uuid.UUID) (Role, error) {
+	member, err := s.repo.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// ListMembers retrieves an organization's members
+func (s *Service) ListMembers(ctx context.Context, orgID uuid.UUID) ([]Member, error) {
+	members, err := s.repo.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	return members, nil
+}
+
+// AddMember adds a registered user, found by email, to an organization
+func (s *Service) AddMember(ctx context.Context, dto *AddMemberDTO) (*Member, error) {
+	if dto == nil {
+		return nil, fmt.Errorf("member data is required")
+	}
+
+	dto.Email = strings.TrimSpace(dto.Email)
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	u, err := s.userRepo.FindByEmail(ctx, dto.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if _, err := s.repo.GetMember(ctx, dto.OrganizationID, u.ID); err == nil {
+		return nil, ErrMemberExists
+	} else if !errors.Is(err, ErrMemberNotFound) {
+		return nil, err
+	}
+
+	member := &Member{
+		OrganizationID: dto.OrganizationID,
+		UserID:         u.ID,
+		Role:           dto.Role,
+	}
+	if err := s.repo.AddMember(ctx, member); err != nil {
+		s.Logger.Error("Failed to add organization member", err, "organizationID", dto.OrganizationID)
+		return nil, fmt.Errorf("failed to add member: %w", err)
+	}
+
+	s.Logger.Info("Organization member added", "organizationID", dto.OrganizationID, "memberID", member.ID, "role", member.Role)
+	return member, nil
+}
+
+// RemoveMember removes a member from an organization. The last admin cannot
+// be removed, so every organization stays manageable.
+func (s *Service) RemoveMember(ctx context.Context, dto RemoveMemberDTO) error {
+	if err := s.validate.Struct(dto); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+
+	member, err := s.repo.GetMemberByID(ctx, dto.MemberID)
+	if err != nil {
+		return err
+	}
+	if member.OrganizationID != dto.OrganizationID {
+		return ErrMemberNotFound
+	}
+
+	if member.Role == RoleAdmin {
+		admins, err := s.repo.CountAdmins(ctx, member.OrganizationID)
+		if err != nil {
+			return fmt.Errorf("failed to count admins: %w", err)
+		}
+		if admins <= 1 {
+			return ErrLastAdmin
+		}
+	}
+
+	if err := s.repo.RemoveMember(ctx, member); err != nil {
+		s.Logger.Error("Failed to remove organization member", err, "memberID", member.ID)
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	s.Logger.Info("Organization member removed", "organizationID", member.OrganizationID, "memberID", member.ID)
+	return nil
+}
+
+// uniqueSlug returns base, or base with the first free numeric suffix
+func (s *Service) uniqueSlug(ctx context.Context, base string) (string, error) {
+	for i := 1; i <= maxSlugAttempts; i++ {
+		slug := base
+		if i > 1 {
+			slug = base + "-" + strconv.Itoa(i)
+		}
+
+		_, err := s.repo.GetBySlug(ctx, slug)
+		if errors.Is(err, ErrOrganizationNotFound) {
+			return slug, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to check organization slug: %w", err)
+		}
+	}
+	return base + "-" + uuid.NewString()[:8], nil
+}
+
+// nonSlugChars matches runs of characters that may not appear in a slug
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns an organization name into a URL-friendly identifier
+func slugify(name string) string {
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(slug) > 80 {
+		slug = strings.TrimRight(slug[:80], "-")
+	}
+	if slug == "" {
+		return "organization"
+	}
+	return slug
+}
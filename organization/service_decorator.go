@@ -0,0 +1,88 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jonesrussell/mp-emailer/logger"
+)
+
+// LoggingDecorator adds logging to an organization service
+type LoggingDecorator struct {
+	service ServiceInterface
+	Logger  logger.Interface
+}
+
+// NewLoggingDecorator creates a new instance of LoggingDecorator
+func NewLoggingDecorator(service ServiceInterface, logger logger.Interface) ServiceInterface {
+	return &LoggingDecorator{
+		service: service,
+		Logger:  logger,
+	}
+}
+
+// CreateOrganization logs organization creation
+func (d *LoggingDecorator) CreateOrganization(ctx context.Context, dto *CreateDTO) (*Organization, error) {
+	d.Logger.Info("Creating organization", "createdBy", dto.CreatedByID)
+	org, err := d.service.CreateOrganization(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to create organization", err, "createdBy", dto.CreatedByID)
+	}
+	return org, err
+}
+
+// GetOrganization logs organization retrieval
+func (d *LoggingDecorator) GetOrganization(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	d.Logger.Debug("Getting organization", "id", id)
+	org, err := d.service.GetOrganization(ctx, id)
+	if err != nil {
+		d.Logger.Error("Failed to get organization", err, "id", id)
+	}
+	return org, err
+}
+
+// ListForUser logs listing a user's organizations
+func (d *LoggingDecorator) ListForUser(ctx context.Context, userID uuid.UUID) ([]Organization, error) {
+	d.Logger.Debug("Listing organizations for user", "userID", userID)
+	orgs, err := d.service.ListForUser(ctx, userID)
+	if err != nil {
+		d.Logger.Error("Failed to list organizations", err, "userID", userID)
+	}
+	return orgs, err
+}
+
+// MemberRole logs role lookups
+func (d *LoggingDecorator) MemberRole(ctx context.Context, orgID, userID uuid.UUID) (Role, error) {
+	d.Logger.Debug("Looking up organization role", "organizationID", orgID, "userID", userID)
+	return d.service.MemberRole(ctx, orgID, userID)
+}
+
+// ListMembers logs listing an organization's members
+func (d *LoggingDecorator) ListMembers(ctx context.Context, orgID uuid.UUID) ([]Member, error) {
+	d.Logger.Debug("Listing organization members", "organizationID", orgID)
+	members, err := d.service.ListMembers(ctx, orgID)
+	if err != nil {
+		d.Logger.Error("Failed to list organization members", err, "organizationID", orgID)
+	}
+	return members, err
+}
+
+// AddMember logs adding a member
+func (d *LoggingDecorator) AddMember(ctx context.Context, dto *AddMemberDTO) (*Member, error) {
+	d.Logger.Info("Adding organization member", "organizationID", dto.OrganizationID, "role", dto.Role)
+	member, err := d.service.AddMember(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to add organization member", err, "organizationID", dto.OrganizationID)
+	}
+	return member, err
+}
+
+// RemoveMember logs removing a member
+func (d *LoggingDecorator) RemoveMember(ctx context.Context, dto RemoveMemberDTO) error {
+	d.Logger.Info("Removing organization member", "organizationID", dto.OrganizationID, "memberID", dto.MemberID)
+	err := d.service.RemoveMember(ctx, dto)
+	if err != nil {
+		d.Logger.Error("Failed to remove organization member", err, "organizationID", dto.OrganizationID)
+	}
+	return err
+}
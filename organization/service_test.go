@@ -0,0 +1,163 @@
+package organization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	mocksLogger "github.com/jonesrussell/mp-emailer/mocks/logger"
+	mocksOrganization "github.com/jonesrussell/mp-emailer/mocks/organization"
+	mocksUser "github.com/jonesrussell/mp-emailer/mocks/user"
+	"github.com/jonesrussell/mp-emailer/organization"
+	"github.com/jonesrussell/mp-emailer/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockRepo     *mocksOrganization.MockRepositoryInterface
+	mockUserRepo *mocksUser.MockRepositoryInterface
+	mockLogger   *mocksLogger.MockInterface
+	service      organization.ServiceInterface
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockRepo = mocksOrganization.NewMockRepositoryInterface(s.T())
+	s.mockUserRepo = mocksUser.NewMockRepositoryInterface(s.T())
+	s.mockLogger = mocksLogger.NewMockInterface(s.T())
+	s.mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	s.mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = organization.NewService(organization.ServiceParams{
+		Repo:     s.mockRepo,
+		UserRepo: s.mockUserRepo,
+		Validate: validator.New(),
+		Logger:   s.mockLogger,
+	})
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) TestCreateOrganization() {
+	creatorID := uuid.New()
+
+	s.Run("slugifies the name and makes the creator admin", func() {
+		s.mockRepo.EXPECT().GetBySlug(mock.Anything, "friends-of-the-park").
+			Return(nil, organization.ErrOrganizationNotFound).Once()
+		s.mockRepo.EXPECT().CreateWithAdmin(mock.Anything, mock.AnythingOfType("*organization.Organization"), creatorID).
+			Return(nil).Once()
+
+		org, err := s.service.CreateOrganization(context.Background(), &organization.CreateDTO{
+			Name:        "  Friends of the Park! ",
+			CreatedByID: creatorID,
+		})
+		s.Require().NoError(err)
+		s.Equal("Friends of the Park!", org.Name)
+		s.Equal("friends-of-the-park", org.Slug)
+	})
+
+	s.Run("adds a suffix when the slug is taken", func() {
+		s.mockRepo.EXPECT().GetBySlug(mock.Anything, "coalition").Return(&organization.Organization{}, nil).Once()
+		s.mockRepo.EXPECT().GetBySlug(mock.Anything, "coalition-2").
+			Return(nil, organization.ErrOrganizationNotFound).Once()
+		s.mockRepo.EXPECT().CreateWithAdmin(mock.Anything, mock.Anything, creatorID).Return(nil).Once()
+
+		org, err := s.service.CreateOrganization(context.Background(), &organization.CreateDTO{
+			Name:        "Coalition",
+			CreatedByID: creatorID,
+		})
+		s.Require().NoError(err)
+		s.Equal("coalition-2", org.Slug)
+	})
+
+	s.Run("rejects short names", func() {
+		_, err := s.service.CreateOrganization(context.Background(), &organization.CreateDTO{
+			Name:        "ab",
+			CreatedByID: creatorID,
+		})
+		s.Error(err)
+	})
+}
+
+func (s *ServiceTestSuite) TestAddMember() {
+	orgID := uuid.New()
+	u := &user.User{Email: "friend@example.com"}
+	u.ID = uuid.New()
+
+	dto := func() *organization.AddMemberDTO {
+		return &organization.AddMemberDTO{OrganizationID: orgID, Email: "friend@example.com", Role: organization.RoleEditor}
+	}
+
+	s.Run("adds a registered user", func() {
+		s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "friend@example.com").Return(u, nil).Once()
+		s.mockRepo.EXPECT().GetMember(mock.Anything, orgID, u.ID).Return(nil, organization.ErrMemberNotFound).Once()
+		s.mockRepo.EXPECT().AddMember(mock.Anything, mock.MatchedBy(func(m *organization.Member) bool {
+			return m.OrganizationID == orgID && m.UserID == u.ID && m.Role == organization.RoleEditor
+		})).Return(nil).Once()
+
+		member, err := s.service.AddMember(context.Background(), dto())
+		s.Require().NoError(err)
+		s.Equal(organization.RoleEditor, member.Role)
+	})
+
+	s.Run("unknown email", func() {
+		s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "friend@example.com").
+			Return(nil, fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)).Once()
+
+		_, err := s.service.AddMember(context.Background(), dto())
+		s.ErrorIs(err, organization.ErrUserNotFound)
+	})
+
+	s.Run("existing member", func() {
+		s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "friend@example.com").Return(u, nil).Once()
+		s.mockRepo.EXPECT().GetMember(mock.Anything, orgID, u.ID).Return(&organization.Member{}, nil).Once()
+
+		_, err := s.service.AddMember(context.Background(), dto())
+		s.ErrorIs(err, organization.ErrMemberExists)
+	})
+}
+
+func (s *ServiceTestSuite) TestRemoveMember() {
+	orgID := uuid.New()
+	admin := &organization.Member{OrganizationID: orgID, Role: organization.RoleAdmin}
+	admin.ID = uuid.New()
+
+	s.Run("refuses to remove the last admin", func() {
+		s.mockRepo.EXPECT().GetMemberByID(mock.Anything, admin.ID).Return(admin, nil).Once()
+		s.mockRepo.EXPECT().CountAdmins(mock.Anything, orgID).Return(int64(1), nil).Once()
+
+		err := s.service.RemoveMember(context.Background(), organization.RemoveMemberDTO{
+			OrganizationID: orgID,
+			MemberID:       admin.ID,
+		})
+		s.ErrorIs(err, organization.ErrLastAdmin)
+	})
+
+	s.Run("removes an admin when another remains", func() {
+		s.mockRepo.EXPECT().GetMemberByID(mock.Anything, admin.ID).Return(admin, nil).Once()
+		s.mockRepo.EXPECT().CountAdmins(mock.Anything, orgID).Return(int64(2), nil).Once()
+		s.mockRepo.EXPECT().RemoveMember(mock.Anything, admin).Return(nil).Once()
+
+		s.NoError(s.service.RemoveMember(context.Background(), organization.RemoveMemberDTO{
+			OrganizationID: orgID,
+			MemberID:       admin.ID,
+		}))
+	})
+
+	s.Run("member of another organization", func() {
+		s.mockRepo.EXPECT().GetMemberByID(mock.Anything, admin.ID).Return(admin, nil).Once()
+
+		err := s.service.RemoveMember(context.Background(), organization.RemoveMemberDTO{
+			OrganizationID: uuid.New(),
+			MemberID:       admin.ID,
+		})
+		s.ErrorIs(err, organization.ErrMemberNotFound)
+	})
+}
@@ -54,7 +54,7 @@ func (h *Handler) IndexGET(c echo.Context) error {
 		// Continue without messages rather than failing the request
 	}
 
-	campaigns, err := h.campaignService.GetCampaigns(c.Request().Context())
+	campaigns, err := h.campaignService.GetActiveCampaigns(c.Request().Context())
 	if err != nil {
 		h.Logger.Error("Error fetching campaigns", err)
 		status, msg := h.MapError(err)
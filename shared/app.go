@@ -13,8 +13,10 @@ import (
 	"github.com/jonesrussell/mp-emailer/config"
 	"github.com/jonesrussell/mp-emailer/database"
 	"github.com/jonesrussell/mp-emailer/email"
+	"github.com/jonesrussell/mp-emailer/httpclient"
 	"github.com/jonesrussell/mp-emailer/logger"
 	"github.com/jonesrussell/mp-emailer/session"
+	"github.com/jonesrussell/mp-emailer/templating"
 	"github.com/jonesrussell/mp-emailer/version"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/fx"
@@ -41,6 +43,7 @@ var App = fx.Options(
 			provideEmailService,
 			fx.As(new(email.Service)),
 		),
+		provideHTTPClient,
 		NewBaseHandler,
 		NewGenericLoggingDecorator[LoggableService],
 		provideDatabaseService,
@@ -80,6 +83,17 @@ func provideLogger(cfg *config.Config) (logger.Interface, error) {
 	return logger.GetLogger(), nil
 }
 
+func provideHTTPClient(cfg *config.Config, log logger.Interface) *httpclient.Client {
+	return httpclient.New(httpclient.Options{
+		Timeout:          cfg.HTTPClient.Timeout,
+		MaxRetries:       cfg.HTTPClient.MaxRetries,
+		InitialBackoff:   cfg.HTTPClient.InitialBackoff,
+		MaxBackoff:       cfg.HTTPClient.MaxBackoff,
+		BreakerThreshold: cfg.HTTPClient.BreakerThreshold,
+		BreakerCooldown:  cfg.HTTPClient.BreakerCooldown,
+	}, log)
+}
+
 func provideVersionInfo() version.Info {
 	return version.Get()
 }
@@ -116,9 +130,11 @@ func provideDatabaseService(cfg *config.Config) (database.Database, error) {
 
 func provideTemplates(manager session.Manager, cfg *config.Config) (TemplateRendererInterface, error) {
 	tmpl := template.New("").Funcs(template.FuncMap{
-		"hasPrefix": strings.HasPrefix,
-		"safeHTML":  func(s string) template.HTML { return template.HTML(s) },
-		"safeURL":   func(s string) template.URL { return template.URL(s) },
+		"hasPrefix":    strings.HasPrefix,
+		"join":         strings.Join,
+		"safeHTML":     func(s string) template.HTML { return template.HTML(s) },
+		"sanitizeHTML": func(s string) template.HTML { return template.HTML(templating.SanitizeTemplate(s)) },
+		"safeURL":      func(s string) template.URL { return template.URL(s) },
 		"dict": func(values ...interface{}) (map[string]interface{}, error) {
 			if len(values)%2 != 0 {
 				return nil, fmt.Errorf("invalid dict call")
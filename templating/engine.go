@@ -0,0 +1,344 @@
+// Package templating renders campaign letters with a sandboxed subset of
+// Go's html/template language. Templates may print variables, branch with
+// if/else and pipe values through a fixed set of filters; everything else
+// (range, with, nested template definitions, arbitrary functions) is
+// rejected when the template is parsed.
+package templating
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template/parse"
+)
+
+// Data is the root value a template is executed against. Nested values must
+// be Data (or map[string]interface{}) so that dotted variable names resolve.
+type Data map[string]interface{}
+
+// Options configures an Engine
+type Options struct {
+	// Variables lists every dotted variable a template may reference,
+	// e.g. "first_name" or "representative.party"
+	Variables []string
+	// Aliases maps legacy "{{Token Name}}" placeholders to the action that
+	// replaces them, e.g. "First Name" -> ".first_name"
+	Aliases map[string]string
+}
+
+// Engine parses and validates templates against a fixed set of variables
+type Engine struct {
+	variables map[string]bool
+	aliases   map[string]string
+	funcs     template.FuncMap
+
+	variableNames []string
+	aliasNames    []string
+}
+
+// New creates a new Engine
+func New(opts Options) *Engine {
+	variables := make(map[string]bool, len(opts.Variables))
+	for _, v := range opts.Variables {
+		variables[v] = true
+	}
+
+	aliases := make(map[string]string, len(opts.Aliases))
+	aliasNames := make([]string, 0, len(opts.Aliases))
+	for token, action := range opts.Aliases {
+		aliases[normalizeToken(token)] = action
+		aliasNames = append(aliasNames, token)
+	}
+	sort.Strings(aliasNames)
+
+	return &Engine{
+		variables:     variables,
+		aliases:       aliases,
+		funcs:         Filters(),
+		variableNames: append([]string(nil), opts.Variables...),
+		aliasNames:    aliasNames,
+	}
+}
+
+// WithVariables returns a copy of the engine that also accepts the given
+// variables, for templates with values of their own such as custom fields
+func (e *Engine) WithVariables(names ...string) *Engine {
+	if len(names) == 0 {
+		return e
+	}
+
+	variables := make(map[string]bool, len(e.variables)+len(names))
+	for name := range e.variables {
+		variables[name] = true
+	}
+	variableNames := append([]string(nil), e.variableNames...)
+	for _, name := range names {
+		if !variables[name] {
+			variables[name] = true
+			variableNames = append(variableNames, name)
+		}
+	}
+
+	return &Engine{
+		variables:     variables,
+		aliases:       e.aliases,
+		funcs:         e.funcs,
+		variableNames: variableNames,
+		aliasNames:    e.aliasNames,
+	}
+}
+
+// Template is a parsed and validated template
+type Template struct {
+	tmpl      *template.Template
+	variables []string
+}
+
+// Variables returns the sorted, de-duplicated variables the template references
+func (t *Template) Variables() []string {
+	return append([]string(nil), t.variables...)
+}
+
+// Execute renders the template. Values are HTML-escaped for their context.
+func (t *Template) Execute(data Data) (string, error) {
+	var sb strings.Builder
+	if err := t.tmpl.Execute(&sb, map[string]interface{}(data)); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// Parse translates legacy placeholders, parses the result and checks that it
+// only uses supported actions and known variables
+func (e *Engine) Parse(name, src string) (*Template, error) {
+	normalized, err := e.Normalize(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkComments(normalized); err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(name).
+		Option("missingkey=error").
+		Funcs(e.funcs).
+		Parse(normalized)
+	if err != nil {
+		return nil, &SyntaxError{Err: err}
+	}
+
+	if len(tmpl.Templates()) > 1 {
+		return nil, &UnsupportedActionError{Action: "define"}
+	}
+
+	v := &validator{engine: e, seen: make(map[string]bool)}
+	if tmpl.Tree != nil && tmpl.Tree.Root != nil {
+		if err := v.walk(tmpl.Tree.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	variables := make([]string, 0, len(v.seen))
+	for name := range v.seen {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+
+	return &Template{tmpl: tmpl, variables: variables}, nil
+}
+
+// Validate parses src and discards the result
+func (e *Engine) Validate(src string) error {
+	_, err := e.Parse("validate", src)
+	return err
+}
+
+// actionPattern matches a single {{ ... }} action
+var actionPattern = regexp.MustCompile(`(?s)\{\{(.*?)\}\}`)
+
+// legacyTokenPattern matches the bare-word placeholders of the old syntax
+var legacyTokenPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9' ]*$`)
+
+// Normalize rewrites legacy placeholders into template actions and unescapes
+// HTML entities inside actions, which rich-text editors insert
+func (e *Engine) Normalize(src string) (string, error) {
+	var firstErr error
+
+	out := actionPattern.ReplaceAllStringFunc(src, func(match string) string {
+		body := html.UnescapeString(match[2 : len(match)-2])
+		token := normalizeToken(body)
+
+		if action, ok := e.aliases[token]; ok {
+			return "{{" + action + "}}"
+		}
+
+		if legacyTokenPattern.MatchString(token) && !isKeywordOrFilter(token) {
+			if firstErr == nil {
+				firstErr = &UnknownVariableError{Name: token, Suggestion: suggest(token, e.aliasNames)}
+			}
+			return match
+		}
+
+		return "{{" + body + "}}"
+	})
+
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return out, nil
+}
+
+// normalizeToken folds the whitespace and apostrophe variants editors produce
+func normalizeToken(s string) string {
+	s = strings.NewReplacer("’", "'", " ", " ").Replace(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+//nolint:gochecknoglobals
+var keywords = map[string]bool{
+	"if": true, "else": true, "end": true, "nil": true, "true": true, "false": true,
+	"range": true, "with": true, "template": true, "define": true, "block": true,
+	"break": true, "continue": true,
+}
+
+// isKeywordOrFilter reports whether a bare-word action starts with template
+// syntax rather than being a legacy placeholder
+func isKeywordOrFilter(token string) bool {
+	first := strings.Fields(token)[0]
+	if keywords[first] {
+		return true
+	}
+	_, ok := allowedFuncs[first]
+	return ok
+}
+
+// allowedFuncs are the only identifiers a template may call
+//
+//nolint:gochecknoglobals
+var allowedFuncs = map[string]bool{
+	"and": true, "or": true, "not": true,
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"upper": true, "lower": true, "title": true, "date": true, "default": true, "trim": true,
+}
+
+// validator walks a parse tree enforcing the sandbox rules
+type validator struct {
+	engine *Engine
+	seen   map[string]bool
+}
+
+func (v *validator) walk(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := v.walk(child); err != nil {
+				return err
+			}
+		}
+	case *parse.TextNode, *parse.CommentNode:
+		return nil
+	case *parse.ActionNode:
+		return v.pipe(n.Pipe)
+	case *parse.IfNode:
+		if err := v.pipe(n.Pipe); err != nil {
+			return err
+		}
+		if err := v.walk(n.List); err != nil {
+			return err
+		}
+		if n.ElseList != nil {
+			return v.walk(n.ElseList)
+		}
+	case *parse.RangeNode:
+		return &UnsupportedActionError{Action: "range"}
+	case *parse.WithNode:
+		return &UnsupportedActionError{Action: "with"}
+	case *parse.TemplateNode:
+		return &UnsupportedActionError{Action: "template"}
+	case *parse.BreakNode:
+		return &UnsupportedActionError{Action: "break"}
+	case *parse.ContinueNode:
+		return &UnsupportedActionError{Action: "continue"}
+	default:
+		return &UnsupportedActionError{Action: node.String()}
+	}
+	return nil
+}
+
+func (v *validator) pipe(p *parse.PipeNode) error {
+	if p == nil {
+		return nil
+	}
+	if len(p.Decl) > 0 {
+		return &UnsupportedActionError{Action: "variable declaration"}
+	}
+	for _, cmd := range p.Cmds {
+		for _, arg := range cmd.Args {
+			if err := v.arg(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) arg(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.FieldNode:
+		return v.variable(strings.Join(n.Ident, "."))
+	case *parse.VariableNode:
+		// Only the root variable "$" is available; its fields are ordinary variables
+		if len(n.Ident) < 2 || n.Ident[0] != "$" {
+			return &UnsupportedActionError{Action: n.String()}
+		}
+		return v.variable(strings.Join(n.Ident[1:], "."))
+	case *parse.IdentifierNode:
+		if !allowedFuncs[n.Ident] {
+			return &UnsupportedActionError{Action: n.Ident}
+		}
+	case *parse.PipeNode:
+		return v.pipe(n)
+	case *parse.StringNode:
+		if containsMarkup(n.Text) {
+			return &UnsupportedActionError{Action: "markup in a string"}
+		}
+	case *parse.NumberNode, *parse.BoolNode, *parse.NilNode:
+		return nil
+	default:
+		return &UnsupportedActionError{Action: node.String()}
+	}
+	return nil
+}
+
+// checkComments rejects comments that carry markup. The parser drops
+// comments, so they are looked for in the source.
+func checkComments(src string) error {
+	for _, match := range actionPattern.FindAllStringSubmatch(src, -1) {
+		body := strings.TrimSpace(strings.TrimPrefix(match[1], "-"))
+		if strings.HasPrefix(body, "/*") && containsMarkup(body) {
+			return &UnsupportedActionError{Action: "markup in a comment"}
+		}
+	}
+	return nil
+}
+
+// containsMarkup reports whether text in an action could open or close a
+// tag. Actions are kept out of the letter sanitizer's reach, so they may
+// not carry markup of their own.
+func containsMarkup(s string) bool {
+	return strings.ContainsAny(s, "<>")
+}
+
+func (v *validator) variable(name string) error {
+	if !v.engine.variables[name] {
+		return &UnknownVariableError{Name: name, Suggestion: suggest(name, v.engine.variableNames)}
+	}
+	v.seen[name] = true
+	return nil
+}
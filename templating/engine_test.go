@@ -0,0 +1,129 @@
+package templating_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonesrussell/mp-emailer/templating"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEngine() *templating.Engine {
+	return templating.New(templating.Options{
+		Variables: []string{"name", "city", "date", "rep.party"},
+		Aliases: map[string]string{
+			"Name":      ".name",
+			"MP's Name": ".name",
+			"Date":      `.date | date "2006-01-02"`,
+		},
+	})
+}
+
+func TestEngine_Execute(t *testing.T) {
+	data := templating.Data{
+		"name": "jane o'neil",
+		"city": "",
+		"date": time.Date(2024, 12, 5, 0, 0, 0, 0, time.UTC),
+		"rep":  templating.Data{"party": "Liberal"},
+	}
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"legacy placeholders", "Hi {{Name}} on {{Date}}", "Hi jane o&#39;neil on 2024-12-05"},
+		{"legacy placeholder with editor entities", "Hi {{MP&#39;s&nbsp;Name}}", "Hi jane o&#39;neil"},
+		{"legacy placeholder with smart quote", "Hi {{MP’s Name}}", "Hi jane o&#39;neil"},
+		{"filters", `{{.name | title}} {{.name | upper}}`, "Jane O&#39;Neil JANE O&#39;NEIL"},
+		{"date layout", `{{.date | date "January 2, 2006"}}`, "December 5, 2024"},
+		{"default", `{{.city | default "your city"}}`, "your city"},
+		{"root variable", `{{$.name | upper}}`, "JANE O&#39;NEIL"},
+		{"conditional", `{{if eq .rep.party "Liberal"}}yes{{else if .city}}maybe{{else}}no{{end}}`, "yes"},
+		{"escaped entities in strings", `{{.city | default "A &amp; B"}}`, "A &amp; B"},
+	}
+
+	engine := newEngine()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := engine.Parse("test", tt.src)
+			require.NoError(t, err)
+
+			got, err := tmpl.Execute(data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEngine_Parse_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr interface{}
+	}{
+		{"unknown variable", "{{.nmae}}", &templating.UnknownVariableError{}},
+		{"unknown nested variable", "{{.rep.name}}", &templating.UnknownVariableError{}},
+		{"unknown legacy placeholder", "{{Riding}}", &templating.UnknownVariableError{}},
+		{"range", "{{range .name}}x{{end}}", &templating.UnsupportedActionError{}},
+		{"with", "{{with .name}}x{{end}}", &templating.UnsupportedActionError{}},
+		{"define", `{{define "x"}}y{{end}}`, &templating.UnsupportedActionError{}},
+		{"template call", `{{template "x"}}`, &templating.UnsupportedActionError{}},
+		{"disallowed builtin", `{{call .name}}`, &templating.UnsupportedActionError{}},
+		{"variable declaration", `{{$x := .name}}`, &templating.UnsupportedActionError{}},
+		{"markup in a string", `{{default "<script>alert(1)</script>" .name}}`, &templating.UnsupportedActionError{}},
+		{"markup in a comment", `{{/* <img src=x onerror=alert(1)> */}}`, &templating.UnsupportedActionError{}},
+		{"escaped markup in a string", `{{"&lt;b&gt;"}}`, &templating.UnsupportedActionError{}},
+		{"syntax error", `{{if .name}}`, &templating.SyntaxError{}},
+	}
+
+	engine := newEngine()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := engine.Parse("test", tt.src)
+			require.Error(t, err)
+			assert.IsType(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTemplate_Variables(t *testing.T) {
+	tmpl, err := newEngine().Parse("test", `{{Name}} {{if .rep.party}}{{.city}}{{end}} {{.name}}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"city", "name", "rep.party"}, tmpl.Variables())
+}
+
+func TestEngine_Parse_SuggestsClosestName(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"{{.rep.pary}}", `unknown variable "rep.pary" (did you mean "rep.party"?)`},
+		{"{{Nmae}}", `unknown variable "Nmae" (did you mean "Name"?)`},
+		{"{{.postcode}}", `unknown variable "postcode"`},
+	}
+
+	engine := newEngine()
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			_, err := engine.Parse("test", tt.src)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestEngine_WithVariables(t *testing.T) {
+	base := newEngine()
+	extended := base.WithVariables("fields.nurse", "fields.story")
+
+	tmpl, err := extended.Parse("test", `{{if .fields.nurse}}{{.fields.story}}{{end}} {{.name}}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fields.nurse", "fields.story", "name"}, tmpl.Variables())
+
+	_, err = base.Parse("test", `{{.fields.nurse}}`)
+	assert.EqualError(t, err, `unknown variable "fields.nurse"`)
+
+	_, err = extended.Parse("test", `{{.fields.nures}}`)
+	assert.EqualError(t, err, `unknown variable "fields.nures" (did you mean "fields.nurse"?)`)
+}
@@ -0,0 +1,40 @@
+package templating
+
+import "fmt"
+
+// UnknownVariableError is returned when a template references a variable
+// that is not available to it
+type UnknownVariableError struct {
+	Name string
+	// Suggestion is the closest known name, if any
+	Suggestion string
+}
+
+func (e *UnknownVariableError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("unknown variable %q (did you mean %q?)", e.Name, e.Suggestion)
+	}
+	return fmt.Sprintf("unknown variable %q", e.Name)
+}
+
+// UnsupportedActionError is returned when a template uses syntax outside the sandbox
+type UnsupportedActionError struct {
+	Action string
+}
+
+func (e *UnsupportedActionError) Error() string {
+	return fmt.Sprintf("unsupported template action %q", e.Action)
+}
+
+// SyntaxError wraps a parse failure from the underlying template package
+type SyntaxError struct {
+	Err error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("template syntax error: %v", e.Err)
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
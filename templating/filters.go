@@ -0,0 +1,74 @@
+package templating
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Filters returns the functions available to templates. Filters take the
+// piped value as their last argument, so {{.name | default "friend"}} works.
+func Filters() template.FuncMap {
+	return template.FuncMap{
+		"upper":   strings.ToUpper,
+		"lower":   strings.ToLower,
+		"trim":    strings.TrimSpace,
+		"title":   title,
+		"date":    formatDate,
+		"default": defaultValue,
+	}
+}
+
+// title capitalizes the first letter of each word and lowercases the rest
+func title(s string) string {
+	prev := ' '
+	return strings.Map(func(r rune) rune {
+		start := unicode.IsSpace(prev) || prev == '-' || prev == '\''
+		prev = r
+		if start {
+			return unicode.ToTitle(r)
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
+
+// formatDate formats a time.Time, or a date string in RFC 3339 or
+// YYYY-MM-DD form, using a Go reference layout
+func formatDate(layout string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(layout), nil
+	case *time.Time:
+		if v == nil {
+			return "", nil
+		}
+		return v.Format(layout), nil
+	case string:
+		if v == "" {
+			return "", nil
+		}
+		for _, in := range []string{time.RFC3339, "2006-01-02"} {
+			if t, err := time.Parse(in, v); err == nil {
+				return t.Format(layout), nil
+			}
+		}
+		return "", fmt.Errorf("date: cannot parse %q", v)
+	default:
+		return "", fmt.Errorf("date: unsupported value of type %T", value)
+	}
+}
+
+// defaultValue returns def when value is empty
+func defaultValue(def interface{}, value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return def
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return def
+		}
+	}
+	return value
+}
@@ -0,0 +1,69 @@
+package templating
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// quillClassPattern matches the formatting classes the Quill editor adds,
+// such as ql-align-center or ql-indent-2
+var quillClassPattern = regexp.MustCompile(`^(ql-[a-z0-9-]+)( ql-[a-z0-9-]+)*$`)
+
+// letterPolicy allows the markup of a letter written in the rich-text
+// editor: text formatting, lists, links, images and tables. Scripts, event
+// handlers, frames, forms, style sheets and javascript: URLs are removed.
+//
+//nolint:gochecknoglobals
+var letterPolicy = newLetterPolicy()
+
+func newLetterPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(quillClassPattern).Globally()
+	p.AllowStyles("color", "background-color").Globally()
+	return p
+}
+
+// actionMarkupEscaper escapes what would let an action's text be read as a tag
+//
+//nolint:gochecknoglobals
+var actionMarkupEscaper = strings.NewReplacer("<", "&lt;", ">", "&gt;")
+
+// SanitizeHTML removes everything from rendered HTML that the letter
+// allowlist doesn't permit
+func SanitizeHTML(s string) string {
+	return letterPolicy.Sanitize(s)
+}
+
+// SanitizeTemplate applies the letter allowlist to a template's markup. Its
+// actions are set aside first so that actions in attribute values, such as
+// link URLs, come through unencoded; whatever they print is escaped by
+// html/template when the letter is rendered. Angle brackets in an action,
+// which can only come from a string or a comment, are escaped so that the
+// result is safe to show as it is; the engine unescapes them when parsing.
+func SanitizeTemplate(src string) string {
+	marker := "tmplaction"
+	for strings.Contains(src, marker) {
+		marker += "x"
+	}
+
+	var actions []string
+	masked := actionPattern.ReplaceAllStringFunc(src, func(action string) string {
+		actions = append(actions, action)
+		return marker + strconv.Itoa(len(actions)-1) + marker
+	})
+	if len(actions) == 0 {
+		return SanitizeHTML(src)
+	}
+
+	markerPattern := regexp.MustCompile(marker + `([0-9]+)` + marker)
+	return markerPattern.ReplaceAllStringFunc(SanitizeHTML(masked), func(m string) string {
+		i, err := strconv.Atoi(m[len(marker) : len(m)-len(marker)])
+		if err != nil || i >= len(actions) {
+			return ""
+		}
+		return actionMarkupEscaper.Replace(actions[i])
+	})
+}
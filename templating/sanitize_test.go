@@ -0,0 +1,104 @@
+package templating_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jonesrussell/mp-emailer/templating"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// xssPayloads is a corpus of markup that must not survive sanitization.
+// Each payload is checked for the fragments listed with it.
+//
+//nolint:gochecknoglobals
+var xssPayloads = []struct {
+	payload   string
+	forbidden []string
+}{
+	{`<script>alert(1)</script>`, []string{"<script", "alert"}},
+	{`<SCRIPT SRC=https://evil.example/x.js></SCRIPT>`, []string{"<script", "evil.example"}},
+	{`<img src=x onerror=alert(1)>`, []string{"onerror"}},
+	{`<svg onload=alert(1)><circle r=1></circle></svg>`, []string{"<svg", "onload"}},
+	{`<body onload=alert(1)>`, []string{"<body", "onload"}},
+	{`<a href="javascript:alert(1)">click</a>`, []string{"javascript:"}},
+	{`<a href="JaVaScRiPt:alert(1)">click</a>`, []string{"javascript:"}},
+	{`<a href="jav&#x09;ascript:alert(1)">click</a>`, []string{"ascript:"}},
+	{`<a href="data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==">click</a>`, []string{"data:"}},
+	{`<a href="vbscript:msgbox(1)">click</a>`, []string{"vbscript:"}},
+	{`<iframe src="https://evil.example"></iframe>`, []string{"<iframe"}},
+	{`<object data="https://evil.example/x.swf"></object>`, []string{"<object"}},
+	{`<embed src="https://evil.example/x.swf">`, []string{"<embed"}},
+	{`<form action="https://evil.example"><input name=pw></form>`, []string{"<form", "<input"}},
+	{`<meta http-equiv="refresh" content="0;url=https://evil.example">`, []string{"<meta", "refresh"}},
+	{`<link rel=stylesheet href="https://evil.example/x.css">`, []string{"<link"}},
+	{`<style>body{background:url(javascript:alert(1))}</style>`, []string{"<style", "javascript:"}},
+	{`<p style="background-image:url(javascript:alert(1))">x</p>`, []string{"javascript:", "url("}},
+	{`<p style="color:expression(alert(1))">x</p>`, []string{"expression"}},
+	{`<div onmouseover="alert(1)">hover</div>`, []string{"onmouseover"}},
+	{`<img src="javascript:alert(1)">`, []string{"javascript:"}},
+	{`<math><mtext><table><mglyph><style><img src=x onerror=alert(1)>`, []string{"onerror", "<math"}},
+	{`<base href="https://evil.example/">`, []string{"<base"}},
+	{`<p class="x" id="y">x</p>`, []string{`class="x"`}},
+	{`"><script>alert(1)</script>`, []string{"<script"}},
+	{`<a href="{{.url}}" onclick="alert(1)">x</a>`, []string{"onclick"}},
+	{`<p>{{"<script>alert(1)</script>"}}</p>`, []string{"<script"}},
+	{`<p>{{/* <img src=x onerror=alert(1)> */}}</p>`, []string{"<img src=x onerror", "onerror=alert(1)>"}},
+}
+
+func TestSanitizeHTML_RemovesXSS(t *testing.T) {
+	for _, tt := range xssPayloads {
+		for name, sanitize := range map[string]func(string) string{
+			"html":     templating.SanitizeHTML,
+			"template": templating.SanitizeTemplate,
+		} {
+			got := strings.ToLower(sanitize(tt.payload))
+			for _, fragment := range tt.forbidden {
+				assert.NotContains(t, got, strings.ToLower(fragment), "%s: %s", name, tt.payload)
+			}
+		}
+	}
+}
+
+func TestSanitizeHTML_KeepsEditorMarkup(t *testing.T) {
+	tests := []string{
+		`<p class="ql-align-center"><strong>Bold</strong> <em>italic</em> <u>underline</u> <s>strike</s></p>`,
+		`<h2>Heading</h2><blockquote>Quote</blockquote><ol><li class="ql-indent-1">One</li></ol><ul><li>Two</li></ul>`,
+		`<p><span style="color: rgb(230, 0, 0)">red</span></p>`,
+	}
+	for _, src := range tests {
+		assert.Equal(t, src, templating.SanitizeHTML(src))
+	}
+	assert.Equal(t, `<a href="https://example.ca" rel="nofollow">site</a>`,
+		templating.SanitizeHTML(`<a href="https://example.ca">site</a>`))
+}
+
+func TestSanitizeTemplate_KeepsActions(t *testing.T) {
+	src := `<p>Dear {{MP's Name}},</p>` +
+		`<p>{{if eq .rep.party "Liberal"}}Thank you.{{else}}Please reconsider.{{end}}</p>` +
+		`<p><a href="https://example.ca/{{.city}}">{{.name | title}}</a></p>` +
+		`<script>alert("{{.name}}")</script>`
+
+	got := templating.SanitizeTemplate(src)
+
+	assert.Equal(t, `<p>Dear {{MP's Name}},</p>`+
+		`<p>{{if eq .rep.party "Liberal"}}Thank you.{{else}}Please reconsider.{{end}}</p>`+
+		`<p><a href="https://example.ca/{{.city}}" rel="nofollow">{{.name | title}}</a></p>`, got)
+
+	tmpl, err := newEngine().Parse("letter", got)
+	require.NoError(t, err)
+	out, err := tmpl.Execute(templating.Data{
+		"name": "<b>jane</b>",
+		"city": "thunder bay",
+		"rep":  templating.Data{"party": "Liberal"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `<p>Dear &lt;b&gt;jane&lt;/b&gt;,</p><p>Thank you.</p>`+
+		`<p><a href="https://example.ca/thunder%20bay" rel="nofollow">&lt;b&gt;jane&lt;/b&gt;</a></p>`, out)
+}
+
+func TestSanitizeTemplate_MarkerInText(t *testing.T) {
+	src := `<p>tmplaction0tmplaction {{.name}}</p>`
+	assert.Equal(t, src, templating.SanitizeTemplate(src))
+}
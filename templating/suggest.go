@@ -0,0 +1,43 @@
+package templating
+
+import "strings"
+
+// suggest returns the candidate closest to name, or "" if none is close
+// enough to be a plausible typo
+func suggest(name string, candidates []string) string {
+	maxDistance := len(name)/3 + 1
+	best, bestDistance := "", maxDistance+1
+	lower := strings.ToLower(name)
+
+	for _, candidate := range candidates {
+		if d := levenshtein(lower, strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+
+	return best
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}